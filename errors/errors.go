@@ -1,11 +1,105 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
 
-var EmptyIdError = errors.New("id is empty")
+var EmptyIdError = InvalidArgument(errors.New("id is empty"))
 
-var NilArgumentError = errors.New("argument is nil")
+var NilArgumentError = InvalidArgument(errors.New("argument is nil"))
 
 var NoTransactionError = errors.New("transaction is not opened")
 
+var VersionConflictError = errors.New("version conflict, the row was modified or deleted")
+
+var MissingIdentityError = errors.New("identity is missing from the context")
+
 var BadRepeatedFieldMaskTpl = "unexpected fieldmask count %d for objects count %d"
+
+// NotFoundError is returned when the row to read or change is missing.
+type NotFoundError struct {
+	Err error
+}
+
+func (e *NotFoundError) Error() string { return e.Err.Error() }
+func (e *NotFoundError) Unwrap() error { return e.Err }
+
+// ConflictError is returned when a change conflicts with a stored row, e.g.
+// when it violates a unique constraint.
+type ConflictError struct {
+	Err error
+}
+
+func (e *ConflictError) Error() string { return e.Err.Error() }
+func (e *ConflictError) Unwrap() error { return e.Err }
+
+// InvalidArgumentError is returned when the arguments of a handler are
+// invalid, or violate a constraint of the database other than a unique one.
+type InvalidArgumentError struct {
+	Err error
+}
+
+func (e *InvalidArgumentError) Error() string { return e.Err.Error() }
+func (e *InvalidArgumentError) Unwrap() error { return e.Err }
+
+// NotFound wraps err into a NotFoundError.
+func NotFound(err error) error { return &NotFoundError{Err: err} }
+
+// Conflict wraps err into a ConflictError.
+func Conflict(err error) error { return &ConflictError{Err: err} }
+
+// InvalidArgument wraps err into an InvalidArgumentError.
+func InvalidArgument(err error) error { return &InvalidArgumentError{Err: err} }
+
+// ItemError is the error of the object at Index of the set given to a set
+// handler.
+type ItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *ItemError) Error() string { return fmt.Sprintf("object %d: %s", e.Index, e.Err) }
+func (e *ItemError) Unwrap() error { return e.Err }
+
+// MultiError is returned by the set handlers when some objects of the set
+// fail, none of them being changed then. It holds the errors of the objects in
+// the order of the set, and unwraps to the first of them so that it is
+// converted like it by ToStatus.
+type MultiError struct {
+	Errors []*ItemError
+}
+
+// Add records err as the error of the object at index, after the errors of
+// the objects up to index.
+func (e *MultiError) Add(index int, err error) {
+	i := sort.Search(len(e.Errors), func(i int) bool { return e.Errors[i].Index > index })
+	e.Errors = append(e.Errors, nil)
+	copy(e.Errors[i+1:], e.Errors[i:])
+	e.Errors[i] = &ItemError{Index: index, Err: err}
+}
+
+// ErrOrNil returns e, or nil when no error was added.
+func (e *MultiError) ErrOrNil() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *MultiError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("%d objects failed: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+func (e *MultiError) Unwrap() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e.Errors[0]
+}
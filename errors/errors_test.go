@@ -0,0 +1,33 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMultiError(t *testing.T) {
+	errs := &MultiError{}
+	if err := errs.ErrOrNil(); err != nil {
+		t.Errorf("Expected nil without errors, got %#v", err)
+	}
+	errs.Add(3, VersionConflictError)
+	errs.Add(0, NilArgumentError)
+	err := errs.ErrOrNil()
+	if err != errs {
+		t.Fatalf("Expected the MultiError, got %#v", err)
+	}
+	if expected := "2 objects failed: object 0: argument is nil; object 3: version conflict, the row was modified or deleted"; err.Error() != expected {
+		t.Errorf("Expected %q, got %q", expected, err.Error())
+	}
+	var invalid *InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Errorf("Expected %s to unwrap to the InvalidArgumentError of the first object", err)
+	}
+	var item *ItemError
+	if !errors.As(err, &item) || item.Index != 0 {
+		t.Errorf("Expected %s to unwrap to the ItemError of the first object, got %#v", err, item)
+	}
+	if errors.Is(err, VersionConflictError) {
+		t.Errorf("Expected %s not to unwrap to the error of the second object", err)
+	}
+}
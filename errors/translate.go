@@ -0,0 +1,131 @@
+package errors
+
+import (
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sqlStateError is implemented by the errors of the postgres drivers, e.g.
+// lib/pq and pgx.
+type sqlStateError interface {
+	SQLState() string
+}
+
+// The SQLSTATE classes and codes of the constraint violations.
+const (
+	sqlStateIntegrityViolation = "23"
+	sqlStateUniqueViolation    = "23505"
+)
+
+// uniqueViolations are the messages of the unique constraint violations of
+// the drivers which don't report a SQLSTATE.
+var uniqueViolations = []string{
+	"UNIQUE constraint failed",     // sqlite
+	"Error 1062",                   // mysql, duplicate entry
+	"Cannot insert duplicate key",  // sqlserver
+	"duplicate key value violates", // postgres
+}
+
+// constraintViolations are the messages of the other constraint violations
+// of the drivers which don't report a SQLSTATE.
+var constraintViolations = []string{
+	"NOT NULL constraint failed",    // sqlite
+	"FOREIGN KEY constraint failed", // sqlite
+	"CHECK constraint failed",       // sqlite
+	"Error 1048",                    // mysql, column cannot be null
+	"Error 1451",                    // mysql, foreign key of a parent row
+	"Error 1452",                    // mysql, foreign key of a child row
+	"Error 3819",                    // mysql, check constraint
+	"conflicted with the",           // sqlserver, foreign key and check constraints
+	"Cannot insert the value NULL",  // sqlserver
+}
+
+// FromDB wraps err, returned by gorm, into the typed errors: recordNotFound,
+// the ErrRecordNotFound of the gorm version in use, into a NotFoundError, the
+// unique constraint violations into a ConflictError, and the other constraint
+// violations into an InvalidArgumentError. Other errors, and errors already
+// typed, are returned as is.
+func FromDB(err, recordNotFound error) error {
+	var (
+		notFound *NotFoundError
+		conflict *ConflictError
+		invalid  *InvalidArgumentError
+		sqlState sqlStateError
+	)
+	switch {
+	case err == nil, errors.As(err, &notFound), errors.As(err, &conflict), errors.As(err, &invalid):
+		return err
+	case errors.Is(err, recordNotFound):
+		return NotFound(err)
+	case errors.As(err, &sqlState):
+		switch state := sqlState.SQLState(); {
+		case state == sqlStateUniqueViolation:
+			return Conflict(err)
+		case strings.HasPrefix(state, sqlStateIntegrityViolation):
+			return InvalidArgument(err)
+		}
+		return err
+	}
+	msg := err.Error()
+	for _, violation := range uniqueViolations {
+		if strings.Contains(msg, violation) {
+			return Conflict(err)
+		}
+	}
+	for _, violation := range constraintViolations {
+		if strings.Contains(msg, violation) {
+			return InvalidArgument(err)
+		}
+	}
+	return err
+}
+
+// Translator converts the errors of the handlers to the errors returned by
+// the default servers.
+type Translator interface {
+	Translate(err error) error
+}
+
+// TranslatorFunc adapts a function to the Translator interface.
+type TranslatorFunc func(error) error
+
+// Translate returns f(err).
+func (f TranslatorFunc) Translate(err error) error { return f(err) }
+
+// StatusTranslator is the Translator of the default servers unless they are
+// given another one, it converts the errors with ToStatus.
+var StatusTranslator Translator = TranslatorFunc(ToStatus)
+
+// ToStatus converts the typed errors to status errors with the matching code:
+// NotFound, AlreadyExists and InvalidArgument. The VersionConflictError is
+// converted to Aborted and the MissingIdentityError to Unauthenticated.
+// Other errors, and status errors, are returned as is.
+func ToStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var (
+		notFound *NotFoundError
+		conflict *ConflictError
+		invalid  *InvalidArgumentError
+		code     codes.Code
+	)
+	switch {
+	case errors.As(err, &notFound):
+		code = codes.NotFound
+	case errors.As(err, &conflict):
+		code = codes.AlreadyExists
+	case errors.As(err, &invalid):
+		code = codes.InvalidArgument
+	case errors.Is(err, VersionConflictError):
+		code = codes.Aborted
+	case errors.Is(err, MissingIdentityError):
+		code = codes.Unauthenticated
+	default:
+		return err
+	}
+	return status.Error(code, err.Error())
+}
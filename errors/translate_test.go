@@ -0,0 +1,102 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errRecordNotFound = errors.New("record not found")
+
+type pgError struct {
+	code string
+}
+
+func (e *pgError) Error() string    { return "pq: " + e.code }
+func (e *pgError) SQLState() string { return e.code }
+
+func TestFromDB(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		expected interface{}
+	}{
+		{"nil", nil, nil},
+		{"record not found", errRecordNotFound, &NotFoundError{}},
+		{"wrapped record not found", fmt.Errorf("read: %w", errRecordNotFound), &NotFoundError{}},
+		{"unique violation state", &pgError{"23505"}, &ConflictError{}},
+		{"not null violation state", &pgError{"23502"}, &InvalidArgumentError{}},
+		{"other state", &pgError{"42P01"}, nil},
+		{"sqlite unique violation", errors.New("UNIQUE constraint failed: users.email"), &ConflictError{}},
+		{"mysql unique violation", errors.New("Error 1062: Duplicate entry 'a' for key 'email'"), &ConflictError{}},
+		{"sqlite foreign key violation", errors.New("FOREIGN KEY constraint failed"), &InvalidArgumentError{}},
+		{"already typed", EmptyIdError, &InvalidArgumentError{}},
+		{"other error", errors.New("connection refused"), nil},
+	}
+
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			err := FromDB(v.err, errRecordNotFound)
+			if v.err != nil && !errors.Is(err, v.err) {
+				t.Errorf("Expected %s to wrap %s", err, v.err)
+			}
+			switch v.expected.(type) {
+			case *NotFoundError:
+				var target *NotFoundError
+				if !errors.As(err, &target) {
+					t.Errorf("Expected a NotFoundError, got %#v", err)
+				}
+			case *ConflictError:
+				var target *ConflictError
+				if !errors.As(err, &target) {
+					t.Errorf("Expected a ConflictError, got %#v", err)
+				}
+			case *InvalidArgumentError:
+				var target *InvalidArgumentError
+				if !errors.As(err, &target) {
+					t.Errorf("Expected an InvalidArgumentError, got %#v", err)
+				}
+			default:
+				if err != v.err {
+					t.Errorf("Expected %v as is, got %#v", v.err, err)
+				}
+			}
+		})
+	}
+}
+
+func TestToStatus(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"not found", NotFound(errRecordNotFound), codes.NotFound},
+		{"conflict", Conflict(errors.New("duplicate")), codes.AlreadyExists},
+		{"empty id", EmptyIdError, codes.InvalidArgument},
+		{"nil argument", NilArgumentError, codes.InvalidArgument},
+		{"version conflict", VersionConflictError, codes.Aborted},
+		{"missing identity", MissingIdentityError, codes.Unauthenticated},
+		{"multi error", &MultiError{Errors: []*ItemError{{Index: 1, Err: NotFound(errRecordNotFound)}, {Index: 2, Err: EmptyIdError}}}, codes.NotFound},
+		{"status", status.Error(codes.PermissionDenied, "denied"), codes.PermissionDenied},
+		{"other error", errors.New("connection refused"), codes.Unknown},
+	}
+
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			err := StatusTranslator.Translate(v.err)
+			if code := status.Code(err); code != v.code {
+				t.Errorf("Expected code %s, got %s", v.code, code)
+			}
+			if msg, expected := status.Convert(err).Message(), status.Convert(v.err).Message(); msg != expected {
+				t.Errorf("Expected message %q, got %q", expected, msg)
+			}
+		})
+	}
+	if err := ToStatus(nil); err != nil {
+		t.Errorf("Expected nil, got %s", err)
+	}
+}
@@ -1,13 +1,14 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
-// 	protoc        v3.11.2
+// 	protoc-gen-go v1.25.0
+// 	protoc        (unknown)
 // source: example/feature_demo/demo_multi_file.proto
 
 package example
 
 import (
 	_ "github.com/edhaight/protoc-gen-gorm/options"
+	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -21,6 +22,10 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
 type ExternalChild struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
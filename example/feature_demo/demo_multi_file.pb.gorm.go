@@ -2,6 +2,7 @@ package example
 
 import (
 	context "context"
+	sql "database/sql"
 	fmt "fmt"
 	errors "github.com/edhaight/protoc-gen-gorm/errors"
 	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
@@ -151,6 +152,24 @@ type BlogPostWithAfterToPB interface {
 	AfterToPB(context.Context, *BlogPost) error
 }
 
+// withExternalChildTransaction runs fn in a new transaction of db, so that the
+// changes of ExternalChildORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withExternalChildTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreateExternalChild executes a basic gorm create call
 func DefaultCreateExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB) (*ExternalChild, error) {
 	if in == nil {
@@ -166,7 +185,7 @@ func DefaultCreateExternalChild(ctx context.Context, in *ExternalChild, db *gorm
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -184,6 +203,120 @@ type ExternalChildORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateExternalChildSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateExternalChildSet(ctx context.Context, in []*ExternalChild, batchSize int, db *gorm.DB) ([]*ExternalChild, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*ExternalChild
+	if ok, err := withExternalChildTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateExternalChildSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*ExternalChildORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&ExternalChildORM{}).(ExternalChildORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&ExternalChildORM{}).(ExternalChildORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*ExternalChild, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// ExternalChildORMWithBeforeCreateSet called before DefaultCreateExternalChildSet inserts the objects
+type ExternalChildORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*ExternalChildORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// ExternalChildORMWithAfterCreateSet called after DefaultCreateExternalChildSet inserts the objects
+type ExternalChildORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*ExternalChildORM, *gorm.DB) error
+}
+
+// DefaultUpsertExternalChild inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB) (*ExternalChild, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	// PostgreSQL and SQLite share the ON CONFLICT clause
+	option := "ON CONFLICT (id) DO UPDATE SET primary_included_id = excluded.primary_included_id, primary_string_type_id = excluded.primary_string_type_id, primary_uuid_type_id = excluded.primary_uuid_type_id"
+	if db.Dialect().GetName() == "mysql" {
+		option = "ON DUPLICATE KEY UPDATE primary_included_id = VALUES(primary_included_id), primary_string_type_id = VALUES(primary_string_type_id), primary_uuid_type_id = VALUES(primary_uuid_type_id)"
+	}
+	if err = db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := ExternalChildORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ExternalChildORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExternalChildORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
 // DefaultReadExternalChild executes a basic gorm read call
 func DefaultReadExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB) (*ExternalChild, error) {
 	if in == nil {
@@ -211,7 +344,7 @@ func DefaultReadExternalChild(ctx context.Context, in *ExternalChild, db *gorm.D
 	}
 	ormResponse := ExternalChildORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormResponse).(ExternalChildORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
@@ -250,7 +383,7 @@ func DefaultDeleteExternalChild(ctx context.Context, in *ExternalChild, db *gorm
 	}
 	err = db.Where(&ormObj).Delete(&ExternalChildORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
@@ -265,31 +398,66 @@ type ExternalChildORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+// DefaultDeleteExternalChildSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
 func DefaultDeleteExternalChildSet(ctx context.Context, in []*ExternalChild, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withExternalChildTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteExternalChildSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*ExternalChildORM, len(in))
 	keys := []string{}
-	for _, obj := range in {
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == "" {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
 	if hook, ok := (interface{}(&ExternalChildORM{})).(ExternalChildORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&ExternalChildORM{}).Error
-	if err != nil {
+	before := []*ExternalChildORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[string]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
+	if err = db.Where("id IN (?)", keys).Delete(&ExternalChildORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
 	if hook, ok := (interface{}(&ExternalChildORM{})).(ExternalChildORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -306,14 +474,14 @@ type ExternalChildORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateExternalChild clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB) (*ExternalChild, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateExternalChild")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	lockedRow := &ExternalChildORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -325,7 +493,7 @@ func DefaultStrictUpdateExternalChild(ctx context.Context, in *ExternalChild, db
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
@@ -404,25 +572,150 @@ type ExternalChildWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *ExternalChild, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetExternalChild executes a bulk gorm update call with patch behavior
+// DefaultPatchSetExternalChild executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
 func DefaultPatchSetExternalChild(ctx context.Context, objects []*ExternalChild, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*ExternalChild, error) {
 	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
 	}
-
-	results := make([]*ExternalChild, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchExternalChild(ctx, patcher, updateMasks[i], db)
+	var out []*ExternalChild
+	if ok, err := withExternalChildTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetExternalChild(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
 		if err != nil {
 			return nil, err
 		}
-
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*ExternalChildORM, len(objects))
+	hookedRows := make([]*ExternalChildORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]string, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == "" {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(ExternalChildWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &ExternalChildORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&ExternalChildORM{}).(ExternalChildORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*ExternalChildORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[string]*ExternalChildORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *ExternalChild, updateMask *field_mask.FieldMask, row *ExternalChildORM, db *gorm.DB) (*ExternalChild, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(ExternalChildWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskExternalChild(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*ExternalChild, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *ExternalChild, in *ExternalChild, updateMask *field_mask.FieldMask, db *gorm.DB) (*ExternalChild, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(ExternalChildWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateExternalChild(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(ExternalChildWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*ExternalChild, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
 		results = append(results, pbResponse)
 	}
-
 	return results, nil
 }
 
+// ExternalChildORMWithBeforePatchSetRead called before DefaultPatchSetExternalChild reads the rows
+type ExternalChildORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*ExternalChild, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
 // DefaultApplyFieldMaskExternalChild patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskExternalChild(ctx context.Context, patchee *ExternalChild, patcher *ExternalChild, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ExternalChild, error) {
 	if patcher == nil {
@@ -443,6 +736,227 @@ func DefaultApplyFieldMaskExternalChild(ctx context.Context, patchee *ExternalCh
 	return patchee, nil
 }
 
+// The columns of ExternalChildORM
+const (
+	ExternalChildORMColumnId                  = "id"
+	ExternalChildORMColumnPrimaryIncludedId   = "primary_included_id"
+	ExternalChildORMColumnPrimaryStringTypeId = "primary_string_type_id"
+	ExternalChildORMColumnPrimaryUUIDTypeId   = "primary_uuid_type_id"
+)
+
+// ExternalChildORMQueryBuilder builds a query of ExternalChildORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type ExternalChildORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// ExternalChildORMQuery returns an empty query builder of ExternalChildORM
+func ExternalChildORMQuery() *ExternalChildORMQueryBuilder {
+	return &ExternalChildORMQueryBuilder{}
+}
+
+func (q *ExternalChildORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *ExternalChildORMQueryBuilder {
+	return &ExternalChildORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *ExternalChildORMQueryBuilder) where(query string, args ...interface{}) *ExternalChildORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *ExternalChildORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// IdEq adds the condition id = v
+func (q *ExternalChildORMQueryBuilder) IdEq(v string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *ExternalChildORMQueryBuilder) IdNe(v string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *ExternalChildORMQueryBuilder) IdGt(v string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *ExternalChildORMQueryBuilder) IdGe(v string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *ExternalChildORMQueryBuilder) IdLt(v string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *ExternalChildORMQueryBuilder) IdLe(v string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *ExternalChildORMQueryBuilder) IdIn(vs ...string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnId+" IN (?)", vs)
+}
+
+// IdLike adds the condition id LIKE pattern
+func (q *ExternalChildORMQueryBuilder) IdLike(pattern string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnId+" LIKE ?", pattern)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *ExternalChildORMQueryBuilder) OrderById(desc bool) *ExternalChildORMQueryBuilder {
+	order := ExternalChildORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PrimaryIncludedIdEq adds the condition primary_included_id = v
+func (q *ExternalChildORMQueryBuilder) PrimaryIncludedIdEq(v _go.UUID) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryIncludedId+" = ?", v)
+}
+
+// PrimaryIncludedIdNe adds the condition primary_included_id <> v
+func (q *ExternalChildORMQueryBuilder) PrimaryIncludedIdNe(v _go.UUID) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryIncludedId+" <> ?", v)
+}
+
+// PrimaryIncludedIdIn adds the condition primary_included_id IN (vs)
+func (q *ExternalChildORMQueryBuilder) PrimaryIncludedIdIn(vs ..._go.UUID) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryIncludedId+" IN (?)", vs)
+}
+
+// PrimaryIncludedIdIsNull adds the condition primary_included_id IS NULL
+func (q *ExternalChildORMQueryBuilder) PrimaryIncludedIdIsNull() *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryIncludedId + " IS NULL")
+}
+
+// PrimaryIncludedIdIsNotNull adds the condition primary_included_id IS NOT NULL
+func (q *ExternalChildORMQueryBuilder) PrimaryIncludedIdIsNotNull() *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryIncludedId + " IS NOT NULL")
+}
+
+// OrderByPrimaryIncludedId adds the order by primary_included_id, descending when desc
+func (q *ExternalChildORMQueryBuilder) OrderByPrimaryIncludedId(desc bool) *ExternalChildORMQueryBuilder {
+	order := ExternalChildORMColumnPrimaryIncludedId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PrimaryStringTypeIdEq adds the condition primary_string_type_id = v
+func (q *ExternalChildORMQueryBuilder) PrimaryStringTypeIdEq(v string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryStringTypeId+" = ?", v)
+}
+
+// PrimaryStringTypeIdNe adds the condition primary_string_type_id <> v
+func (q *ExternalChildORMQueryBuilder) PrimaryStringTypeIdNe(v string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryStringTypeId+" <> ?", v)
+}
+
+// PrimaryStringTypeIdGt adds the condition primary_string_type_id > v
+func (q *ExternalChildORMQueryBuilder) PrimaryStringTypeIdGt(v string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryStringTypeId+" > ?", v)
+}
+
+// PrimaryStringTypeIdGe adds the condition primary_string_type_id >= v
+func (q *ExternalChildORMQueryBuilder) PrimaryStringTypeIdGe(v string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryStringTypeId+" >= ?", v)
+}
+
+// PrimaryStringTypeIdLt adds the condition primary_string_type_id < v
+func (q *ExternalChildORMQueryBuilder) PrimaryStringTypeIdLt(v string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryStringTypeId+" < ?", v)
+}
+
+// PrimaryStringTypeIdLe adds the condition primary_string_type_id <= v
+func (q *ExternalChildORMQueryBuilder) PrimaryStringTypeIdLe(v string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryStringTypeId+" <= ?", v)
+}
+
+// PrimaryStringTypeIdIn adds the condition primary_string_type_id IN (vs)
+func (q *ExternalChildORMQueryBuilder) PrimaryStringTypeIdIn(vs ...string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryStringTypeId+" IN (?)", vs)
+}
+
+// PrimaryStringTypeIdLike adds the condition primary_string_type_id LIKE pattern
+func (q *ExternalChildORMQueryBuilder) PrimaryStringTypeIdLike(pattern string) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryStringTypeId+" LIKE ?", pattern)
+}
+
+// PrimaryStringTypeIdIsNull adds the condition primary_string_type_id IS NULL
+func (q *ExternalChildORMQueryBuilder) PrimaryStringTypeIdIsNull() *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryStringTypeId + " IS NULL")
+}
+
+// PrimaryStringTypeIdIsNotNull adds the condition primary_string_type_id IS NOT NULL
+func (q *ExternalChildORMQueryBuilder) PrimaryStringTypeIdIsNotNull() *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryStringTypeId + " IS NOT NULL")
+}
+
+// OrderByPrimaryStringTypeId adds the order by primary_string_type_id, descending when desc
+func (q *ExternalChildORMQueryBuilder) OrderByPrimaryStringTypeId(desc bool) *ExternalChildORMQueryBuilder {
+	order := ExternalChildORMColumnPrimaryStringTypeId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PrimaryUUIDTypeIdEq adds the condition primary_uuid_type_id = v
+func (q *ExternalChildORMQueryBuilder) PrimaryUUIDTypeIdEq(v _go.UUID) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryUUIDTypeId+" = ?", v)
+}
+
+// PrimaryUUIDTypeIdNe adds the condition primary_uuid_type_id <> v
+func (q *ExternalChildORMQueryBuilder) PrimaryUUIDTypeIdNe(v _go.UUID) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryUUIDTypeId+" <> ?", v)
+}
+
+// PrimaryUUIDTypeIdIn adds the condition primary_uuid_type_id IN (vs)
+func (q *ExternalChildORMQueryBuilder) PrimaryUUIDTypeIdIn(vs ..._go.UUID) *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryUUIDTypeId+" IN (?)", vs)
+}
+
+// PrimaryUUIDTypeIdIsNull adds the condition primary_uuid_type_id IS NULL
+func (q *ExternalChildORMQueryBuilder) PrimaryUUIDTypeIdIsNull() *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryUUIDTypeId + " IS NULL")
+}
+
+// PrimaryUUIDTypeIdIsNotNull adds the condition primary_uuid_type_id IS NOT NULL
+func (q *ExternalChildORMQueryBuilder) PrimaryUUIDTypeIdIsNotNull() *ExternalChildORMQueryBuilder {
+	return q.where(ExternalChildORMColumnPrimaryUUIDTypeId + " IS NOT NULL")
+}
+
+// OrderByPrimaryUUIDTypeId adds the order by primary_uuid_type_id, descending when desc
+func (q *ExternalChildORMQueryBuilder) OrderByPrimaryUUIDTypeId(desc bool) *ExternalChildORMQueryBuilder {
+	order := ExternalChildORMColumnPrimaryUUIDTypeId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
 // DefaultListExternalChild executes a gorm list call
 func DefaultListExternalChild(ctx context.Context, db *gorm.DB) ([]*ExternalChild, error) {
 	in := ExternalChild{}
@@ -468,7 +982,7 @@ func DefaultListExternalChild(ctx context.Context, db *gorm.DB) ([]*ExternalChil
 	db = db.Order("id")
 	ormResponse := []ExternalChildORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -496,6 +1010,24 @@ type ExternalChildORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]ExternalChildORM) error
 }
 
+// withBlogPostTransaction runs fn in a new transaction of db, so that the
+// changes of BlogPostORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withBlogPostTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreateBlogPost executes a basic gorm create call
 func DefaultCreateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
 	if in == nil {
@@ -511,7 +1043,7 @@ func DefaultCreateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*Blo
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -529,6 +1061,120 @@ type BlogPostORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateBlogPostSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateBlogPostSet(ctx context.Context, in []*BlogPost, batchSize int, db *gorm.DB) ([]*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*BlogPost
+	if ok, err := withBlogPostTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateBlogPostSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*BlogPostORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&BlogPostORM{}).(BlogPostORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&BlogPostORM{}).(BlogPostORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*BlogPost, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// BlogPostORMWithBeforeCreateSet called before DefaultCreateBlogPostSet inserts the objects
+type BlogPostORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*BlogPostORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// BlogPostORMWithAfterCreateSet called after DefaultCreateBlogPostSet inserts the objects
+type BlogPostORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*BlogPostORM, *gorm.DB) error
+}
+
+// DefaultUpsertBlogPost inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	// PostgreSQL and SQLite share the ON CONFLICT clause
+	option := "ON CONFLICT (id) DO UPDATE SET author = excluded.author, title = excluded.title"
+	if db.Dialect().GetName() == "mysql" {
+		option = "ON DUPLICATE KEY UPDATE author = VALUES(author), title = VALUES(title)"
+	}
+	if err = db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := BlogPostORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type BlogPostORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BlogPostORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
 // DefaultReadBlogPost executes a basic gorm read call
 func DefaultReadBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
 	if in == nil {
@@ -556,7 +1202,7 @@ func DefaultReadBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogP
 	}
 	ormResponse := BlogPostORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormResponse).(BlogPostORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
@@ -595,7 +1241,7 @@ func DefaultDeleteBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) error
 	}
 	err = db.Where(&ormObj).Delete(&BlogPostORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
@@ -610,31 +1256,66 @@ type BlogPostORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+// DefaultDeleteBlogPostSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
 func DefaultDeleteBlogPostSet(ctx context.Context, in []*BlogPost, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withBlogPostTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteBlogPostSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*BlogPostORM, len(in))
 	keys := []uint64{}
-	for _, obj := range in {
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == 0 {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
 	if hook, ok := (interface{}(&BlogPostORM{})).(BlogPostORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&BlogPostORM{}).Error
-	if err != nil {
+	before := []*BlogPostORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[uint64]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
+	if err = db.Where("id IN (?)", keys).Delete(&BlogPostORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
 	if hook, ok := (interface{}(&BlogPostORM{})).(BlogPostORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -651,14 +1332,14 @@ type BlogPostORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateBlogPost clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateBlogPost")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	lockedRow := &BlogPostORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -670,7 +1351,7 @@ func DefaultStrictUpdateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB)
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
@@ -749,25 +1430,150 @@ type BlogPostWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *BlogPost, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetBlogPost executes a bulk gorm update call with patch behavior
+// DefaultPatchSetBlogPost executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
 func DefaultPatchSetBlogPost(ctx context.Context, objects []*BlogPost, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*BlogPost, error) {
 	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
 	}
-
-	results := make([]*BlogPost, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchBlogPost(ctx, patcher, updateMasks[i], db)
+	var out []*BlogPost
+	if ok, err := withBlogPostTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetBlogPost(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
 		if err != nil {
 			return nil, err
 		}
-
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*BlogPostORM, len(objects))
+	hookedRows := make([]*BlogPostORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]uint64, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(BlogPostWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &BlogPostORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&BlogPostORM{}).(BlogPostORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*BlogPostORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[uint64]*BlogPostORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *BlogPost, updateMask *field_mask.FieldMask, row *BlogPostORM, db *gorm.DB) (*BlogPost, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(BlogPostWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskBlogPost(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*BlogPost, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *BlogPost, in *BlogPost, updateMask *field_mask.FieldMask, db *gorm.DB) (*BlogPost, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(BlogPostWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateBlogPost(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(BlogPostWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*BlogPost, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
 		results = append(results, pbResponse)
 	}
-
 	return results, nil
 }
 
+// BlogPostORMWithBeforePatchSetRead called before DefaultPatchSetBlogPost reads the rows
+type BlogPostORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*BlogPost, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
 // DefaultApplyFieldMaskBlogPost patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskBlogPost(ctx context.Context, patchee *BlogPost, patcher *BlogPost, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*BlogPost, error) {
 	if patcher == nil {
@@ -796,6 +1602,190 @@ func DefaultApplyFieldMaskBlogPost(ctx context.Context, patchee *BlogPost, patch
 	return patchee, nil
 }
 
+// The columns of BlogPostORM
+const (
+	BlogPostORMColumnAuthor = "author"
+	BlogPostORMColumnId     = "id"
+	BlogPostORMColumnTitle  = "title"
+)
+
+// BlogPostORMQueryBuilder builds a query of BlogPostORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type BlogPostORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// BlogPostORMQuery returns an empty query builder of BlogPostORM
+func BlogPostORMQuery() *BlogPostORMQueryBuilder {
+	return &BlogPostORMQueryBuilder{}
+}
+
+func (q *BlogPostORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *BlogPostORMQueryBuilder {
+	return &BlogPostORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *BlogPostORMQueryBuilder) where(query string, args ...interface{}) *BlogPostORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *BlogPostORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// AuthorEq adds the condition author = v
+func (q *BlogPostORMQueryBuilder) AuthorEq(v string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnAuthor+" = ?", v)
+}
+
+// AuthorNe adds the condition author <> v
+func (q *BlogPostORMQueryBuilder) AuthorNe(v string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnAuthor+" <> ?", v)
+}
+
+// AuthorGt adds the condition author > v
+func (q *BlogPostORMQueryBuilder) AuthorGt(v string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnAuthor+" > ?", v)
+}
+
+// AuthorGe adds the condition author >= v
+func (q *BlogPostORMQueryBuilder) AuthorGe(v string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnAuthor+" >= ?", v)
+}
+
+// AuthorLt adds the condition author < v
+func (q *BlogPostORMQueryBuilder) AuthorLt(v string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnAuthor+" < ?", v)
+}
+
+// AuthorLe adds the condition author <= v
+func (q *BlogPostORMQueryBuilder) AuthorLe(v string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnAuthor+" <= ?", v)
+}
+
+// AuthorIn adds the condition author IN (vs)
+func (q *BlogPostORMQueryBuilder) AuthorIn(vs ...string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnAuthor+" IN (?)", vs)
+}
+
+// AuthorLike adds the condition author LIKE pattern
+func (q *BlogPostORMQueryBuilder) AuthorLike(pattern string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnAuthor+" LIKE ?", pattern)
+}
+
+// OrderByAuthor adds the order by author, descending when desc
+func (q *BlogPostORMQueryBuilder) OrderByAuthor(desc bool) *BlogPostORMQueryBuilder {
+	order := BlogPostORMColumnAuthor
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// IdEq adds the condition id = v
+func (q *BlogPostORMQueryBuilder) IdEq(v uint64) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *BlogPostORMQueryBuilder) IdNe(v uint64) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *BlogPostORMQueryBuilder) IdGt(v uint64) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *BlogPostORMQueryBuilder) IdGe(v uint64) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *BlogPostORMQueryBuilder) IdLt(v uint64) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *BlogPostORMQueryBuilder) IdLe(v uint64) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *BlogPostORMQueryBuilder) IdIn(vs ...uint64) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnId+" IN (?)", vs)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *BlogPostORMQueryBuilder) OrderById(desc bool) *BlogPostORMQueryBuilder {
+	order := BlogPostORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// TitleEq adds the condition title = v
+func (q *BlogPostORMQueryBuilder) TitleEq(v string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnTitle+" = ?", v)
+}
+
+// TitleNe adds the condition title <> v
+func (q *BlogPostORMQueryBuilder) TitleNe(v string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnTitle+" <> ?", v)
+}
+
+// TitleGt adds the condition title > v
+func (q *BlogPostORMQueryBuilder) TitleGt(v string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnTitle+" > ?", v)
+}
+
+// TitleGe adds the condition title >= v
+func (q *BlogPostORMQueryBuilder) TitleGe(v string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnTitle+" >= ?", v)
+}
+
+// TitleLt adds the condition title < v
+func (q *BlogPostORMQueryBuilder) TitleLt(v string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnTitle+" < ?", v)
+}
+
+// TitleLe adds the condition title <= v
+func (q *BlogPostORMQueryBuilder) TitleLe(v string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnTitle+" <= ?", v)
+}
+
+// TitleIn adds the condition title IN (vs)
+func (q *BlogPostORMQueryBuilder) TitleIn(vs ...string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnTitle+" IN (?)", vs)
+}
+
+// TitleLike adds the condition title LIKE pattern
+func (q *BlogPostORMQueryBuilder) TitleLike(pattern string) *BlogPostORMQueryBuilder {
+	return q.where(BlogPostORMColumnTitle+" LIKE ?", pattern)
+}
+
+// OrderByTitle adds the order by title, descending when desc
+func (q *BlogPostORMQueryBuilder) OrderByTitle(desc bool) *BlogPostORMQueryBuilder {
+	order := BlogPostORMColumnTitle
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
 // DefaultListBlogPost executes a gorm list call
 func DefaultListBlogPost(ctx context.Context, db *gorm.DB) ([]*BlogPost, error) {
 	in := BlogPost{}
@@ -821,7 +1811,7 @@ func DefaultListBlogPost(ctx context.Context, db *gorm.DB) ([]*BlogPost, error)
 	db = db.Order("id")
 	ormResponse := []BlogPostORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -2,11 +2,23 @@ package example
 
 import (
 	context "context"
+	errors "github.com/edhaight/protoc-gen-gorm/errors"
 	gorm "github.com/jinzhu/gorm"
 )
 
 type BlogPostServiceDefaultServer struct {
 	DB *gorm.DB
+	// ErrorTranslator converts the errors returned by the methods, errors.StatusTranslator
+	// is used when nil
+	ErrorTranslator errors.Translator
+}
+
+// translateError converts err with the ErrorTranslator of the server
+func (m *BlogPostServiceDefaultServer) translateError(err error) error {
+	if m.ErrorTranslator == nil {
+		return errors.StatusTranslator.Translate(err)
+	}
+	return m.ErrorTranslator.Translate(err)
 }
 
 // Read ...
@@ -1,18 +1,19 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
-// 	protoc        v3.11.2
+// 	protoc-gen-go v1.25.0
+// 	protoc        (unknown)
 // source: example/feature_demo/demo_service.proto
 
 package example
 
 import (
 	_ "github.com/edhaight/protoc-gen-gorm/options"
-	empty "github.com/golang/protobuf/ptypes/empty"
+	proto "github.com/golang/protobuf/proto"
 	query "github.com/infobloxopen/atlas-app-toolkit/query"
-	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -24,6 +25,10 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
 // IntPoint is a basic message type representing a single cartesian point
 // that we want to store in a database
 type IntPoint struct {
@@ -186,6 +191,196 @@ func (x *CreateIntPointResponse) GetResult() *IntPoint {
 	return nil
 }
 
+type CreateSetIntPointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A set of objects is created from the repeated 'payloads' field
+	Payloads []*IntPoint `protobuf:"bytes,1,rep,name=payloads,proto3" json:"payloads,omitempty"`
+}
+
+func (x *CreateSetIntPointRequest) Reset() {
+	*x = CreateSetIntPointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSetIntPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSetIntPointRequest) ProtoMessage() {}
+
+func (x *CreateSetIntPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSetIntPointRequest.ProtoReflect.Descriptor instead.
+func (*CreateSetIntPointRequest) Descriptor() ([]byte, []int) {
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSetIntPointRequest) GetPayloads() []*IntPoint {
+	if x != nil {
+		return x.Payloads
+	}
+	return nil
+}
+
+type CreateSetIntPointResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*IntPoint `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *CreateSetIntPointResponse) Reset() {
+	*x = CreateSetIntPointResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSetIntPointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSetIntPointResponse) ProtoMessage() {}
+
+func (x *CreateSetIntPointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSetIntPointResponse.ProtoReflect.Descriptor instead.
+func (*CreateSetIntPointResponse) Descriptor() ([]byte, []int) {
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateSetIntPointResponse) GetResults() []*IntPoint {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type UpsertIntPointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The 'payload' is inserted, or updates the IntPoint of the same id
+	Payload *IntPoint `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *UpsertIntPointRequest) Reset() {
+	*x = UpsertIntPointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertIntPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertIntPointRequest) ProtoMessage() {}
+
+func (x *UpsertIntPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertIntPointRequest.ProtoReflect.Descriptor instead.
+func (*UpsertIntPointRequest) Descriptor() ([]byte, []int) {
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpsertIntPointRequest) GetPayload() *IntPoint {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type UpsertIntPointResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *IntPoint `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *UpsertIntPointResponse) Reset() {
+	*x = UpsertIntPointResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertIntPointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertIntPointResponse) ProtoMessage() {}
+
+func (x *UpsertIntPointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertIntPointResponse.ProtoReflect.Descriptor instead.
+func (*UpsertIntPointResponse) Descriptor() ([]byte, []int) {
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpsertIntPointResponse) GetResult() *IntPoint {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
 type ReadIntPointRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -199,7 +394,7 @@ type ReadIntPointRequest struct {
 func (x *ReadIntPointRequest) Reset() {
 	*x = ReadIntPointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[3]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -212,7 +407,7 @@ func (x *ReadIntPointRequest) String() string {
 func (*ReadIntPointRequest) ProtoMessage() {}
 
 func (x *ReadIntPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[3]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -225,7 +420,7 @@ func (x *ReadIntPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadIntPointRequest.ProtoReflect.Descriptor instead.
 func (*ReadIntPointRequest) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{3}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ReadIntPointRequest) GetId() uint32 {
@@ -254,7 +449,7 @@ type ReadIntPointResponse struct {
 func (x *ReadIntPointResponse) Reset() {
 	*x = ReadIntPointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[4]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -267,7 +462,7 @@ func (x *ReadIntPointResponse) String() string {
 func (*ReadIntPointResponse) ProtoMessage() {}
 
 func (x *ReadIntPointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[4]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,7 +475,7 @@ func (x *ReadIntPointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadIntPointResponse.ProtoReflect.Descriptor instead.
 func (*ReadIntPointResponse) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{4}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ReadIntPointResponse) GetResult() *IntPoint {
@@ -295,14 +490,14 @@ type UpdateIntPointRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload        *IntPoint             `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	GerogeriGegege *field_mask.FieldMask `protobuf:"bytes,2,opt,name=gerogeri_gegege,json=gerogeriGegege,proto3" json:"gerogeri_gegege,omitempty"`
+	Payload        *IntPoint              `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	GerogeriGegege *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=gerogeri_gegege,json=gerogeriGegege,proto3" json:"gerogeri_gegege,omitempty"`
 }
 
 func (x *UpdateIntPointRequest) Reset() {
 	*x = UpdateIntPointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[5]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -315,7 +510,7 @@ func (x *UpdateIntPointRequest) String() string {
 func (*UpdateIntPointRequest) ProtoMessage() {}
 
 func (x *UpdateIntPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[5]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +523,7 @@ func (x *UpdateIntPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIntPointRequest.ProtoReflect.Descriptor instead.
 func (*UpdateIntPointRequest) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{5}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateIntPointRequest) GetPayload() *IntPoint {
@@ -338,7 +533,7 @@ func (x *UpdateIntPointRequest) GetPayload() *IntPoint {
 	return nil
 }
 
-func (x *UpdateIntPointRequest) GetGerogeriGegege() *field_mask.FieldMask {
+func (x *UpdateIntPointRequest) GetGerogeriGegege() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.GerogeriGegege
 	}
@@ -356,7 +551,7 @@ type UpdateIntPointResponse struct {
 func (x *UpdateIntPointResponse) Reset() {
 	*x = UpdateIntPointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[6]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -369,7 +564,7 @@ func (x *UpdateIntPointResponse) String() string {
 func (*UpdateIntPointResponse) ProtoMessage() {}
 
 func (x *UpdateIntPointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[6]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -382,7 +577,7 @@ func (x *UpdateIntPointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIntPointResponse.ProtoReflect.Descriptor instead.
 func (*UpdateIntPointResponse) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{6}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateIntPointResponse) GetResult() *IntPoint {
@@ -397,14 +592,14 @@ type UpdateSetIntPointRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Objects []*IntPoint             `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
-	Masks   []*field_mask.FieldMask `protobuf:"bytes,2,rep,name=masks,proto3" json:"masks,omitempty"`
+	Objects []*IntPoint              `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	Masks   []*fieldmaskpb.FieldMask `protobuf:"bytes,2,rep,name=masks,proto3" json:"masks,omitempty"`
 }
 
 func (x *UpdateSetIntPointRequest) Reset() {
 	*x = UpdateSetIntPointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[7]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -417,7 +612,7 @@ func (x *UpdateSetIntPointRequest) String() string {
 func (*UpdateSetIntPointRequest) ProtoMessage() {}
 
 func (x *UpdateSetIntPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[7]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,7 +625,7 @@ func (x *UpdateSetIntPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSetIntPointRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSetIntPointRequest) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{7}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateSetIntPointRequest) GetObjects() []*IntPoint {
@@ -440,7 +635,7 @@ func (x *UpdateSetIntPointRequest) GetObjects() []*IntPoint {
 	return nil
 }
 
-func (x *UpdateSetIntPointRequest) GetMasks() []*field_mask.FieldMask {
+func (x *UpdateSetIntPointRequest) GetMasks() []*fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.Masks
 	}
@@ -458,7 +653,7 @@ type UpdateSetIntPointResponse struct {
 func (x *UpdateSetIntPointResponse) Reset() {
 	*x = UpdateSetIntPointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[8]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -471,7 +666,7 @@ func (x *UpdateSetIntPointResponse) String() string {
 func (*UpdateSetIntPointResponse) ProtoMessage() {}
 
 func (x *UpdateSetIntPointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[8]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,7 +679,7 @@ func (x *UpdateSetIntPointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSetIntPointResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSetIntPointResponse) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{8}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateSetIntPointResponse) GetResults() []*IntPoint {
@@ -506,7 +701,7 @@ type DeleteIntPointRequest struct {
 func (x *DeleteIntPointRequest) Reset() {
 	*x = DeleteIntPointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[9]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -519,7 +714,7 @@ func (x *DeleteIntPointRequest) String() string {
 func (*DeleteIntPointRequest) ProtoMessage() {}
 
 func (x *DeleteIntPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[9]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +727,7 @@ func (x *DeleteIntPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIntPointRequest.ProtoReflect.Descriptor instead.
 func (*DeleteIntPointRequest) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{9}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteIntPointRequest) GetId() uint32 {
@@ -554,7 +749,7 @@ type DeleteIntPointsRequest struct {
 func (x *DeleteIntPointsRequest) Reset() {
 	*x = DeleteIntPointsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[10]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -567,7 +762,7 @@ func (x *DeleteIntPointsRequest) String() string {
 func (*DeleteIntPointsRequest) ProtoMessage() {}
 
 func (x *DeleteIntPointsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[10]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +775,7 @@ func (x *DeleteIntPointsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIntPointsRequest.ProtoReflect.Descriptor instead.
 func (*DeleteIntPointsRequest) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{10}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteIntPointsRequest) GetIds() []uint32 {
@@ -601,7 +796,7 @@ type DeleteIntPointResponse struct {
 func (x *DeleteIntPointResponse) Reset() {
 	*x = DeleteIntPointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[11]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -614,7 +809,7 @@ func (x *DeleteIntPointResponse) String() string {
 func (*DeleteIntPointResponse) ProtoMessage() {}
 
 func (x *DeleteIntPointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[11]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,7 +822,7 @@ func (x *DeleteIntPointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIntPointResponse.ProtoReflect.Descriptor instead.
 func (*DeleteIntPointResponse) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{11}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{15}
 }
 
 type ListIntPointResponse struct {
@@ -643,7 +838,7 @@ type ListIntPointResponse struct {
 func (x *ListIntPointResponse) Reset() {
 	*x = ListIntPointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[12]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -656,7 +851,7 @@ func (x *ListIntPointResponse) String() string {
 func (*ListIntPointResponse) ProtoMessage() {}
 
 func (x *ListIntPointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[12]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +864,7 @@ func (x *ListIntPointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIntPointResponse.ProtoReflect.Descriptor instead.
 func (*ListIntPointResponse) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{12}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListIntPointResponse) GetResults() []*IntPoint {
@@ -699,7 +894,7 @@ type ListSomethingResponse struct {
 func (x *ListSomethingResponse) Reset() {
 	*x = ListSomethingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[13]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -712,7 +907,7 @@ func (x *ListSomethingResponse) String() string {
 func (*ListSomethingResponse) ProtoMessage() {}
 
 func (x *ListSomethingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[13]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +920,7 @@ func (x *ListSomethingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSomethingResponse.ProtoReflect.Descriptor instead.
 func (*ListSomethingResponse) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{13}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListSomethingResponse) GetResults() []*Something {
@@ -754,7 +949,7 @@ type Something struct {
 func (x *Something) Reset() {
 	*x = Something{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[14]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -767,7 +962,7 @@ func (x *Something) String() string {
 func (*Something) ProtoMessage() {}
 
 func (x *Something) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[14]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -780,7 +975,7 @@ func (x *Something) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Something.ProtoReflect.Descriptor instead.
 func (*Something) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{14}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Something) GetField() string {
@@ -804,7 +999,7 @@ type ListIntPointRequest struct {
 func (x *ListIntPointRequest) Reset() {
 	*x = ListIntPointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[15]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -817,7 +1012,7 @@ func (x *ListIntPointRequest) String() string {
 func (*ListIntPointRequest) ProtoMessage() {}
 
 func (x *ListIntPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[15]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,7 +1025,7 @@ func (x *ListIntPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIntPointRequest.ProtoReflect.Descriptor instead.
 func (*ListIntPointRequest) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{15}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListIntPointRequest) GetFilter() *query.Filtering {
@@ -872,7 +1067,7 @@ type Circle struct {
 func (x *Circle) Reset() {
 	*x = Circle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[16]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -885,7 +1080,7 @@ func (x *Circle) String() string {
 func (*Circle) ProtoMessage() {}
 
 func (x *Circle) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[16]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,7 +1093,7 @@ func (x *Circle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Circle.ProtoReflect.Descriptor instead.
 func (*Circle) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{16}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Circle) GetR() uint32 {
@@ -917,7 +1112,7 @@ type ListCircleRequest struct {
 func (x *ListCircleRequest) Reset() {
 	*x = ListCircleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[17]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -930,7 +1125,7 @@ func (x *ListCircleRequest) String() string {
 func (*ListCircleRequest) ProtoMessage() {}
 
 func (x *ListCircleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[17]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,7 +1138,7 @@ func (x *ListCircleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCircleRequest.ProtoReflect.Descriptor instead.
 func (*ListCircleRequest) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{17}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{21}
 }
 
 type ListCircleResponse struct {
@@ -957,7 +1152,7 @@ type ListCircleResponse struct {
 func (x *ListCircleResponse) Reset() {
 	*x = ListCircleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_example_feature_demo_demo_service_proto_msgTypes[18]
+		mi := &file_example_feature_demo_demo_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -970,7 +1165,7 @@ func (x *ListCircleResponse) String() string {
 func (*ListCircleResponse) ProtoMessage() {}
 
 func (x *ListCircleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_example_feature_demo_demo_service_proto_msgTypes[18]
+	mi := &file_example_feature_demo_demo_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -983,7 +1178,7 @@ func (x *ListCircleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCircleResponse.ProtoReflect.Descriptor instead.
 func (*ListCircleResponse) Descriptor() ([]byte, []int) {
-	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{18}
+	return file_example_feature_demo_demo_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListCircleResponse) GetResults() []*Circle {
@@ -1024,6 +1219,24 @@ var file_example_feature_demo_demo_service_proto_rawDesc = []byte{
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
 	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x49, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x08,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0x48, 0x0a, 0x19, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x44, 0x0a, 0x15, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x49,
+	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x43, 0x0a, 0x16, 0x55,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
 	0x22, 0x5b, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
@@ -1106,164 +1319,174 @@ var file_example_feature_demo_demo_service_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
 	0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x32,
-	0xbc, 0x05, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0xdf, 0x06, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e,
 	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
 	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
 	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
 	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x45, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x74, 0x12, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x04, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x12, 0x54, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x12, 0x21, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
 	0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x49, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69,
-	0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x06,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0xba, 0xb9, 0x19, 0x0a, 0x0a, 0x08, 0x49,
-	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67,
-	0x1a, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74,
-	0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x1a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x32, 0xfc,
-	0x04, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x78, 0x6e, 0x12, 0x4b,
-	0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x04, 0x52,
-	0x65, 0x61, 0x64, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65,
-	0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x1a, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74,
+	0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a,
+	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6d, 0x65,
+	0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6d, 0x65,
+	0x74, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x59, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0xba, 0xb9, 0x19, 0x0a,
+	0x0a, 0x08, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12,
+	0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68,
+	0x69, 0x6e, 0x67, 0x1a, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f,
+	0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x1a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08,
+	0x01, 0x32, 0xfc, 0x04, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x78,
+	0x6e, 0x12, 0x4b, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45,
+	0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65,
+	0x22, 0x00, 0x12, 0x45, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x06, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x65, 0x74, 0x12, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x1a, 0x12,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69,
+	0x6e, 0x67, 0x22, 0x00, 0x1a, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x08, 0x01, 0x10, 0x01, 0x18, 0x01,
+	0x32, 0x5a, 0x0a, 0x0d, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x41, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x1a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x32, 0xf4, 0x07, 0x0a,
+	0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73,
+	0x41, 0x75, 0x74, 0x6f, 0x47, 0x65, 0x6e, 0x12, 0x4c, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64, 0x41, 0x12, 0x1c, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x05, 0x52,
+	0x65, 0x61, 0x64, 0x42, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x12, 0x1e,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x12, 0x1e, 0x2e, 0x65,
 	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74,
 	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65,
 	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74,
 	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x45, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x74, 0x12,
-	0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f,
-	0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x1a, 0x12, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22,
-	0x00, 0x1a, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x08, 0x01, 0x10, 0x01, 0x18, 0x01, 0x32, 0x5a, 0x0a,
-	0x0d, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41,
-	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x1a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x32, 0xf4, 0x07, 0x0a, 0x16, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x41, 0x75, 0x74,
-	0x6f, 0x47, 0x65, 0x6e, 0x12, 0x4c, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x12,
-	0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x12, 0x1e, 0x2e,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x46, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64, 0x41, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64,
-	0x42, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64,
-	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e,
-	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x4c, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x12, 0x1e, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c,
-	0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x05,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x12, 0x1c, 0x2e,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x07,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x46, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5b, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19,
+	0x0b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x07,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
 	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
 	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a, 0x09,
-	0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x07, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x42, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a, 0x09, 0x69, 0x6e, 0x74,
-	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x65, 0x74, 0x41, 0x12, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a, 0x09, 0x69, 0x6e,
-	0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x65, 0x74, 0x42, 0x12, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a, 0x09, 0x69,
-	0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x1a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01,
-	0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65,
-	0x64, 0x68, 0x61, 0x69, 0x67, 0x68, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
-	0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f,
-	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x3b, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x74, 0x41, 0x12, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a,
+	0x09, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x74, 0x42, 0x12, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x1a, 0x06, 0xba, 0xb9, 0x19,
+	0x02, 0x08, 0x01, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x65, 0x64, 0x68, 0x61, 0x69, 0x67, 0x68, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x3b,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1278,120 +1501,132 @@ func file_example_feature_demo_demo_service_proto_rawDescGZIP() []byte {
 	return file_example_feature_demo_demo_service_proto_rawDescData
 }
 
-var file_example_feature_demo_demo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_example_feature_demo_demo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_example_feature_demo_demo_service_proto_goTypes = []interface{}{
 	(*IntPoint)(nil),                  // 0: example.IntPoint
 	(*CreateIntPointRequest)(nil),     // 1: example.CreateIntPointRequest
 	(*CreateIntPointResponse)(nil),    // 2: example.CreateIntPointResponse
-	(*ReadIntPointRequest)(nil),       // 3: example.ReadIntPointRequest
-	(*ReadIntPointResponse)(nil),      // 4: example.ReadIntPointResponse
-	(*UpdateIntPointRequest)(nil),     // 5: example.UpdateIntPointRequest
-	(*UpdateIntPointResponse)(nil),    // 6: example.UpdateIntPointResponse
-	(*UpdateSetIntPointRequest)(nil),  // 7: example.UpdateSetIntPointRequest
-	(*UpdateSetIntPointResponse)(nil), // 8: example.UpdateSetIntPointResponse
-	(*DeleteIntPointRequest)(nil),     // 9: example.DeleteIntPointRequest
-	(*DeleteIntPointsRequest)(nil),    // 10: example.DeleteIntPointsRequest
-	(*DeleteIntPointResponse)(nil),    // 11: example.DeleteIntPointResponse
-	(*ListIntPointResponse)(nil),      // 12: example.ListIntPointResponse
-	(*ListSomethingResponse)(nil),     // 13: example.ListSomethingResponse
-	(*Something)(nil),                 // 14: example.Something
-	(*ListIntPointRequest)(nil),       // 15: example.ListIntPointRequest
-	(*Circle)(nil),                    // 16: example.Circle
-	(*ListCircleRequest)(nil),         // 17: example.ListCircleRequest
-	(*ListCircleResponse)(nil),        // 18: example.ListCircleResponse
-	(*query.FieldSelection)(nil),      // 19: infoblox.api.FieldSelection
-	(*field_mask.FieldMask)(nil),      // 20: google.protobuf.FieldMask
-	(*query.PageInfo)(nil),            // 21: infoblox.api.PageInfo
-	(*query.Filtering)(nil),           // 22: infoblox.api.Filtering
-	(*query.Sorting)(nil),             // 23: infoblox.api.Sorting
-	(*query.Pagination)(nil),          // 24: infoblox.api.Pagination
-	(*empty.Empty)(nil),               // 25: google.protobuf.Empty
+	(*CreateSetIntPointRequest)(nil),  // 3: example.CreateSetIntPointRequest
+	(*CreateSetIntPointResponse)(nil), // 4: example.CreateSetIntPointResponse
+	(*UpsertIntPointRequest)(nil),     // 5: example.UpsertIntPointRequest
+	(*UpsertIntPointResponse)(nil),    // 6: example.UpsertIntPointResponse
+	(*ReadIntPointRequest)(nil),       // 7: example.ReadIntPointRequest
+	(*ReadIntPointResponse)(nil),      // 8: example.ReadIntPointResponse
+	(*UpdateIntPointRequest)(nil),     // 9: example.UpdateIntPointRequest
+	(*UpdateIntPointResponse)(nil),    // 10: example.UpdateIntPointResponse
+	(*UpdateSetIntPointRequest)(nil),  // 11: example.UpdateSetIntPointRequest
+	(*UpdateSetIntPointResponse)(nil), // 12: example.UpdateSetIntPointResponse
+	(*DeleteIntPointRequest)(nil),     // 13: example.DeleteIntPointRequest
+	(*DeleteIntPointsRequest)(nil),    // 14: example.DeleteIntPointsRequest
+	(*DeleteIntPointResponse)(nil),    // 15: example.DeleteIntPointResponse
+	(*ListIntPointResponse)(nil),      // 16: example.ListIntPointResponse
+	(*ListSomethingResponse)(nil),     // 17: example.ListSomethingResponse
+	(*Something)(nil),                 // 18: example.Something
+	(*ListIntPointRequest)(nil),       // 19: example.ListIntPointRequest
+	(*Circle)(nil),                    // 20: example.Circle
+	(*ListCircleRequest)(nil),         // 21: example.ListCircleRequest
+	(*ListCircleResponse)(nil),        // 22: example.ListCircleResponse
+	(*query.FieldSelection)(nil),      // 23: infoblox.api.FieldSelection
+	(*fieldmaskpb.FieldMask)(nil),     // 24: google.protobuf.FieldMask
+	(*query.PageInfo)(nil),            // 25: infoblox.api.PageInfo
+	(*query.Filtering)(nil),           // 26: infoblox.api.Filtering
+	(*query.Sorting)(nil),             // 27: infoblox.api.Sorting
+	(*query.Pagination)(nil),          // 28: infoblox.api.Pagination
+	(*emptypb.Empty)(nil),             // 29: google.protobuf.Empty
 }
 var file_example_feature_demo_demo_service_proto_depIdxs = []int32{
 	0,  // 0: example.CreateIntPointRequest.payload:type_name -> example.IntPoint
 	0,  // 1: example.CreateIntPointResponse.result:type_name -> example.IntPoint
-	19, // 2: example.ReadIntPointRequest.fields:type_name -> infoblox.api.FieldSelection
-	0,  // 3: example.ReadIntPointResponse.result:type_name -> example.IntPoint
-	0,  // 4: example.UpdateIntPointRequest.payload:type_name -> example.IntPoint
-	20, // 5: example.UpdateIntPointRequest.gerogeri_gegege:type_name -> google.protobuf.FieldMask
-	0,  // 6: example.UpdateIntPointResponse.result:type_name -> example.IntPoint
-	0,  // 7: example.UpdateSetIntPointRequest.objects:type_name -> example.IntPoint
-	20, // 8: example.UpdateSetIntPointRequest.masks:type_name -> google.protobuf.FieldMask
-	0,  // 9: example.UpdateSetIntPointResponse.results:type_name -> example.IntPoint
-	0,  // 10: example.ListIntPointResponse.results:type_name -> example.IntPoint
-	21, // 11: example.ListIntPointResponse.page_info:type_name -> infoblox.api.PageInfo
-	14, // 12: example.ListSomethingResponse.results:type_name -> example.Something
-	21, // 13: example.ListSomethingResponse.page_info:type_name -> infoblox.api.PageInfo
-	22, // 14: example.ListIntPointRequest.filter:type_name -> infoblox.api.Filtering
-	23, // 15: example.ListIntPointRequest.order_by:type_name -> infoblox.api.Sorting
-	19, // 16: example.ListIntPointRequest.fields:type_name -> infoblox.api.FieldSelection
-	24, // 17: example.ListIntPointRequest.paging:type_name -> infoblox.api.Pagination
-	16, // 18: example.ListCircleResponse.results:type_name -> example.Circle
-	1,  // 19: example.IntPointService.Create:input_type -> example.CreateIntPointRequest
-	3,  // 20: example.IntPointService.Read:input_type -> example.ReadIntPointRequest
-	5,  // 21: example.IntPointService.Update:input_type -> example.UpdateIntPointRequest
-	7,  // 22: example.IntPointService.UpdateSet:input_type -> example.UpdateSetIntPointRequest
-	15, // 23: example.IntPointService.List:input_type -> example.ListIntPointRequest
-	25, // 24: example.IntPointService.ListSomething:input_type -> google.protobuf.Empty
-	9,  // 25: example.IntPointService.Delete:input_type -> example.DeleteIntPointRequest
-	25, // 26: example.IntPointService.CustomMethod:input_type -> google.protobuf.Empty
-	14, // 27: example.IntPointService.CreateSomething:input_type -> example.Something
-	1,  // 28: example.IntPointTxn.Create:input_type -> example.CreateIntPointRequest
-	3,  // 29: example.IntPointTxn.Read:input_type -> example.ReadIntPointRequest
-	5,  // 30: example.IntPointTxn.Update:input_type -> example.UpdateIntPointRequest
-	15, // 31: example.IntPointTxn.List:input_type -> example.ListIntPointRequest
-	9,  // 32: example.IntPointTxn.Delete:input_type -> example.DeleteIntPointRequest
-	10, // 33: example.IntPointTxn.DeleteSet:input_type -> example.DeleteIntPointsRequest
-	25, // 34: example.IntPointTxn.CustomMethod:input_type -> google.protobuf.Empty
-	14, // 35: example.IntPointTxn.CreateSomething:input_type -> example.Something
-	17, // 36: example.CircleService.List:input_type -> example.ListCircleRequest
-	1,  // 37: example.MultipleMethodsAutoGen.CreateA:input_type -> example.CreateIntPointRequest
-	1,  // 38: example.MultipleMethodsAutoGen.CreateB:input_type -> example.CreateIntPointRequest
-	3,  // 39: example.MultipleMethodsAutoGen.ReadA:input_type -> example.ReadIntPointRequest
-	3,  // 40: example.MultipleMethodsAutoGen.ReadB:input_type -> example.ReadIntPointRequest
-	5,  // 41: example.MultipleMethodsAutoGen.UpdateA:input_type -> example.UpdateIntPointRequest
-	5,  // 42: example.MultipleMethodsAutoGen.UpdateB:input_type -> example.UpdateIntPointRequest
-	15, // 43: example.MultipleMethodsAutoGen.ListA:input_type -> example.ListIntPointRequest
-	15, // 44: example.MultipleMethodsAutoGen.ListB:input_type -> example.ListIntPointRequest
-	9,  // 45: example.MultipleMethodsAutoGen.DeleteA:input_type -> example.DeleteIntPointRequest
-	9,  // 46: example.MultipleMethodsAutoGen.DeleteB:input_type -> example.DeleteIntPointRequest
-	10, // 47: example.MultipleMethodsAutoGen.DeleteSetA:input_type -> example.DeleteIntPointsRequest
-	10, // 48: example.MultipleMethodsAutoGen.DeleteSetB:input_type -> example.DeleteIntPointsRequest
-	2,  // 49: example.IntPointService.Create:output_type -> example.CreateIntPointResponse
-	4,  // 50: example.IntPointService.Read:output_type -> example.ReadIntPointResponse
-	6,  // 51: example.IntPointService.Update:output_type -> example.UpdateIntPointResponse
-	8,  // 52: example.IntPointService.UpdateSet:output_type -> example.UpdateSetIntPointResponse
-	12, // 53: example.IntPointService.List:output_type -> example.ListIntPointResponse
-	13, // 54: example.IntPointService.ListSomething:output_type -> example.ListSomethingResponse
-	11, // 55: example.IntPointService.Delete:output_type -> example.DeleteIntPointResponse
-	25, // 56: example.IntPointService.CustomMethod:output_type -> google.protobuf.Empty
-	14, // 57: example.IntPointService.CreateSomething:output_type -> example.Something
-	2,  // 58: example.IntPointTxn.Create:output_type -> example.CreateIntPointResponse
-	4,  // 59: example.IntPointTxn.Read:output_type -> example.ReadIntPointResponse
-	6,  // 60: example.IntPointTxn.Update:output_type -> example.UpdateIntPointResponse
-	12, // 61: example.IntPointTxn.List:output_type -> example.ListIntPointResponse
-	11, // 62: example.IntPointTxn.Delete:output_type -> example.DeleteIntPointResponse
-	11, // 63: example.IntPointTxn.DeleteSet:output_type -> example.DeleteIntPointResponse
-	25, // 64: example.IntPointTxn.CustomMethod:output_type -> google.protobuf.Empty
-	14, // 65: example.IntPointTxn.CreateSomething:output_type -> example.Something
-	18, // 66: example.CircleService.List:output_type -> example.ListCircleResponse
-	2,  // 67: example.MultipleMethodsAutoGen.CreateA:output_type -> example.CreateIntPointResponse
-	2,  // 68: example.MultipleMethodsAutoGen.CreateB:output_type -> example.CreateIntPointResponse
-	4,  // 69: example.MultipleMethodsAutoGen.ReadA:output_type -> example.ReadIntPointResponse
-	4,  // 70: example.MultipleMethodsAutoGen.ReadB:output_type -> example.ReadIntPointResponse
-	6,  // 71: example.MultipleMethodsAutoGen.UpdateA:output_type -> example.UpdateIntPointResponse
-	6,  // 72: example.MultipleMethodsAutoGen.UpdateB:output_type -> example.UpdateIntPointResponse
-	12, // 73: example.MultipleMethodsAutoGen.ListA:output_type -> example.ListIntPointResponse
-	12, // 74: example.MultipleMethodsAutoGen.ListB:output_type -> example.ListIntPointResponse
-	11, // 75: example.MultipleMethodsAutoGen.DeleteA:output_type -> example.DeleteIntPointResponse
-	11, // 76: example.MultipleMethodsAutoGen.DeleteB:output_type -> example.DeleteIntPointResponse
-	11, // 77: example.MultipleMethodsAutoGen.DeleteSetA:output_type -> example.DeleteIntPointResponse
-	11, // 78: example.MultipleMethodsAutoGen.DeleteSetB:output_type -> example.DeleteIntPointResponse
-	49, // [49:79] is the sub-list for method output_type
-	19, // [19:49] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	0,  // 2: example.CreateSetIntPointRequest.payloads:type_name -> example.IntPoint
+	0,  // 3: example.CreateSetIntPointResponse.results:type_name -> example.IntPoint
+	0,  // 4: example.UpsertIntPointRequest.payload:type_name -> example.IntPoint
+	0,  // 5: example.UpsertIntPointResponse.result:type_name -> example.IntPoint
+	23, // 6: example.ReadIntPointRequest.fields:type_name -> infoblox.api.FieldSelection
+	0,  // 7: example.ReadIntPointResponse.result:type_name -> example.IntPoint
+	0,  // 8: example.UpdateIntPointRequest.payload:type_name -> example.IntPoint
+	24, // 9: example.UpdateIntPointRequest.gerogeri_gegege:type_name -> google.protobuf.FieldMask
+	0,  // 10: example.UpdateIntPointResponse.result:type_name -> example.IntPoint
+	0,  // 11: example.UpdateSetIntPointRequest.objects:type_name -> example.IntPoint
+	24, // 12: example.UpdateSetIntPointRequest.masks:type_name -> google.protobuf.FieldMask
+	0,  // 13: example.UpdateSetIntPointResponse.results:type_name -> example.IntPoint
+	0,  // 14: example.ListIntPointResponse.results:type_name -> example.IntPoint
+	25, // 15: example.ListIntPointResponse.page_info:type_name -> infoblox.api.PageInfo
+	18, // 16: example.ListSomethingResponse.results:type_name -> example.Something
+	25, // 17: example.ListSomethingResponse.page_info:type_name -> infoblox.api.PageInfo
+	26, // 18: example.ListIntPointRequest.filter:type_name -> infoblox.api.Filtering
+	27, // 19: example.ListIntPointRequest.order_by:type_name -> infoblox.api.Sorting
+	23, // 20: example.ListIntPointRequest.fields:type_name -> infoblox.api.FieldSelection
+	28, // 21: example.ListIntPointRequest.paging:type_name -> infoblox.api.Pagination
+	20, // 22: example.ListCircleResponse.results:type_name -> example.Circle
+	1,  // 23: example.IntPointService.Create:input_type -> example.CreateIntPointRequest
+	3,  // 24: example.IntPointService.CreateSet:input_type -> example.CreateSetIntPointRequest
+	5,  // 25: example.IntPointService.Upsert:input_type -> example.UpsertIntPointRequest
+	7,  // 26: example.IntPointService.Read:input_type -> example.ReadIntPointRequest
+	9,  // 27: example.IntPointService.Update:input_type -> example.UpdateIntPointRequest
+	11, // 28: example.IntPointService.UpdateSet:input_type -> example.UpdateSetIntPointRequest
+	19, // 29: example.IntPointService.List:input_type -> example.ListIntPointRequest
+	29, // 30: example.IntPointService.ListSomething:input_type -> google.protobuf.Empty
+	13, // 31: example.IntPointService.Delete:input_type -> example.DeleteIntPointRequest
+	29, // 32: example.IntPointService.CustomMethod:input_type -> google.protobuf.Empty
+	18, // 33: example.IntPointService.CreateSomething:input_type -> example.Something
+	1,  // 34: example.IntPointTxn.Create:input_type -> example.CreateIntPointRequest
+	7,  // 35: example.IntPointTxn.Read:input_type -> example.ReadIntPointRequest
+	9,  // 36: example.IntPointTxn.Update:input_type -> example.UpdateIntPointRequest
+	19, // 37: example.IntPointTxn.List:input_type -> example.ListIntPointRequest
+	13, // 38: example.IntPointTxn.Delete:input_type -> example.DeleteIntPointRequest
+	14, // 39: example.IntPointTxn.DeleteSet:input_type -> example.DeleteIntPointsRequest
+	29, // 40: example.IntPointTxn.CustomMethod:input_type -> google.protobuf.Empty
+	18, // 41: example.IntPointTxn.CreateSomething:input_type -> example.Something
+	21, // 42: example.CircleService.List:input_type -> example.ListCircleRequest
+	1,  // 43: example.MultipleMethodsAutoGen.CreateA:input_type -> example.CreateIntPointRequest
+	1,  // 44: example.MultipleMethodsAutoGen.CreateB:input_type -> example.CreateIntPointRequest
+	7,  // 45: example.MultipleMethodsAutoGen.ReadA:input_type -> example.ReadIntPointRequest
+	7,  // 46: example.MultipleMethodsAutoGen.ReadB:input_type -> example.ReadIntPointRequest
+	9,  // 47: example.MultipleMethodsAutoGen.UpdateA:input_type -> example.UpdateIntPointRequest
+	9,  // 48: example.MultipleMethodsAutoGen.UpdateB:input_type -> example.UpdateIntPointRequest
+	19, // 49: example.MultipleMethodsAutoGen.ListA:input_type -> example.ListIntPointRequest
+	19, // 50: example.MultipleMethodsAutoGen.ListB:input_type -> example.ListIntPointRequest
+	13, // 51: example.MultipleMethodsAutoGen.DeleteA:input_type -> example.DeleteIntPointRequest
+	13, // 52: example.MultipleMethodsAutoGen.DeleteB:input_type -> example.DeleteIntPointRequest
+	14, // 53: example.MultipleMethodsAutoGen.DeleteSetA:input_type -> example.DeleteIntPointsRequest
+	14, // 54: example.MultipleMethodsAutoGen.DeleteSetB:input_type -> example.DeleteIntPointsRequest
+	2,  // 55: example.IntPointService.Create:output_type -> example.CreateIntPointResponse
+	4,  // 56: example.IntPointService.CreateSet:output_type -> example.CreateSetIntPointResponse
+	6,  // 57: example.IntPointService.Upsert:output_type -> example.UpsertIntPointResponse
+	8,  // 58: example.IntPointService.Read:output_type -> example.ReadIntPointResponse
+	10, // 59: example.IntPointService.Update:output_type -> example.UpdateIntPointResponse
+	12, // 60: example.IntPointService.UpdateSet:output_type -> example.UpdateSetIntPointResponse
+	16, // 61: example.IntPointService.List:output_type -> example.ListIntPointResponse
+	17, // 62: example.IntPointService.ListSomething:output_type -> example.ListSomethingResponse
+	15, // 63: example.IntPointService.Delete:output_type -> example.DeleteIntPointResponse
+	29, // 64: example.IntPointService.CustomMethod:output_type -> google.protobuf.Empty
+	18, // 65: example.IntPointService.CreateSomething:output_type -> example.Something
+	2,  // 66: example.IntPointTxn.Create:output_type -> example.CreateIntPointResponse
+	8,  // 67: example.IntPointTxn.Read:output_type -> example.ReadIntPointResponse
+	10, // 68: example.IntPointTxn.Update:output_type -> example.UpdateIntPointResponse
+	16, // 69: example.IntPointTxn.List:output_type -> example.ListIntPointResponse
+	15, // 70: example.IntPointTxn.Delete:output_type -> example.DeleteIntPointResponse
+	15, // 71: example.IntPointTxn.DeleteSet:output_type -> example.DeleteIntPointResponse
+	29, // 72: example.IntPointTxn.CustomMethod:output_type -> google.protobuf.Empty
+	18, // 73: example.IntPointTxn.CreateSomething:output_type -> example.Something
+	22, // 74: example.CircleService.List:output_type -> example.ListCircleResponse
+	2,  // 75: example.MultipleMethodsAutoGen.CreateA:output_type -> example.CreateIntPointResponse
+	2,  // 76: example.MultipleMethodsAutoGen.CreateB:output_type -> example.CreateIntPointResponse
+	8,  // 77: example.MultipleMethodsAutoGen.ReadA:output_type -> example.ReadIntPointResponse
+	8,  // 78: example.MultipleMethodsAutoGen.ReadB:output_type -> example.ReadIntPointResponse
+	10, // 79: example.MultipleMethodsAutoGen.UpdateA:output_type -> example.UpdateIntPointResponse
+	10, // 80: example.MultipleMethodsAutoGen.UpdateB:output_type -> example.UpdateIntPointResponse
+	16, // 81: example.MultipleMethodsAutoGen.ListA:output_type -> example.ListIntPointResponse
+	16, // 82: example.MultipleMethodsAutoGen.ListB:output_type -> example.ListIntPointResponse
+	15, // 83: example.MultipleMethodsAutoGen.DeleteA:output_type -> example.DeleteIntPointResponse
+	15, // 84: example.MultipleMethodsAutoGen.DeleteB:output_type -> example.DeleteIntPointResponse
+	15, // 85: example.MultipleMethodsAutoGen.DeleteSetA:output_type -> example.DeleteIntPointResponse
+	15, // 86: example.MultipleMethodsAutoGen.DeleteSetB:output_type -> example.DeleteIntPointResponse
+	55, // [55:87] is the sub-list for method output_type
+	23, // [23:55] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_example_feature_demo_demo_service_proto_init() }
@@ -1437,7 +1672,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadIntPointRequest); i {
+			switch v := v.(*CreateSetIntPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1449,7 +1684,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadIntPointResponse); i {
+			switch v := v.(*CreateSetIntPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1461,7 +1696,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateIntPointRequest); i {
+			switch v := v.(*UpsertIntPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1473,7 +1708,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateIntPointResponse); i {
+			switch v := v.(*UpsertIntPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1485,7 +1720,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSetIntPointRequest); i {
+			switch v := v.(*ReadIntPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1497,7 +1732,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSetIntPointResponse); i {
+			switch v := v.(*ReadIntPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1509,7 +1744,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteIntPointRequest); i {
+			switch v := v.(*UpdateIntPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1521,7 +1756,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteIntPointsRequest); i {
+			switch v := v.(*UpdateIntPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1533,7 +1768,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteIntPointResponse); i {
+			switch v := v.(*UpdateSetIntPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1545,7 +1780,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListIntPointResponse); i {
+			switch v := v.(*UpdateSetIntPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1557,7 +1792,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSomethingResponse); i {
+			switch v := v.(*DeleteIntPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1569,7 +1804,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Something); i {
+			switch v := v.(*DeleteIntPointsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1581,7 +1816,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListIntPointRequest); i {
+			switch v := v.(*DeleteIntPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1593,7 +1828,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Circle); i {
+			switch v := v.(*ListIntPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1605,7 +1840,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCircleRequest); i {
+			switch v := v.(*ListSomethingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1617,6 +1852,54 @@ func file_example_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_example_feature_demo_demo_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Something); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_feature_demo_demo_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIntPointRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_feature_demo_demo_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Circle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_feature_demo_demo_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCircleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_example_feature_demo_demo_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListCircleResponse); i {
 			case 0:
 				return &v.state
@@ -1635,7 +1918,7 @@ func file_example_feature_demo_demo_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_example_feature_demo_demo_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   4,
 		},
@@ -2,15 +2,16 @@ package example
 
 import (
 	context "context"
+	sql "database/sql"
 	json "encoding/json"
 	fmt "fmt"
 	errors "github.com/edhaight/protoc-gen-gorm/errors"
-	empty "github.com/golang/protobuf/ptypes/empty"
 	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
 	query "github.com/infobloxopen/atlas-app-toolkit/query"
 	gorm "github.com/jinzhu/gorm"
 	trace "go.opencensus.io/trace"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 type IntPointORM struct {
@@ -217,6 +218,24 @@ type CircleWithAfterToPB interface {
 	AfterToPB(context.Context, *Circle) error
 }
 
+// withIntPointTransaction runs fn in a new transaction of db, so that the
+// changes of IntPointORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withIntPointTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreateIntPoint executes a basic gorm create call
 func DefaultCreateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*IntPoint, error) {
 	if in == nil {
@@ -232,7 +251,7 @@ func DefaultCreateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*Int
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -250,6 +269,120 @@ type IntPointORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateIntPointSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateIntPointSet(ctx context.Context, in []*IntPoint, batchSize int, db *gorm.DB) ([]*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*IntPoint
+	if ok, err := withIntPointTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateIntPointSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*IntPointORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&IntPointORM{}).(IntPointORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&IntPointORM{}).(IntPointORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*IntPoint, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// IntPointORMWithBeforeCreateSet called before DefaultCreateIntPointSet inserts the objects
+type IntPointORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*IntPointORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointORMWithAfterCreateSet called after DefaultCreateIntPointSet inserts the objects
+type IntPointORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*IntPointORM, *gorm.DB) error
+}
+
+// DefaultUpsertIntPoint inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	// PostgreSQL and SQLite share the ON CONFLICT clause
+	option := "ON CONFLICT (id) DO UPDATE SET x = excluded.x, y = excluded.y"
+	if db.Dialect().GetName() == "mysql" {
+		option = "ON DUPLICATE KEY UPDATE x = VALUES(x), y = VALUES(y)"
+	}
+	if err = db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := IntPointORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type IntPointORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type IntPointORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
 // DefaultReadIntPoint executes a basic gorm read call
 func DefaultReadIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB, fs *query.FieldSelection) (*IntPoint, error) {
 	if in == nil {
@@ -277,7 +410,7 @@ func DefaultReadIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB, fs *que
 	}
 	ormResponse := IntPointORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormResponse).(IntPointORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db, fs); err != nil {
@@ -316,7 +449,7 @@ func DefaultDeleteIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) error
 	}
 	err = db.Where(&ormObj).Delete(&IntPointORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
@@ -331,31 +464,66 @@ type IntPointORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+// DefaultDeleteIntPointSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
 func DefaultDeleteIntPointSet(ctx context.Context, in []*IntPoint, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withIntPointTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteIntPointSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*IntPointORM, len(in))
 	keys := []uint32{}
-	for _, obj := range in {
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == 0 {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
 	if hook, ok := (interface{}(&IntPointORM{})).(IntPointORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&IntPointORM{}).Error
-	if err != nil {
+	before := []*IntPointORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[uint32]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
+	if err = db.Where("id IN (?)", keys).Delete(&IntPointORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
 	if hook, ok := (interface{}(&IntPointORM{})).(IntPointORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -372,14 +540,14 @@ type IntPointORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateIntPoint clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*IntPoint, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateIntPoint")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	lockedRow := &IntPointORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -391,7 +559,7 @@ func DefaultStrictUpdateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB)
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
@@ -470,25 +638,150 @@ type IntPointWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *IntPoint, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetIntPoint executes a bulk gorm update call with patch behavior
+// DefaultPatchSetIntPoint executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
 func DefaultPatchSetIntPoint(ctx context.Context, objects []*IntPoint, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*IntPoint, error) {
 	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
 	}
-
-	results := make([]*IntPoint, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchIntPoint(ctx, patcher, updateMasks[i], db)
+	var out []*IntPoint
+	if ok, err := withIntPointTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetIntPoint(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
 		if err != nil {
 			return nil, err
 		}
-
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*IntPointORM, len(objects))
+	hookedRows := make([]*IntPointORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]uint32, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(IntPointWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &IntPointORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&IntPointORM{}).(IntPointORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*IntPointORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[uint32]*IntPointORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *IntPoint, updateMask *field_mask.FieldMask, row *IntPointORM, db *gorm.DB) (*IntPoint, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(IntPointWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskIntPoint(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*IntPoint, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *IntPoint, in *IntPoint, updateMask *field_mask.FieldMask, db *gorm.DB) (*IntPoint, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(IntPointWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateIntPoint(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(IntPointWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*IntPoint, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
 		results = append(results, pbResponse)
 	}
-
 	return results, nil
 }
 
+// IntPointORMWithBeforePatchSetRead called before DefaultPatchSetIntPoint reads the rows
+type IntPointORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*IntPoint, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
 // DefaultApplyFieldMaskIntPoint patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskIntPoint(ctx context.Context, patchee *IntPoint, patcher *IntPoint, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*IntPoint, error) {
 	if patcher == nil {
@@ -517,6 +810,180 @@ func DefaultApplyFieldMaskIntPoint(ctx context.Context, patchee *IntPoint, patch
 	return patchee, nil
 }
 
+// The columns of IntPointORM
+const (
+	IntPointORMColumnId = "id"
+	IntPointORMColumnX  = "x"
+	IntPointORMColumnY  = "y"
+)
+
+// IntPointORMQueryBuilder builds a query of IntPointORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type IntPointORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// IntPointORMQuery returns an empty query builder of IntPointORM
+func IntPointORMQuery() *IntPointORMQueryBuilder {
+	return &IntPointORMQueryBuilder{}
+}
+
+func (q *IntPointORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *IntPointORMQueryBuilder {
+	return &IntPointORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *IntPointORMQueryBuilder) where(query string, args ...interface{}) *IntPointORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *IntPointORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// IdEq adds the condition id = v
+func (q *IntPointORMQueryBuilder) IdEq(v uint32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *IntPointORMQueryBuilder) IdNe(v uint32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *IntPointORMQueryBuilder) IdGt(v uint32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *IntPointORMQueryBuilder) IdGe(v uint32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *IntPointORMQueryBuilder) IdLt(v uint32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *IntPointORMQueryBuilder) IdLe(v uint32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *IntPointORMQueryBuilder) IdIn(vs ...uint32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnId+" IN (?)", vs)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *IntPointORMQueryBuilder) OrderById(desc bool) *IntPointORMQueryBuilder {
+	order := IntPointORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// XEq adds the condition x = v
+func (q *IntPointORMQueryBuilder) XEq(v int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnX+" = ?", v)
+}
+
+// XNe adds the condition x <> v
+func (q *IntPointORMQueryBuilder) XNe(v int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnX+" <> ?", v)
+}
+
+// XGt adds the condition x > v
+func (q *IntPointORMQueryBuilder) XGt(v int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnX+" > ?", v)
+}
+
+// XGe adds the condition x >= v
+func (q *IntPointORMQueryBuilder) XGe(v int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnX+" >= ?", v)
+}
+
+// XLt adds the condition x < v
+func (q *IntPointORMQueryBuilder) XLt(v int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnX+" < ?", v)
+}
+
+// XLe adds the condition x <= v
+func (q *IntPointORMQueryBuilder) XLe(v int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnX+" <= ?", v)
+}
+
+// XIn adds the condition x IN (vs)
+func (q *IntPointORMQueryBuilder) XIn(vs ...int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnX+" IN (?)", vs)
+}
+
+// OrderByX adds the order by x, descending when desc
+func (q *IntPointORMQueryBuilder) OrderByX(desc bool) *IntPointORMQueryBuilder {
+	order := IntPointORMColumnX
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// YEq adds the condition y = v
+func (q *IntPointORMQueryBuilder) YEq(v int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnY+" = ?", v)
+}
+
+// YNe adds the condition y <> v
+func (q *IntPointORMQueryBuilder) YNe(v int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnY+" <> ?", v)
+}
+
+// YGt adds the condition y > v
+func (q *IntPointORMQueryBuilder) YGt(v int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnY+" > ?", v)
+}
+
+// YGe adds the condition y >= v
+func (q *IntPointORMQueryBuilder) YGe(v int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnY+" >= ?", v)
+}
+
+// YLt adds the condition y < v
+func (q *IntPointORMQueryBuilder) YLt(v int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnY+" < ?", v)
+}
+
+// YLe adds the condition y <= v
+func (q *IntPointORMQueryBuilder) YLe(v int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnY+" <= ?", v)
+}
+
+// YIn adds the condition y IN (vs)
+func (q *IntPointORMQueryBuilder) YIn(vs ...int32) *IntPointORMQueryBuilder {
+	return q.where(IntPointORMColumnY+" IN (?)", vs)
+}
+
+// OrderByY adds the order by y, descending when desc
+func (q *IntPointORMQueryBuilder) OrderByY(desc bool) *IntPointORMQueryBuilder {
+	order := IntPointORMColumnY
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
 // DefaultListIntPoint executes a gorm list call
 func DefaultListIntPoint(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*IntPoint, error) {
 	in := IntPoint{}
@@ -542,7 +1009,7 @@ func DefaultListIntPoint(ctx context.Context, db *gorm.DB, f *query.Filtering, s
 	db = db.Order("id")
 	ormResponse := []IntPointORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse, f, s, p, fs); err != nil {
@@ -570,6 +1037,55 @@ type IntPointORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]IntPointORM, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) error
 }
 
+// DefaultCountIntPoint returns the count of the rows DefaultListIntPoint lists, without
+// ordering and paging them
+func DefaultCountIntPoint(ctx context.Context, db *gorm.DB, f *query.Filtering) (int64, error) {
+	in := IntPoint{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithListCount); ok {
+		if count, ok, err := hook.ListCount(ctx, db, f); err != nil || ok {
+			return count, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &IntPointORM{}, &IntPoint{}, f, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	db = db.Where(&ormObj)
+	var count int64
+	if err := db.Model(&IntPointORM{}).Count(&count).Error; err != nil {
+		return 0, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	return count, nil
+}
+
+// IntPointORMWithListCount replaces the count of DefaultCountIntPoint when ok, e.g.
+// to skip counting or to estimate the count of huge tables
+type IntPointORMWithListCount interface {
+	ListCount(context.Context, *gorm.DB, *query.Filtering) (count int64, ok bool, err error)
+}
+
+// withSomethingTransaction runs fn in a new transaction of db, so that the
+// changes of SomethingORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withSomethingTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreateSomething executes a basic gorm create call
 func DefaultCreateSomething(ctx context.Context, in *Something, db *gorm.DB) (*Something, error) {
 	if in == nil {
@@ -585,7 +1101,7 @@ func DefaultCreateSomething(ctx context.Context, in *Something, db *gorm.DB) (*S
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(SomethingORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -603,6 +1119,71 @@ type SomethingORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateSomethingSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateSomethingSet(ctx context.Context, in []*Something, batchSize int, db *gorm.DB) ([]*Something, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*Something
+	if ok, err := withSomethingTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateSomethingSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*SomethingORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&SomethingORM{}).(SomethingORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&SomethingORM{}).(SomethingORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*Something, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// SomethingORMWithBeforeCreateSet called before DefaultCreateSomethingSet inserts the objects
+type SomethingORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*SomethingORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// SomethingORMWithAfterCreateSet called after DefaultCreateSomethingSet inserts the objects
+type SomethingORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*SomethingORM, *gorm.DB) error
+}
+
 // DefaultApplyFieldMaskSomething patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskSomething(ctx context.Context, patchee *Something, patcher *Something, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Something, error) {
 	if patcher == nil {
@@ -623,6 +1204,91 @@ func DefaultApplyFieldMaskSomething(ctx context.Context, patchee *Something, pat
 	return patchee, nil
 }
 
+// The columns of SomethingORM
+const (
+	SomethingORMColumnField = "field"
+)
+
+// SomethingORMQueryBuilder builds a query of SomethingORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type SomethingORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// SomethingORMQuery returns an empty query builder of SomethingORM
+func SomethingORMQuery() *SomethingORMQueryBuilder {
+	return &SomethingORMQueryBuilder{}
+}
+
+func (q *SomethingORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *SomethingORMQueryBuilder {
+	return &SomethingORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *SomethingORMQueryBuilder) where(query string, args ...interface{}) *SomethingORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *SomethingORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// FieldEq adds the condition field = v
+func (q *SomethingORMQueryBuilder) FieldEq(v string) *SomethingORMQueryBuilder {
+	return q.where(SomethingORMColumnField+" = ?", v)
+}
+
+// FieldNe adds the condition field <> v
+func (q *SomethingORMQueryBuilder) FieldNe(v string) *SomethingORMQueryBuilder {
+	return q.where(SomethingORMColumnField+" <> ?", v)
+}
+
+// FieldGt adds the condition field > v
+func (q *SomethingORMQueryBuilder) FieldGt(v string) *SomethingORMQueryBuilder {
+	return q.where(SomethingORMColumnField+" > ?", v)
+}
+
+// FieldGe adds the condition field >= v
+func (q *SomethingORMQueryBuilder) FieldGe(v string) *SomethingORMQueryBuilder {
+	return q.where(SomethingORMColumnField+" >= ?", v)
+}
+
+// FieldLt adds the condition field < v
+func (q *SomethingORMQueryBuilder) FieldLt(v string) *SomethingORMQueryBuilder {
+	return q.where(SomethingORMColumnField+" < ?", v)
+}
+
+// FieldLe adds the condition field <= v
+func (q *SomethingORMQueryBuilder) FieldLe(v string) *SomethingORMQueryBuilder {
+	return q.where(SomethingORMColumnField+" <= ?", v)
+}
+
+// FieldIn adds the condition field IN (vs)
+func (q *SomethingORMQueryBuilder) FieldIn(vs ...string) *SomethingORMQueryBuilder {
+	return q.where(SomethingORMColumnField+" IN (?)", vs)
+}
+
+// FieldLike adds the condition field LIKE pattern
+func (q *SomethingORMQueryBuilder) FieldLike(pattern string) *SomethingORMQueryBuilder {
+	return q.where(SomethingORMColumnField+" LIKE ?", pattern)
+}
+
+// OrderByField adds the order by field, descending when desc
+func (q *SomethingORMQueryBuilder) OrderByField(desc bool) *SomethingORMQueryBuilder {
+	order := SomethingORMColumnField
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
 // DefaultListSomething executes a gorm list call
 func DefaultListSomething(ctx context.Context, db *gorm.DB) ([]*Something, error) {
 	in := Something{}
@@ -647,7 +1313,7 @@ func DefaultListSomething(ctx context.Context, db *gorm.DB) ([]*Something, error
 	db = db.Where(&ormObj)
 	ormResponse := []SomethingORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(SomethingORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -675,6 +1341,24 @@ type SomethingORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]SomethingORM) error
 }
 
+// withCircleTransaction runs fn in a new transaction of db, so that the
+// changes of CircleORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withCircleTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreateCircle executes a basic gorm create call
 func DefaultCreateCircle(ctx context.Context, in *Circle, db *gorm.DB) (*Circle, error) {
 	if in == nil {
@@ -690,7 +1374,7 @@ func DefaultCreateCircle(ctx context.Context, in *Circle, db *gorm.DB) (*Circle,
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(CircleORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -708,6 +1392,71 @@ type CircleORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateCircleSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateCircleSet(ctx context.Context, in []*Circle, batchSize int, db *gorm.DB) ([]*Circle, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*Circle
+	if ok, err := withCircleTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateCircleSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*CircleORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&CircleORM{}).(CircleORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&CircleORM{}).(CircleORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*Circle, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// CircleORMWithBeforeCreateSet called before DefaultCreateCircleSet inserts the objects
+type CircleORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*CircleORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// CircleORMWithAfterCreateSet called after DefaultCreateCircleSet inserts the objects
+type CircleORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*CircleORM, *gorm.DB) error
+}
+
 // DefaultApplyFieldMaskCircle patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskCircle(ctx context.Context, patchee *Circle, patcher *Circle, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Circle, error) {
 	if patcher == nil {
@@ -728,6 +1477,86 @@ func DefaultApplyFieldMaskCircle(ctx context.Context, patchee *Circle, patcher *
 	return patchee, nil
 }
 
+// The columns of CircleORM
+const (
+	CircleORMColumnR = "r"
+)
+
+// CircleORMQueryBuilder builds a query of CircleORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type CircleORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// CircleORMQuery returns an empty query builder of CircleORM
+func CircleORMQuery() *CircleORMQueryBuilder {
+	return &CircleORMQueryBuilder{}
+}
+
+func (q *CircleORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *CircleORMQueryBuilder {
+	return &CircleORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *CircleORMQueryBuilder) where(query string, args ...interface{}) *CircleORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *CircleORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// REq adds the condition r = v
+func (q *CircleORMQueryBuilder) REq(v uint32) *CircleORMQueryBuilder {
+	return q.where(CircleORMColumnR+" = ?", v)
+}
+
+// RNe adds the condition r <> v
+func (q *CircleORMQueryBuilder) RNe(v uint32) *CircleORMQueryBuilder {
+	return q.where(CircleORMColumnR+" <> ?", v)
+}
+
+// RGt adds the condition r > v
+func (q *CircleORMQueryBuilder) RGt(v uint32) *CircleORMQueryBuilder {
+	return q.where(CircleORMColumnR+" > ?", v)
+}
+
+// RGe adds the condition r >= v
+func (q *CircleORMQueryBuilder) RGe(v uint32) *CircleORMQueryBuilder {
+	return q.where(CircleORMColumnR+" >= ?", v)
+}
+
+// RLt adds the condition r < v
+func (q *CircleORMQueryBuilder) RLt(v uint32) *CircleORMQueryBuilder {
+	return q.where(CircleORMColumnR+" < ?", v)
+}
+
+// RLe adds the condition r <= v
+func (q *CircleORMQueryBuilder) RLe(v uint32) *CircleORMQueryBuilder {
+	return q.where(CircleORMColumnR+" <= ?", v)
+}
+
+// RIn adds the condition r IN (vs)
+func (q *CircleORMQueryBuilder) RIn(vs ...uint32) *CircleORMQueryBuilder {
+	return q.where(CircleORMColumnR+" IN (?)", vs)
+}
+
+// OrderByR adds the order by r, descending when desc
+func (q *CircleORMQueryBuilder) OrderByR(desc bool) *CircleORMQueryBuilder {
+	order := CircleORMColumnR
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
 // DefaultListCircle executes a gorm list call
 func DefaultListCircle(ctx context.Context, db *gorm.DB) ([]*Circle, error) {
 	in := Circle{}
@@ -752,7 +1581,7 @@ func DefaultListCircle(ctx context.Context, db *gorm.DB) ([]*Circle, error) {
 	db = db.Where(&ormObj)
 	ormResponse := []CircleORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(CircleORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -781,6 +1610,20 @@ type CircleORMWithAfterListFind interface {
 }
 type IntPointServiceDefaultServer struct {
 	DB *gorm.DB
+	// ErrorTranslator converts the errors returned by the methods, errors.StatusTranslator
+	// is used when nil
+	ErrorTranslator errors.Translator
+	// BatchSize is the number of objects inserted at once by the CreateSet methods,
+	// all of them when 0
+	BatchSize int
+}
+
+// translateError converts err with the ErrorTranslator of the server
+func (m *IntPointServiceDefaultServer) translateError(err error) error {
+	if m.ErrorTranslator == nil {
+		return errors.StatusTranslator.Translate(err)
+	}
+	return m.ErrorTranslator.Translate(err)
 }
 
 // Create ...
@@ -789,18 +1632,18 @@ func (m *IntPointServiceDefaultServer) Create(ctx context.Context, in *CreateInt
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeCreate); ok {
 		var err error
 		if db, err = custom.BeforeCreate(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &CreateIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterCreate); ok {
 		var err error
 		if err = custom.AfterCreate(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -816,24 +1659,90 @@ type IntPointServiceIntPointWithAfterCreate interface {
 	AfterCreate(context.Context, *CreateIntPointResponse, *gorm.DB) error
 }
 
+// CreateSet ...
+func (m *IntPointServiceDefaultServer) CreateSet(ctx context.Context, in *CreateSetIntPointRequest) (*CreateSetIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeCreateSet); ok {
+		var err error
+		if db, err = custom.BeforeCreateSet(ctx, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	res, err := DefaultCreateIntPointSet(ctx, in.GetPayloads(), m.BatchSize, db)
+	if err != nil {
+		return nil, m.translateError(err)
+	}
+	out := &CreateSetIntPointResponse{Results: res}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterCreateSet); ok {
+		var err error
+		if err = custom.AfterCreateSet(ctx, out, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeCreateSet called before DefaultCreateSetIntPoint in the default CreateSet handler
+type IntPointServiceIntPointWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterCreateSet called before DefaultCreateSetIntPoint in the default CreateSet handler
+type IntPointServiceIntPointWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, *CreateSetIntPointResponse, *gorm.DB) error
+}
+
+// Upsert ...
+func (m *IntPointServiceDefaultServer) Upsert(ctx context.Context, in *UpsertIntPointRequest) (*UpsertIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeUpsert); ok {
+		var err error
+		if db, err = custom.BeforeUpsert(ctx, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	res, err := DefaultUpsertIntPoint(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, m.translateError(err)
+	}
+	out := &UpsertIntPointResponse{Result: res}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterUpsert); ok {
+		var err error
+		if err = custom.AfterUpsert(ctx, out, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeUpsert called before DefaultUpsertIntPoint in the default Upsert handler
+type IntPointServiceIntPointWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterUpsert called before DefaultUpsertIntPoint in the default Upsert handler
+type IntPointServiceIntPointWithAfterUpsert interface {
+	AfterUpsert(context.Context, *UpsertIntPointResponse, *gorm.DB) error
+}
+
 // Read ...
 func (m *IntPointServiceDefaultServer) Read(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
 	db := m.DB
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeRead); ok {
 		var err error
 		if db, err = custom.BeforeRead(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &ReadIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterRead); ok {
 		var err error
 		if err = custom.AfterRead(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -857,18 +1766,18 @@ func (m *IntPointServiceDefaultServer) Update(ctx context.Context, in *UpdateInt
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeUpdate); ok {
 		var err error
 		if db, err = custom.BeforeUpdate(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &UpdateIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterUpdate); ok {
 		var err error
 		if err = custom.AfterUpdate(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -887,7 +1796,7 @@ type IntPointServiceIntPointWithAfterUpdate interface {
 // UpdateSet ...
 func (m *IntPointServiceDefaultServer) UpdateSet(ctx context.Context, in *UpdateSetIntPointRequest) (*UpdateSetIntPointResponse, error) {
 	if in == nil {
-		return nil, errors.NilArgumentError
+		return nil, m.translateError(errors.NilArgumentError)
 	}
 
 	db := m.DB
@@ -895,13 +1804,13 @@ func (m *IntPointServiceDefaultServer) UpdateSet(ctx context.Context, in *Update
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeUpdateSet); ok {
 		var err error
 		if db, err = custom.BeforeUpdateSet(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 
 	res, err := DefaultPatchSetIntPoint(ctx, in.GetObjects(), in.GetMasks(), db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 
 	out := &UpdateSetIntPointResponse{Results: res}
@@ -909,7 +1818,7 @@ func (m *IntPointServiceDefaultServer) UpdateSet(ctx context.Context, in *Update
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterUpdateSet); ok {
 		var err error
 		if err = custom.AfterUpdateSet(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 
@@ -932,7 +1841,7 @@ func (m *IntPointServiceDefaultServer) List(ctx context.Context, in *ListIntPoin
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeList); ok {
 		var err error
 		if db, err = custom.BeforeList(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	pagedRequest := false
@@ -942,7 +1851,7 @@ func (m *IntPointServiceDefaultServer) List(ctx context.Context, in *ListIntPoin
 	}
 	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	var resPaging *query.PageInfo
 	if pagedRequest {
@@ -955,11 +1864,18 @@ func (m *IntPointServiceDefaultServer) List(ctx context.Context, in *ListIntPoin
 		}
 		resPaging = &query.PageInfo{Offset: offset}
 	}
+	if resPaging != nil {
+		count, err := DefaultCountIntPoint(ctx, db, in.Filter)
+		if err != nil {
+			return nil, m.translateError(err)
+		}
+		resPaging.Size = int32(count)
+	}
 	out := &ListIntPointResponse{Results: res, PageInfo: resPaging}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterList); ok {
 		var err error
 		if err = custom.AfterList(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -976,23 +1892,23 @@ type IntPointServiceIntPointWithAfterList interface {
 }
 
 // ListSomething ...
-func (m *IntPointServiceDefaultServer) ListSomething(ctx context.Context, in *empty.Empty) (*ListSomethingResponse, error) {
+func (m *IntPointServiceDefaultServer) ListSomething(ctx context.Context, in *emptypb.Empty) (*ListSomethingResponse, error) {
 	db := m.DB
 	if custom, ok := interface{}(in).(IntPointServiceSomethingWithBeforeListSomething); ok {
 		var err error
 		if db, err = custom.BeforeListSomething(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	res, err := DefaultListSomething(ctx, db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &ListSomethingResponse{Results: res}
 	if custom, ok := interface{}(in).(IntPointServiceSomethingWithAfterListSomething); ok {
 		var err error
 		if err = custom.AfterListSomething(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1014,18 +1930,18 @@ func (m *IntPointServiceDefaultServer) Delete(ctx context.Context, in *DeleteInt
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeDelete); ok {
 		var err error
 		if db, err = custom.BeforeDelete(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterDelete); ok {
 		var err error
 		if err = custom.AfterDelete(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1042,8 +1958,8 @@ type IntPointServiceIntPointWithAfterDelete interface {
 }
 
 // CustomMethod ...
-func (m *IntPointServiceDefaultServer) CustomMethod(ctx context.Context, in *empty.Empty) (*empty.Empty, error) {
-	out := &empty.Empty{}
+func (m *IntPointServiceDefaultServer) CustomMethod(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
+	out := &emptypb.Empty{}
 	return out, nil
 }
 
@@ -1054,6 +1970,17 @@ func (m *IntPointServiceDefaultServer) CreateSomething(ctx context.Context, in *
 }
 
 type IntPointTxnDefaultServer struct {
+	// ErrorTranslator converts the errors returned by the methods, errors.StatusTranslator
+	// is used when nil
+	ErrorTranslator errors.Translator
+}
+
+// translateError converts err with the ErrorTranslator of the server
+func (m *IntPointTxnDefaultServer) translateError(err error) error {
+	if m.ErrorTranslator == nil {
+		return errors.StatusTranslator.Translate(err)
+	}
+	return m.ErrorTranslator.Translate(err)
 }
 
 // spanInit ...
@@ -1104,18 +2031,18 @@ func (m *IntPointTxnDefaultServer) Create(ctx context.Context, in *CreateIntPoin
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeCreate); ok {
 		var err error
 		if db, err = custom.BeforeCreate(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, m.translateError(err))
 		}
 	}
 	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, m.spanError(span, m.translateError(err))
 	}
 	out := &CreateIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterCreate); ok {
 		var err error
 		if err = custom.AfterCreate(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, m.translateError(err))
 		}
 	}
 	errSpanResult := m.spanResult(span, out)
@@ -1153,18 +2080,18 @@ func (m *IntPointTxnDefaultServer) Read(ctx context.Context, in *ReadIntPointReq
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeRead); ok {
 		var err error
 		if db, err = custom.BeforeRead(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, m.translateError(err))
 		}
 	}
 	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, m.spanError(span, m.translateError(err))
 	}
 	out := &ReadIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterRead); ok {
 		var err error
 		if err = custom.AfterRead(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, m.translateError(err))
 		}
 	}
 	errSpanResult := m.spanResult(span, out)
@@ -1204,18 +2131,18 @@ func (m *IntPointTxnDefaultServer) Update(ctx context.Context, in *UpdateIntPoin
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeUpdate); ok {
 		var err error
 		if db, err = custom.BeforeUpdate(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, m.translateError(err))
 		}
 	}
 	res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, m.spanError(span, m.translateError(err))
 	}
 	out := &UpdateIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterUpdate); ok {
 		var err error
 		if err = custom.AfterUpdate(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, m.translateError(err))
 		}
 	}
 	errSpanResult := m.spanResult(span, out)
@@ -1253,7 +2180,7 @@ func (m *IntPointTxnDefaultServer) List(ctx context.Context, in *ListIntPointReq
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeList); ok {
 		var err error
 		if db, err = custom.BeforeList(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, m.translateError(err))
 		}
 	}
 	pagedRequest := false
@@ -1263,7 +2190,7 @@ func (m *IntPointTxnDefaultServer) List(ctx context.Context, in *ListIntPointReq
 	}
 	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, m.spanError(span, m.translateError(err))
 	}
 	var resPaging *query.PageInfo
 	if pagedRequest {
@@ -1276,11 +2203,18 @@ func (m *IntPointTxnDefaultServer) List(ctx context.Context, in *ListIntPointReq
 		}
 		resPaging = &query.PageInfo{Offset: offset}
 	}
+	if resPaging != nil {
+		count, err := DefaultCountIntPoint(ctx, db, in.Filter)
+		if err != nil {
+			return nil, m.spanError(span, m.translateError(err))
+		}
+		resPaging.Size = int32(count)
+	}
 	out := &ListIntPointResponse{Results: res, PageInfo: resPaging}
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterList); ok {
 		var err error
 		if err = custom.AfterList(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, m.translateError(err))
 		}
 	}
 	errSpanResult := m.spanResult(span, out)
@@ -1318,18 +2252,18 @@ func (m *IntPointTxnDefaultServer) Delete(ctx context.Context, in *DeleteIntPoin
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeDelete); ok {
 		var err error
 		if db, err = custom.BeforeDelete(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, m.translateError(err))
 		}
 	}
 	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, m.spanError(span, m.translateError(err))
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterDelete); ok {
 		var err error
 		if err = custom.AfterDelete(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, m.translateError(err))
 		}
 	}
 	errSpanResult := m.spanResult(span, out)
@@ -1371,18 +2305,18 @@ func (m *IntPointTxnDefaultServer) DeleteSet(ctx context.Context, in *DeleteIntP
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeDeleteSet); ok {
 		var err error
 		if db, err = custom.BeforeDeleteSet(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, m.translateError(err))
 		}
 	}
 	err := DefaultDeleteIntPointSet(ctx, objs, db)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, m.spanError(span, m.translateError(err))
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterDeleteSet); ok {
 		var err error
 		if err = custom.AfterDeleteSet(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, m.translateError(err))
 		}
 	}
 	errSpanResult := m.spanResult(span, out)
@@ -1403,13 +2337,13 @@ type IntPointTxnIntPointWithAfterDeleteSet interface {
 }
 
 // CustomMethod ...
-func (m *IntPointTxnDefaultServer) CustomMethod(ctx context.Context, in *empty.Empty) (*empty.Empty, error) {
+func (m *IntPointTxnDefaultServer) CustomMethod(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
 	span, errSpanCreate := m.spanCreate(ctx, in, "CustomMethod")
 	if errSpanCreate != nil {
 		return nil, errSpanCreate
 	}
 	defer span.End()
-	out := &empty.Empty{}
+	out := &emptypb.Empty{}
 	errSpanResult := m.spanResult(span, out)
 	if errSpanResult != nil {
 		return nil, m.spanError(span, errSpanResult)
@@ -1434,6 +2368,17 @@ func (m *IntPointTxnDefaultServer) CreateSomething(ctx context.Context, in *Some
 
 type CircleServiceDefaultServer struct {
 	DB *gorm.DB
+	// ErrorTranslator converts the errors returned by the methods, errors.StatusTranslator
+	// is used when nil
+	ErrorTranslator errors.Translator
+}
+
+// translateError converts err with the ErrorTranslator of the server
+func (m *CircleServiceDefaultServer) translateError(err error) error {
+	if m.ErrorTranslator == nil {
+		return errors.StatusTranslator.Translate(err)
+	}
+	return m.ErrorTranslator.Translate(err)
 }
 
 // List ...
@@ -1442,18 +2387,18 @@ func (m *CircleServiceDefaultServer) List(ctx context.Context, in *ListCircleReq
 	if custom, ok := interface{}(in).(CircleServiceCircleWithBeforeList); ok {
 		var err error
 		if db, err = custom.BeforeList(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	res, err := DefaultListCircle(ctx, db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &ListCircleResponse{Results: res}
 	if custom, ok := interface{}(in).(CircleServiceCircleWithAfterList); ok {
 		var err error
 		if err = custom.AfterList(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1470,6 +2415,17 @@ type CircleServiceCircleWithAfterList interface {
 }
 type MultipleMethodsAutoGenDefaultServer struct {
 	DB *gorm.DB
+	// ErrorTranslator converts the errors returned by the methods, errors.StatusTranslator
+	// is used when nil
+	ErrorTranslator errors.Translator
+}
+
+// translateError converts err with the ErrorTranslator of the server
+func (m *MultipleMethodsAutoGenDefaultServer) translateError(err error) error {
+	if m.ErrorTranslator == nil {
+		return errors.StatusTranslator.Translate(err)
+	}
+	return m.ErrorTranslator.Translate(err)
 }
 
 // CreateA ...
@@ -1478,18 +2434,18 @@ func (m *MultipleMethodsAutoGenDefaultServer) CreateA(ctx context.Context, in *C
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeCreateA); ok {
 		var err error
 		if db, err = custom.BeforeCreateA(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &CreateIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterCreateA); ok {
 		var err error
 		if err = custom.AfterCreateA(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1511,18 +2467,18 @@ func (m *MultipleMethodsAutoGenDefaultServer) CreateB(ctx context.Context, in *C
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeCreateB); ok {
 		var err error
 		if db, err = custom.BeforeCreateB(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &CreateIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterCreateB); ok {
 		var err error
 		if err = custom.AfterCreateB(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1544,18 +2500,18 @@ func (m *MultipleMethodsAutoGenDefaultServer) ReadA(ctx context.Context, in *Rea
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeReadA); ok {
 		var err error
 		if db, err = custom.BeforeReadA(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &ReadIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterReadA); ok {
 		var err error
 		if err = custom.AfterReadA(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1577,18 +2533,18 @@ func (m *MultipleMethodsAutoGenDefaultServer) ReadB(ctx context.Context, in *Rea
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeReadB); ok {
 		var err error
 		if db, err = custom.BeforeReadB(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &ReadIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterReadB); ok {
 		var err error
 		if err = custom.AfterReadB(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1612,18 +2568,18 @@ func (m *MultipleMethodsAutoGenDefaultServer) UpdateA(ctx context.Context, in *U
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeUpdateA); ok {
 		var err error
 		if db, err = custom.BeforeUpdateA(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &UpdateIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterUpdateA); ok {
 		var err error
 		if err = custom.AfterUpdateA(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1647,18 +2603,18 @@ func (m *MultipleMethodsAutoGenDefaultServer) UpdateB(ctx context.Context, in *U
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeUpdateB); ok {
 		var err error
 		if db, err = custom.BeforeUpdateB(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &UpdateIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterUpdateB); ok {
 		var err error
 		if err = custom.AfterUpdateB(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1680,7 +2636,7 @@ func (m *MultipleMethodsAutoGenDefaultServer) ListA(ctx context.Context, in *Lis
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeListA); ok {
 		var err error
 		if db, err = custom.BeforeListA(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	pagedRequest := false
@@ -1690,7 +2646,7 @@ func (m *MultipleMethodsAutoGenDefaultServer) ListA(ctx context.Context, in *Lis
 	}
 	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	var resPaging *query.PageInfo
 	if pagedRequest {
@@ -1703,11 +2659,18 @@ func (m *MultipleMethodsAutoGenDefaultServer) ListA(ctx context.Context, in *Lis
 		}
 		resPaging = &query.PageInfo{Offset: offset}
 	}
+	if resPaging != nil {
+		count, err := DefaultCountIntPoint(ctx, db, in.Filter)
+		if err != nil {
+			return nil, m.translateError(err)
+		}
+		resPaging.Size = int32(count)
+	}
 	out := &ListIntPointResponse{Results: res, PageInfo: resPaging}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterListA); ok {
 		var err error
 		if err = custom.AfterListA(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1729,7 +2692,7 @@ func (m *MultipleMethodsAutoGenDefaultServer) ListB(ctx context.Context, in *Lis
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeListB); ok {
 		var err error
 		if db, err = custom.BeforeListB(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	pagedRequest := false
@@ -1739,7 +2702,7 @@ func (m *MultipleMethodsAutoGenDefaultServer) ListB(ctx context.Context, in *Lis
 	}
 	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	var resPaging *query.PageInfo
 	if pagedRequest {
@@ -1752,11 +2715,18 @@ func (m *MultipleMethodsAutoGenDefaultServer) ListB(ctx context.Context, in *Lis
 		}
 		resPaging = &query.PageInfo{Offset: offset}
 	}
+	if resPaging != nil {
+		count, err := DefaultCountIntPoint(ctx, db, in.Filter)
+		if err != nil {
+			return nil, m.translateError(err)
+		}
+		resPaging.Size = int32(count)
+	}
 	out := &ListIntPointResponse{Results: res, PageInfo: resPaging}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterListB); ok {
 		var err error
 		if err = custom.AfterListB(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1778,18 +2748,18 @@ func (m *MultipleMethodsAutoGenDefaultServer) DeleteA(ctx context.Context, in *D
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteA); ok {
 		var err error
 		if db, err = custom.BeforeDeleteA(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteA); ok {
 		var err error
 		if err = custom.AfterDeleteA(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1811,18 +2781,18 @@ func (m *MultipleMethodsAutoGenDefaultServer) DeleteB(ctx context.Context, in *D
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteB); ok {
 		var err error
 		if db, err = custom.BeforeDeleteB(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteB); ok {
 		var err error
 		if err = custom.AfterDeleteB(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1848,18 +2818,18 @@ func (m *MultipleMethodsAutoGenDefaultServer) DeleteSetA(ctx context.Context, in
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteSetA); ok {
 		var err error
 		if db, err = custom.BeforeDeleteSetA(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	err := DefaultDeleteIntPointSet(ctx, objs, db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteSetA); ok {
 		var err error
 		if err = custom.AfterDeleteSetA(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -1885,18 +2855,18 @@ func (m *MultipleMethodsAutoGenDefaultServer) DeleteSetB(ctx context.Context, in
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteSetB); ok {
 		var err error
 		if db, err = custom.BeforeDeleteSetB(ctx, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	err := DefaultDeleteIntPointSet(ctx, objs, db)
 	if err != nil {
-		return nil, err
+		return nil, m.translateError(err)
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteSetB); ok {
 		var err error
 		if err = custom.AfterDeleteSetB(ctx, out, db); err != nil {
-			return nil, err
+			return nil, m.translateError(err)
 		}
 	}
 	return out, nil
@@ -24,6 +24,8 @@ type IntPointServiceClient interface {
 	// so multiple objects can have CURDL handlers in the same service, provided
 	// they are given unique suffixes
 	Create(ctx context.Context, in *CreateIntPointRequest, opts ...grpc.CallOption) (*CreateIntPointResponse, error)
+	CreateSet(ctx context.Context, in *CreateSetIntPointRequest, opts ...grpc.CallOption) (*CreateSetIntPointResponse, error)
+	Upsert(ctx context.Context, in *UpsertIntPointRequest, opts ...grpc.CallOption) (*UpsertIntPointResponse, error)
 	Read(ctx context.Context, in *ReadIntPointRequest, opts ...grpc.CallOption) (*ReadIntPointResponse, error)
 	Update(ctx context.Context, in *UpdateIntPointRequest, opts ...grpc.CallOption) (*UpdateIntPointResponse, error)
 	UpdateSet(ctx context.Context, in *UpdateSetIntPointRequest, opts ...grpc.CallOption) (*UpdateSetIntPointResponse, error)
@@ -54,6 +56,24 @@ func (c *intPointServiceClient) Create(ctx context.Context, in *CreateIntPointRe
 	return out, nil
 }
 
+func (c *intPointServiceClient) CreateSet(ctx context.Context, in *CreateSetIntPointRequest, opts ...grpc.CallOption) (*CreateSetIntPointResponse, error) {
+	out := new(CreateSetIntPointResponse)
+	err := c.cc.Invoke(ctx, "/example.IntPointService/CreateSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *intPointServiceClient) Upsert(ctx context.Context, in *UpsertIntPointRequest, opts ...grpc.CallOption) (*UpsertIntPointResponse, error) {
+	out := new(UpsertIntPointResponse)
+	err := c.cc.Invoke(ctx, "/example.IntPointService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *intPointServiceClient) Read(ctx context.Context, in *ReadIntPointRequest, opts ...grpc.CallOption) (*ReadIntPointResponse, error) {
 	out := new(ReadIntPointResponse)
 	err := c.cc.Invoke(ctx, "/example.IntPointService/Read", in, out, opts...)
@@ -135,6 +155,8 @@ type IntPointServiceServer interface {
 	// so multiple objects can have CURDL handlers in the same service, provided
 	// they are given unique suffixes
 	Create(context.Context, *CreateIntPointRequest) (*CreateIntPointResponse, error)
+	CreateSet(context.Context, *CreateSetIntPointRequest) (*CreateSetIntPointResponse, error)
+	Upsert(context.Context, *UpsertIntPointRequest) (*UpsertIntPointResponse, error)
 	Read(context.Context, *ReadIntPointRequest) (*ReadIntPointResponse, error)
 	Update(context.Context, *UpdateIntPointRequest) (*UpdateIntPointResponse, error)
 	UpdateSet(context.Context, *UpdateSetIntPointRequest) (*UpdateSetIntPointResponse, error)
@@ -156,6 +178,12 @@ type UnimplementedIntPointServiceServer struct {
 func (UnimplementedIntPointServiceServer) Create(context.Context, *CreateIntPointRequest) (*CreateIntPointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (UnimplementedIntPointServiceServer) CreateSet(context.Context, *CreateSetIntPointRequest) (*CreateSetIntPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSet not implemented")
+}
+func (UnimplementedIntPointServiceServer) Upsert(context.Context, *UpsertIntPointRequest) (*UpsertIntPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
 func (UnimplementedIntPointServiceServer) Read(context.Context, *ReadIntPointRequest) (*ReadIntPointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
@@ -211,6 +239,42 @@ func _IntPointService_Create_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IntPointService_CreateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSetIntPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntPointServiceServer).CreateSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.IntPointService/CreateSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntPointServiceServer).CreateSet(ctx, req.(*CreateSetIntPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntPointService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertIntPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntPointServiceServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.IntPointService/Upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntPointServiceServer).Upsert(ctx, req.(*UpsertIntPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IntPointService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadIntPointRequest)
 	if err := dec(in); err != nil {
@@ -366,6 +430,14 @@ var IntPointService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _IntPointService_Create_Handler,
 		},
+		{
+			MethodName: "CreateSet",
+			Handler:    _IntPointService_CreateSet_Handler,
+		},
+		{
+			MethodName: "Upsert",
+			Handler:    _IntPointService_Upsert_Handler,
+		},
 		{
 			MethodName: "Read",
 			Handler:    _IntPointService_Read_Handler,
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
-// 	protoc        v3.11.2
+// 	protoc-gen-go v1.25.0
+// 	protoc        (unknown)
 // source: example/feature_demo/demo_types.proto
 
 package example
@@ -10,11 +10,12 @@ import (
 	user "github.com/edhaight/protoc-gen-gorm/example/user"
 	_ "github.com/edhaight/protoc-gen-gorm/options"
 	types "github.com/edhaight/protoc-gen-gorm/types"
-	empty "github.com/golang/protobuf/ptypes/empty"
-	timestamp "github.com/golang/protobuf/ptypes/timestamp"
-	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -26,6 +27,10 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
 // enums are mapped to the their underlying numeric value in the db.
 // This is practical from an API perspective, but tougher for debugging.
 // Strings with validation constraints can be used instead if desired
@@ -97,17 +102,17 @@ type TestTypes struct {
 	// []float32/float64 -> 'float[]'
 	Numbers []int32 `protobuf:"varint,2,rep,packed,name=numbers,proto3" json:"numbers,omitempty"`
 	// a StringValue represents a Nullable string
-	OptionalString *wrappers.StringValue `protobuf:"bytes,3,opt,name=optional_string,json=optionalString,proto3" json:"optional_string,omitempty"`
-	BecomesInt     TestTypesStatus       `protobuf:"varint,4,opt,name=becomes_int,json=becomesInt,proto3,enum=example.TestTypesStatus" json:"becomes_int,omitempty"`
+	OptionalString *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=optional_string,json=optionalString,proto3" json:"optional_string,omitempty"`
+	BecomesInt     TestTypesStatus         `protobuf:"varint,4,opt,name=becomes_int,json=becomesInt,proto3,enum=example.TestTypesStatus" json:"becomes_int,omitempty"`
 	// The Empty type serves no purpose outside of rpc calls and is dropped
 	// automatically from objects
-	Nothingness *empty.Empty `protobuf:"bytes,5,opt,name=nothingness,proto3" json:"nothingness,omitempty"`
+	Nothingness *emptypb.Empty `protobuf:"bytes,5,opt,name=nothingness,proto3" json:"nothingness,omitempty"`
 	// The UUID custom type should act like a StringValue at the API level, but is
 	// automatically converted to and from a uuid.UUID (github.com/satori/go.uuid)
 	Uuid *types.UUID `protobuf:"bytes,6,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	// Timestamps convert to golang's time.Time type, and created_at and
 	// updated_at values are automatically filled by GORM
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// This represents a foreign key to the 'type_with_id' type for associations
 	// This could be hidden from the API (or soon autogenerated).
 	TypeWithIdId uint32 `protobuf:"varint,8,opt,name=type_with_id_id,json=typeWithIdId,proto3" json:"type_with_id_id,omitempty"`
@@ -168,7 +173,7 @@ func (x *TestTypes) GetNumbers() []int32 {
 	return nil
 }
 
-func (x *TestTypes) GetOptionalString() *wrappers.StringValue {
+func (x *TestTypes) GetOptionalString() *wrapperspb.StringValue {
 	if x != nil {
 		return x.OptionalString
 	}
@@ -182,7 +187,7 @@ func (x *TestTypes) GetBecomesInt() TestTypesStatus {
 	return TestTypes_UNKNOWN
 }
 
-func (x *TestTypes) GetNothingness() *empty.Empty {
+func (x *TestTypes) GetNothingness() *emptypb.Empty {
 	if x != nil {
 		return x.Nothingness
 	}
@@ -196,7 +201,7 @@ func (x *TestTypes) GetUuid() *types.UUID {
 	return nil
 }
 
-func (x *TestTypes) GetCreatedAt() *timestamp.Timestamp {
+func (x *TestTypes) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
@@ -251,18 +256,18 @@ type TypeWithID struct {
 	ANestedObject *TestTypes `protobuf:"bytes,4,opt,name=a_nested_object,json=aNestedObject,proto3" json:"a_nested_object,omitempty"`
 	// An in-package and cross-package imported type (in-package can use any
 	// association type, cross-package is limited to belongs_to and many_to_many)
-	Point               *IntPoint             `protobuf:"bytes,5,opt,name=point,proto3" json:"point,omitempty"`
-	User                *user.User            `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
-	Address             *types.InetValue      `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
-	MultiaccountTypeIds []uint32              `protobuf:"varint,8,rep,packed,name=multiaccount_type_ids,json=multiaccountTypeIds,proto3" json:"multiaccount_type_ids,omitempty"`
-	SyntheticField      *APIOnlyType          `protobuf:"bytes,9,opt,name=synthetic_field,json=syntheticField,proto3" json:"synthetic_field,omitempty"`
-	TagTest             float32               `protobuf:"fixed32,10,opt,name=tag_test,json=tagTest,proto3" json:"tag_test,omitempty"`
-	TagSizeTest         string                `protobuf:"bytes,11,opt,name=tag_size_test,json=tagSizeTest,proto3" json:"tag_size_test,omitempty"`
-	FloatField          *wrappers.FloatValue  `protobuf:"bytes,12,opt,name=float_field,json=floatField,proto3" json:"float_field,omitempty"`
-	DoubleField         *wrappers.DoubleValue `protobuf:"bytes,13,opt,name=double_field,json=doubleField,proto3" json:"double_field,omitempty"`
+	Point               *IntPoint               `protobuf:"bytes,5,opt,name=point,proto3" json:"point,omitempty"`
+	User                *user.User              `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	Address             *types.InetValue        `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	MultiaccountTypeIds []uint32                `protobuf:"varint,8,rep,packed,name=multiaccount_type_ids,json=multiaccountTypeIds,proto3" json:"multiaccount_type_ids,omitempty"`
+	SyntheticField      *APIOnlyType            `protobuf:"bytes,9,opt,name=synthetic_field,json=syntheticField,proto3" json:"synthetic_field,omitempty"`
+	TagTest             float32                 `protobuf:"fixed32,10,opt,name=tag_test,json=tagTest,proto3" json:"tag_test,omitempty"`
+	TagSizeTest         string                  `protobuf:"bytes,11,opt,name=tag_size_test,json=tagSizeTest,proto3" json:"tag_size_test,omitempty"`
+	FloatField          *wrapperspb.FloatValue  `protobuf:"bytes,12,opt,name=float_field,json=floatField,proto3" json:"float_field,omitempty"`
+	DoubleField         *wrapperspb.DoubleValue `protobuf:"bytes,13,opt,name=double_field,json=doubleField,proto3" json:"double_field,omitempty"`
 	// Limited support for DB type 'time', implemented via strings (string -> DB && DB -> string)
-	TimeOnly  *types.TimeOnly      `protobuf:"bytes,14,opt,name=time_only,json=timeOnly,proto3" json:"time_only,omitempty"`
-	DeletedAt *timestamp.Timestamp `protobuf:"bytes,15,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	TimeOnly  *types.TimeOnly        `protobuf:"bytes,14,opt,name=time_only,json=timeOnly,proto3" json:"time_only,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 }
 
 func (x *TypeWithID) Reset() {
@@ -374,14 +379,14 @@ func (x *TypeWithID) GetTagSizeTest() string {
 	return ""
 }
 
-func (x *TypeWithID) GetFloatField() *wrappers.FloatValue {
+func (x *TypeWithID) GetFloatField() *wrapperspb.FloatValue {
 	if x != nil {
 		return x.FloatField
 	}
 	return nil
 }
 
-func (x *TypeWithID) GetDoubleField() *wrappers.DoubleValue {
+func (x *TypeWithID) GetDoubleField() *wrapperspb.DoubleValue {
 	if x != nil {
 		return x.DoubleField
 	}
@@ -395,7 +400,7 @@ func (x *TypeWithID) GetTimeOnly() *types.TimeOnly {
 	return nil
 }
 
-func (x *TypeWithID) GetDeletedAt() *timestamp.Timestamp {
+func (x *TypeWithID) GetDeletedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.DeletedAt
 	}
@@ -1273,18 +1278,18 @@ var file_example_feature_demo_demo_types_proto_goTypes = []interface{}{
 	(*TestAssocHandlerAppend)(nil),    // 12: example.TestAssocHandlerAppend
 	(*TestTagAssociation)(nil),        // 13: example.TestTagAssociation
 	(*PrimaryIncluded)(nil),           // 14: example.PrimaryIncluded
-	(*wrappers.StringValue)(nil),      // 15: google.protobuf.StringValue
-	(*empty.Empty)(nil),               // 16: google.protobuf.Empty
+	(*wrapperspb.StringValue)(nil),    // 15: google.protobuf.StringValue
+	(*emptypb.Empty)(nil),             // 16: google.protobuf.Empty
 	(*types.UUID)(nil),                // 17: gorm.types.UUID
-	(*timestamp.Timestamp)(nil),       // 18: google.protobuf.Timestamp
+	(*timestamppb.Timestamp)(nil),     // 18: google.protobuf.Timestamp
 	(*types.JSONValue)(nil),           // 19: gorm.types.JSONValue
 	(*types.UUIDValue)(nil),           // 20: gorm.types.UUIDValue
 	(*types.TimeOnly)(nil),            // 21: gorm.types.TimeOnly
 	(*IntPoint)(nil),                  // 22: example.IntPoint
 	(*user.User)(nil),                 // 23: user.User
 	(*types.InetValue)(nil),           // 24: gorm.types.InetValue
-	(*wrappers.FloatValue)(nil),       // 25: google.protobuf.FloatValue
-	(*wrappers.DoubleValue)(nil),      // 26: google.protobuf.DoubleValue
+	(*wrapperspb.FloatValue)(nil),     // 25: google.protobuf.FloatValue
+	(*wrapperspb.DoubleValue)(nil),    // 26: google.protobuf.DoubleValue
 	(*ExternalChild)(nil),             // 27: example.ExternalChild
 }
 var file_example_feature_demo_demo_types_proto_depIdxs = []int32{
@@ -2,13 +2,12 @@ package example
 
 import (
 	context "context"
+	sql "database/sql"
 	fmt "fmt"
 	errors "github.com/edhaight/protoc-gen-gorm/errors"
 	user "github.com/edhaight/protoc-gen-gorm/example/user"
 	types "github.com/edhaight/protoc-gen-gorm/types"
 	ptypes "github.com/golang/protobuf/ptypes"
-	empty "github.com/golang/protobuf/ptypes/empty"
-	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	auth "github.com/infobloxopen/atlas-app-toolkit/auth"
 	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
 	gorm "github.com/jinzhu/gorm"
@@ -16,6 +15,9 @@ import (
 	pq "github.com/lib/pq"
 	_go "github.com/satori/go.uuid"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	strings "strings"
 	time "time"
 )
@@ -112,7 +114,7 @@ func (m *TestTypesORM) ToPB(ctx context.Context) (TestTypes, error) {
 		copy(to.Numbers, m.Numbers)
 	}
 	if m.OptionalString != nil {
-		to.OptionalString = &wrappers.StringValue{Value: *m.OptionalString}
+		to.OptionalString = &wrapperspb.StringValue{Value: *m.OptionalString}
 	}
 	to.BecomesInt = TestTypesStatus(TestTypesStatus_value[m.BecomesInt])
 	to.Uuid = &types.UUID{Value: m.Uuid.String()}
@@ -314,10 +316,10 @@ func (m *TypeWithIDORM) ToPB(ctx context.Context) (TypeWithID, error) {
 	to.TagTest = m.TagTest
 	to.TagSizeTest = m.TagSizeTest
 	if m.FloatField != nil {
-		to.FloatField = &wrappers.FloatValue{Value: *m.FloatField}
+		to.FloatField = &wrapperspb.FloatValue{Value: *m.FloatField}
 	}
 	if m.DoubleField != nil {
-		to.DoubleField = &wrappers.DoubleValue{Value: *m.DoubleField}
+		to.DoubleField = &wrapperspb.DoubleValue{Value: *m.DoubleField}
 	}
 	if m.TimeOnly != "" {
 		if to.TimeOnly, err = types.TimeOnlyByString(m.TimeOnly); err != nil {
@@ -1262,6 +1264,24 @@ type PrimaryIncludedWithAfterToPB interface {
 	AfterToPB(context.Context, *PrimaryIncluded) error
 }
 
+// withTestTypesTransaction runs fn in a new transaction of db, so that the
+// changes of TestTypesORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withTestTypesTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreateTestTypes executes a basic gorm create call
 func DefaultCreateTestTypes(ctx context.Context, in *TestTypes, db *gorm.DB) (*TestTypes, error) {
 	if in == nil {
@@ -1277,7 +1297,7 @@ func DefaultCreateTestTypes(ctx context.Context, in *TestTypes, db *gorm.DB) (*T
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestTypesORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -1295,6 +1315,71 @@ type TestTypesORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateTestTypesSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateTestTypesSet(ctx context.Context, in []*TestTypes, batchSize int, db *gorm.DB) ([]*TestTypes, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*TestTypes
+	if ok, err := withTestTypesTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateTestTypesSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*TestTypesORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&TestTypesORM{}).(TestTypesORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&TestTypesORM{}).(TestTypesORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*TestTypes, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// TestTypesORMWithBeforeCreateSet called before DefaultCreateTestTypesSet inserts the objects
+type TestTypesORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestTypesORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// TestTypesORMWithAfterCreateSet called after DefaultCreateTestTypesSet inserts the objects
+type TestTypesORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestTypesORM, *gorm.DB) error
+}
+
 // DefaultApplyFieldMaskTestTypes patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, patcher *TestTypes, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTypes, error) {
 	if patcher == nil {
@@ -1305,6 +1390,7 @@ func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, pat
 	var err error
 	var updatedOptionalString bool
 	var updatedNothingness bool
+	var updatedCreatedAt bool
 	var updatedJsonField bool
 	for i, f := range updateMask.Paths {
 		if f == prefix+"ApiOnlyString" {
@@ -1316,12 +1402,13 @@ func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, pat
 			continue
 		}
 		if !updatedOptionalString && strings.HasPrefix(f, prefix+"OptionalString.") {
+			updatedOptionalString = true
 			if patcher.OptionalString == nil {
 				patchee.OptionalString = nil
 				continue
 			}
 			if patchee.OptionalString == nil {
-				patchee.OptionalString = &wrappers.StringValue{}
+				patchee.OptionalString = &wrapperspb.StringValue{}
 			}
 			childMask := &field_mask.FieldMask{}
 			for j := i; j < len(updateMask.Paths); j++ {
@@ -1330,8 +1417,9 @@ func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, pat
 				}
 			}
 			if err := gorm1.MergeWithMask(patcher.OptionalString, patchee.OptionalString, childMask); err != nil {
-				return nil, nil
+				return nil, err
 			}
+			continue
 		}
 		if f == prefix+"OptionalString" {
 			updatedOptionalString = true
@@ -1343,12 +1431,13 @@ func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, pat
 			continue
 		}
 		if !updatedNothingness && strings.HasPrefix(f, prefix+"Nothingness.") {
+			updatedNothingness = true
 			if patcher.Nothingness == nil {
 				patchee.Nothingness = nil
 				continue
 			}
 			if patchee.Nothingness == nil {
-				patchee.Nothingness = &empty.Empty{}
+				patchee.Nothingness = &emptypb.Empty{}
 			}
 			childMask := &field_mask.FieldMask{}
 			for j := i; j < len(updateMask.Paths); j++ {
@@ -1357,8 +1446,9 @@ func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, pat
 				}
 			}
 			if err := gorm1.MergeWithMask(patcher.Nothingness, patchee.Nothingness, childMask); err != nil {
-				return nil, nil
+				return nil, err
 			}
+			continue
 		}
 		if f == prefix+"Nothingness" {
 			updatedNothingness = true
@@ -1369,7 +1459,28 @@ func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, pat
 			patchee.Uuid = patcher.Uuid
 			continue
 		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			updatedCreatedAt = true
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
 			patchee.CreatedAt = patcher.CreatedAt
 			continue
 		}
@@ -1397,6 +1508,545 @@ func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, pat
 	return patchee, nil
 }
 
+// The columns of TestTypesORM
+const (
+	TestTypesORMColumnANestedObjectTypeWithIDId = "a_nested_object_type_with_id_id"
+	TestTypesORMColumnArray                     = "array"
+	TestTypesORMColumnArray2                    = "array2"
+	TestTypesORMColumnBecomesInt                = "becomes_int"
+	TestTypesORMColumnCreatedAt                 = "created_at"
+	TestTypesORMColumnJsonField                 = "json_field"
+	TestTypesORMColumnNullableUuid              = "nullable_uuid"
+	TestTypesORMColumnNumbers                   = "numbers"
+	TestTypesORMColumnOptionalString            = "optional_string"
+	TestTypesORMColumnThingsTypeWithIDId        = "things_type_with_id_id"
+	TestTypesORMColumnTimeOnly                  = "time_only"
+	TestTypesORMColumnTypeWithIdId              = "type_with_id_id"
+	TestTypesORMColumnUuid                      = "uuid"
+)
+
+// TestTypesORMQueryBuilder builds a query of TestTypesORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type TestTypesORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// TestTypesORMQuery returns an empty query builder of TestTypesORM
+func TestTypesORMQuery() *TestTypesORMQueryBuilder {
+	return &TestTypesORMQueryBuilder{}
+}
+
+func (q *TestTypesORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *TestTypesORMQueryBuilder {
+	return &TestTypesORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *TestTypesORMQueryBuilder) where(query string, args ...interface{}) *TestTypesORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *TestTypesORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// ANestedObjectTypeWithIDIdEq adds the condition a_nested_object_type_with_id_id = v
+func (q *TestTypesORMQueryBuilder) ANestedObjectTypeWithIDIdEq(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnANestedObjectTypeWithIDId+" = ?", v)
+}
+
+// ANestedObjectTypeWithIDIdNe adds the condition a_nested_object_type_with_id_id <> v
+func (q *TestTypesORMQueryBuilder) ANestedObjectTypeWithIDIdNe(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnANestedObjectTypeWithIDId+" <> ?", v)
+}
+
+// ANestedObjectTypeWithIDIdGt adds the condition a_nested_object_type_with_id_id > v
+func (q *TestTypesORMQueryBuilder) ANestedObjectTypeWithIDIdGt(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnANestedObjectTypeWithIDId+" > ?", v)
+}
+
+// ANestedObjectTypeWithIDIdGe adds the condition a_nested_object_type_with_id_id >= v
+func (q *TestTypesORMQueryBuilder) ANestedObjectTypeWithIDIdGe(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnANestedObjectTypeWithIDId+" >= ?", v)
+}
+
+// ANestedObjectTypeWithIDIdLt adds the condition a_nested_object_type_with_id_id < v
+func (q *TestTypesORMQueryBuilder) ANestedObjectTypeWithIDIdLt(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnANestedObjectTypeWithIDId+" < ?", v)
+}
+
+// ANestedObjectTypeWithIDIdLe adds the condition a_nested_object_type_with_id_id <= v
+func (q *TestTypesORMQueryBuilder) ANestedObjectTypeWithIDIdLe(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnANestedObjectTypeWithIDId+" <= ?", v)
+}
+
+// ANestedObjectTypeWithIDIdIn adds the condition a_nested_object_type_with_id_id IN (vs)
+func (q *TestTypesORMQueryBuilder) ANestedObjectTypeWithIDIdIn(vs ...uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnANestedObjectTypeWithIDId+" IN (?)", vs)
+}
+
+// ANestedObjectTypeWithIDIdIsNull adds the condition a_nested_object_type_with_id_id IS NULL
+func (q *TestTypesORMQueryBuilder) ANestedObjectTypeWithIDIdIsNull() *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnANestedObjectTypeWithIDId + " IS NULL")
+}
+
+// ANestedObjectTypeWithIDIdIsNotNull adds the condition a_nested_object_type_with_id_id IS NOT NULL
+func (q *TestTypesORMQueryBuilder) ANestedObjectTypeWithIDIdIsNotNull() *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnANestedObjectTypeWithIDId + " IS NOT NULL")
+}
+
+// OrderByANestedObjectTypeWithIDId adds the order by a_nested_object_type_with_id_id, descending when desc
+func (q *TestTypesORMQueryBuilder) OrderByANestedObjectTypeWithIDId(desc bool) *TestTypesORMQueryBuilder {
+	order := TestTypesORMColumnANestedObjectTypeWithIDId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// OrderByArray adds the order by array, descending when desc
+func (q *TestTypesORMQueryBuilder) OrderByArray(desc bool) *TestTypesORMQueryBuilder {
+	order := TestTypesORMColumnArray
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// OrderByArray2 adds the order by array2, descending when desc
+func (q *TestTypesORMQueryBuilder) OrderByArray2(desc bool) *TestTypesORMQueryBuilder {
+	order := TestTypesORMColumnArray2
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// BecomesIntEq adds the condition becomes_int = v
+func (q *TestTypesORMQueryBuilder) BecomesIntEq(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnBecomesInt+" = ?", v)
+}
+
+// BecomesIntNe adds the condition becomes_int <> v
+func (q *TestTypesORMQueryBuilder) BecomesIntNe(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnBecomesInt+" <> ?", v)
+}
+
+// BecomesIntGt adds the condition becomes_int > v
+func (q *TestTypesORMQueryBuilder) BecomesIntGt(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnBecomesInt+" > ?", v)
+}
+
+// BecomesIntGe adds the condition becomes_int >= v
+func (q *TestTypesORMQueryBuilder) BecomesIntGe(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnBecomesInt+" >= ?", v)
+}
+
+// BecomesIntLt adds the condition becomes_int < v
+func (q *TestTypesORMQueryBuilder) BecomesIntLt(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnBecomesInt+" < ?", v)
+}
+
+// BecomesIntLe adds the condition becomes_int <= v
+func (q *TestTypesORMQueryBuilder) BecomesIntLe(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnBecomesInt+" <= ?", v)
+}
+
+// BecomesIntIn adds the condition becomes_int IN (vs)
+func (q *TestTypesORMQueryBuilder) BecomesIntIn(vs ...string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnBecomesInt+" IN (?)", vs)
+}
+
+// BecomesIntLike adds the condition becomes_int LIKE pattern
+func (q *TestTypesORMQueryBuilder) BecomesIntLike(pattern string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnBecomesInt+" LIKE ?", pattern)
+}
+
+// OrderByBecomesInt adds the order by becomes_int, descending when desc
+func (q *TestTypesORMQueryBuilder) OrderByBecomesInt(desc bool) *TestTypesORMQueryBuilder {
+	order := TestTypesORMColumnBecomesInt
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// CreatedAtEq adds the condition created_at = v
+func (q *TestTypesORMQueryBuilder) CreatedAtEq(v time.Time) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnCreatedAt+" = ?", v)
+}
+
+// CreatedAtNe adds the condition created_at <> v
+func (q *TestTypesORMQueryBuilder) CreatedAtNe(v time.Time) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnCreatedAt+" <> ?", v)
+}
+
+// CreatedAtGt adds the condition created_at > v
+func (q *TestTypesORMQueryBuilder) CreatedAtGt(v time.Time) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnCreatedAt+" > ?", v)
+}
+
+// CreatedAtGe adds the condition created_at >= v
+func (q *TestTypesORMQueryBuilder) CreatedAtGe(v time.Time) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnCreatedAt+" >= ?", v)
+}
+
+// CreatedAtLt adds the condition created_at < v
+func (q *TestTypesORMQueryBuilder) CreatedAtLt(v time.Time) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnCreatedAt+" < ?", v)
+}
+
+// CreatedAtLe adds the condition created_at <= v
+func (q *TestTypesORMQueryBuilder) CreatedAtLe(v time.Time) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnCreatedAt+" <= ?", v)
+}
+
+// CreatedAtIn adds the condition created_at IN (vs)
+func (q *TestTypesORMQueryBuilder) CreatedAtIn(vs ...time.Time) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnCreatedAt+" IN (?)", vs)
+}
+
+// CreatedAtIsNull adds the condition created_at IS NULL
+func (q *TestTypesORMQueryBuilder) CreatedAtIsNull() *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnCreatedAt + " IS NULL")
+}
+
+// CreatedAtIsNotNull adds the condition created_at IS NOT NULL
+func (q *TestTypesORMQueryBuilder) CreatedAtIsNotNull() *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnCreatedAt + " IS NOT NULL")
+}
+
+// OrderByCreatedAt adds the order by created_at, descending when desc
+func (q *TestTypesORMQueryBuilder) OrderByCreatedAt(desc bool) *TestTypesORMQueryBuilder {
+	order := TestTypesORMColumnCreatedAt
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// JsonFieldIsNull adds the condition json_field IS NULL
+func (q *TestTypesORMQueryBuilder) JsonFieldIsNull() *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnJsonField + " IS NULL")
+}
+
+// JsonFieldIsNotNull adds the condition json_field IS NOT NULL
+func (q *TestTypesORMQueryBuilder) JsonFieldIsNotNull() *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnJsonField + " IS NOT NULL")
+}
+
+// OrderByJsonField adds the order by json_field, descending when desc
+func (q *TestTypesORMQueryBuilder) OrderByJsonField(desc bool) *TestTypesORMQueryBuilder {
+	order := TestTypesORMColumnJsonField
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// NullableUuidEq adds the condition nullable_uuid = v
+func (q *TestTypesORMQueryBuilder) NullableUuidEq(v _go.UUID) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnNullableUuid+" = ?", v)
+}
+
+// NullableUuidNe adds the condition nullable_uuid <> v
+func (q *TestTypesORMQueryBuilder) NullableUuidNe(v _go.UUID) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnNullableUuid+" <> ?", v)
+}
+
+// NullableUuidIn adds the condition nullable_uuid IN (vs)
+func (q *TestTypesORMQueryBuilder) NullableUuidIn(vs ..._go.UUID) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnNullableUuid+" IN (?)", vs)
+}
+
+// NullableUuidIsNull adds the condition nullable_uuid IS NULL
+func (q *TestTypesORMQueryBuilder) NullableUuidIsNull() *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnNullableUuid + " IS NULL")
+}
+
+// NullableUuidIsNotNull adds the condition nullable_uuid IS NOT NULL
+func (q *TestTypesORMQueryBuilder) NullableUuidIsNotNull() *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnNullableUuid + " IS NOT NULL")
+}
+
+// OrderByNullableUuid adds the order by nullable_uuid, descending when desc
+func (q *TestTypesORMQueryBuilder) OrderByNullableUuid(desc bool) *TestTypesORMQueryBuilder {
+	order := TestTypesORMColumnNullableUuid
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// OrderByNumbers adds the order by numbers, descending when desc
+func (q *TestTypesORMQueryBuilder) OrderByNumbers(desc bool) *TestTypesORMQueryBuilder {
+	order := TestTypesORMColumnNumbers
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// OptionalStringEq adds the condition optional_string = v
+func (q *TestTypesORMQueryBuilder) OptionalStringEq(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnOptionalString+" = ?", v)
+}
+
+// OptionalStringNe adds the condition optional_string <> v
+func (q *TestTypesORMQueryBuilder) OptionalStringNe(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnOptionalString+" <> ?", v)
+}
+
+// OptionalStringGt adds the condition optional_string > v
+func (q *TestTypesORMQueryBuilder) OptionalStringGt(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnOptionalString+" > ?", v)
+}
+
+// OptionalStringGe adds the condition optional_string >= v
+func (q *TestTypesORMQueryBuilder) OptionalStringGe(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnOptionalString+" >= ?", v)
+}
+
+// OptionalStringLt adds the condition optional_string < v
+func (q *TestTypesORMQueryBuilder) OptionalStringLt(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnOptionalString+" < ?", v)
+}
+
+// OptionalStringLe adds the condition optional_string <= v
+func (q *TestTypesORMQueryBuilder) OptionalStringLe(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnOptionalString+" <= ?", v)
+}
+
+// OptionalStringIn adds the condition optional_string IN (vs)
+func (q *TestTypesORMQueryBuilder) OptionalStringIn(vs ...string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnOptionalString+" IN (?)", vs)
+}
+
+// OptionalStringLike adds the condition optional_string LIKE pattern
+func (q *TestTypesORMQueryBuilder) OptionalStringLike(pattern string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnOptionalString+" LIKE ?", pattern)
+}
+
+// OptionalStringIsNull adds the condition optional_string IS NULL
+func (q *TestTypesORMQueryBuilder) OptionalStringIsNull() *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnOptionalString + " IS NULL")
+}
+
+// OptionalStringIsNotNull adds the condition optional_string IS NOT NULL
+func (q *TestTypesORMQueryBuilder) OptionalStringIsNotNull() *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnOptionalString + " IS NOT NULL")
+}
+
+// OrderByOptionalString adds the order by optional_string, descending when desc
+func (q *TestTypesORMQueryBuilder) OrderByOptionalString(desc bool) *TestTypesORMQueryBuilder {
+	order := TestTypesORMColumnOptionalString
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// ThingsTypeWithIDIdEq adds the condition things_type_with_id_id = v
+func (q *TestTypesORMQueryBuilder) ThingsTypeWithIDIdEq(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnThingsTypeWithIDId+" = ?", v)
+}
+
+// ThingsTypeWithIDIdNe adds the condition things_type_with_id_id <> v
+func (q *TestTypesORMQueryBuilder) ThingsTypeWithIDIdNe(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnThingsTypeWithIDId+" <> ?", v)
+}
+
+// ThingsTypeWithIDIdGt adds the condition things_type_with_id_id > v
+func (q *TestTypesORMQueryBuilder) ThingsTypeWithIDIdGt(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnThingsTypeWithIDId+" > ?", v)
+}
+
+// ThingsTypeWithIDIdGe adds the condition things_type_with_id_id >= v
+func (q *TestTypesORMQueryBuilder) ThingsTypeWithIDIdGe(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnThingsTypeWithIDId+" >= ?", v)
+}
+
+// ThingsTypeWithIDIdLt adds the condition things_type_with_id_id < v
+func (q *TestTypesORMQueryBuilder) ThingsTypeWithIDIdLt(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnThingsTypeWithIDId+" < ?", v)
+}
+
+// ThingsTypeWithIDIdLe adds the condition things_type_with_id_id <= v
+func (q *TestTypesORMQueryBuilder) ThingsTypeWithIDIdLe(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnThingsTypeWithIDId+" <= ?", v)
+}
+
+// ThingsTypeWithIDIdIn adds the condition things_type_with_id_id IN (vs)
+func (q *TestTypesORMQueryBuilder) ThingsTypeWithIDIdIn(vs ...uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnThingsTypeWithIDId+" IN (?)", vs)
+}
+
+// ThingsTypeWithIDIdIsNull adds the condition things_type_with_id_id IS NULL
+func (q *TestTypesORMQueryBuilder) ThingsTypeWithIDIdIsNull() *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnThingsTypeWithIDId + " IS NULL")
+}
+
+// ThingsTypeWithIDIdIsNotNull adds the condition things_type_with_id_id IS NOT NULL
+func (q *TestTypesORMQueryBuilder) ThingsTypeWithIDIdIsNotNull() *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnThingsTypeWithIDId + " IS NOT NULL")
+}
+
+// OrderByThingsTypeWithIDId adds the order by things_type_with_id_id, descending when desc
+func (q *TestTypesORMQueryBuilder) OrderByThingsTypeWithIDId(desc bool) *TestTypesORMQueryBuilder {
+	order := TestTypesORMColumnThingsTypeWithIDId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// TimeOnlyEq adds the condition time_only = v
+func (q *TestTypesORMQueryBuilder) TimeOnlyEq(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTimeOnly+" = ?", v)
+}
+
+// TimeOnlyNe adds the condition time_only <> v
+func (q *TestTypesORMQueryBuilder) TimeOnlyNe(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTimeOnly+" <> ?", v)
+}
+
+// TimeOnlyGt adds the condition time_only > v
+func (q *TestTypesORMQueryBuilder) TimeOnlyGt(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTimeOnly+" > ?", v)
+}
+
+// TimeOnlyGe adds the condition time_only >= v
+func (q *TestTypesORMQueryBuilder) TimeOnlyGe(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTimeOnly+" >= ?", v)
+}
+
+// TimeOnlyLt adds the condition time_only < v
+func (q *TestTypesORMQueryBuilder) TimeOnlyLt(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTimeOnly+" < ?", v)
+}
+
+// TimeOnlyLe adds the condition time_only <= v
+func (q *TestTypesORMQueryBuilder) TimeOnlyLe(v string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTimeOnly+" <= ?", v)
+}
+
+// TimeOnlyIn adds the condition time_only IN (vs)
+func (q *TestTypesORMQueryBuilder) TimeOnlyIn(vs ...string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTimeOnly+" IN (?)", vs)
+}
+
+// TimeOnlyLike adds the condition time_only LIKE pattern
+func (q *TestTypesORMQueryBuilder) TimeOnlyLike(pattern string) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTimeOnly+" LIKE ?", pattern)
+}
+
+// OrderByTimeOnly adds the order by time_only, descending when desc
+func (q *TestTypesORMQueryBuilder) OrderByTimeOnly(desc bool) *TestTypesORMQueryBuilder {
+	order := TestTypesORMColumnTimeOnly
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// TypeWithIdIdEq adds the condition type_with_id_id = v
+func (q *TestTypesORMQueryBuilder) TypeWithIdIdEq(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTypeWithIdId+" = ?", v)
+}
+
+// TypeWithIdIdNe adds the condition type_with_id_id <> v
+func (q *TestTypesORMQueryBuilder) TypeWithIdIdNe(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTypeWithIdId+" <> ?", v)
+}
+
+// TypeWithIdIdGt adds the condition type_with_id_id > v
+func (q *TestTypesORMQueryBuilder) TypeWithIdIdGt(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTypeWithIdId+" > ?", v)
+}
+
+// TypeWithIdIdGe adds the condition type_with_id_id >= v
+func (q *TestTypesORMQueryBuilder) TypeWithIdIdGe(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTypeWithIdId+" >= ?", v)
+}
+
+// TypeWithIdIdLt adds the condition type_with_id_id < v
+func (q *TestTypesORMQueryBuilder) TypeWithIdIdLt(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTypeWithIdId+" < ?", v)
+}
+
+// TypeWithIdIdLe adds the condition type_with_id_id <= v
+func (q *TestTypesORMQueryBuilder) TypeWithIdIdLe(v uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTypeWithIdId+" <= ?", v)
+}
+
+// TypeWithIdIdIn adds the condition type_with_id_id IN (vs)
+func (q *TestTypesORMQueryBuilder) TypeWithIdIdIn(vs ...uint32) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnTypeWithIdId+" IN (?)", vs)
+}
+
+// OrderByTypeWithIdId adds the order by type_with_id_id, descending when desc
+func (q *TestTypesORMQueryBuilder) OrderByTypeWithIdId(desc bool) *TestTypesORMQueryBuilder {
+	order := TestTypesORMColumnTypeWithIdId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// UuidEq adds the condition uuid = v
+func (q *TestTypesORMQueryBuilder) UuidEq(v _go.UUID) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnUuid+" = ?", v)
+}
+
+// UuidNe adds the condition uuid <> v
+func (q *TestTypesORMQueryBuilder) UuidNe(v _go.UUID) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnUuid+" <> ?", v)
+}
+
+// UuidIn adds the condition uuid IN (vs)
+func (q *TestTypesORMQueryBuilder) UuidIn(vs ..._go.UUID) *TestTypesORMQueryBuilder {
+	return q.where(TestTypesORMColumnUuid+" IN (?)", vs)
+}
+
+// OrderByUuid adds the order by uuid, descending when desc
+func (q *TestTypesORMQueryBuilder) OrderByUuid(desc bool) *TestTypesORMQueryBuilder {
+	order := TestTypesORMColumnUuid
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
 // DefaultListTestTypes executes a gorm list call
 func DefaultListTestTypes(ctx context.Context, db *gorm.DB) ([]*TestTypes, error) {
 	in := TestTypes{}
@@ -1421,7 +2071,7 @@ func DefaultListTestTypes(ctx context.Context, db *gorm.DB) ([]*TestTypes, error
 	db = db.Where(&ormObj)
 	ormResponse := []TestTypesORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestTypesORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -1449,10 +2099,28 @@ type TestTypesORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]TestTypesORM) error
 }
 
-// DefaultCreateTypeWithID executes a basic gorm create call
-func DefaultCreateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
+// withTypeWithIDTransaction runs fn in a new transaction of db, so that the
+// changes of TypeWithIDORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withTypeWithIDTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
+// DefaultCreateTypeWithID executes a basic gorm create call
+func DefaultCreateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -1464,7 +2132,7 @@ func DefaultCreateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -1482,6 +2150,120 @@ type TypeWithIDORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateTypeWithIDSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateTypeWithIDSet(ctx context.Context, in []*TypeWithID, batchSize int, db *gorm.DB) ([]*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*TypeWithID
+	if ok, err := withTypeWithIDTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateTypeWithIDSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*TypeWithIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&TypeWithIDORM{}).(TypeWithIDORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&TypeWithIDORM{}).(TypeWithIDORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*TypeWithID, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// TypeWithIDORMWithBeforeCreateSet called before DefaultCreateTypeWithIDSet inserts the objects
+type TypeWithIDORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TypeWithIDORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// TypeWithIDORMWithAfterCreateSet called after DefaultCreateTypeWithIDSet inserts the objects
+type TypeWithIDORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TypeWithIDORM, *gorm.DB) error
+}
+
+// DefaultUpsertTypeWithID inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	// PostgreSQL and SQLite share the ON CONFLICT clause
+	option := "ON CONFLICT (id) DO UPDATE SET address = excluded.address, double_field = excluded.double_field, float_field = excluded.float_field, int_point_id = excluded.int_point_id, ip_addr = excluded.ip_addr, multi_account_types = excluded.multi_account_types, tag_size_test = excluded.tag_size_test, tag_test = excluded.tag_test, time_only = excluded.time_only, user_id = excluded.user_id"
+	if db.Dialect().GetName() == "mysql" {
+		option = "ON DUPLICATE KEY UPDATE address = VALUES(address), double_field = VALUES(double_field), float_field = VALUES(float_field), int_point_id = VALUES(int_point_id), ip_addr = VALUES(ip_addr), multi_account_types = VALUES(multi_account_types), tag_size_test = VALUES(tag_size_test), tag_test = VALUES(tag_test), time_only = VALUES(time_only), user_id = VALUES(user_id)"
+	}
+	if err = db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := TypeWithIDORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type TypeWithIDORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
 // DefaultReadTypeWithID executes a basic gorm read call
 func DefaultReadTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
 	if in == nil {
@@ -1509,7 +2291,7 @@ func DefaultReadTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*T
 	}
 	ormResponse := TypeWithIDORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormResponse).(TypeWithIDORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
@@ -1548,7 +2330,7 @@ func DefaultDeleteTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) e
 	}
 	err = db.Where(&ormObj).Delete(&TypeWithIDORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
@@ -1563,31 +2345,66 @@ type TypeWithIDORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+// DefaultDeleteTypeWithIDSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
 func DefaultDeleteTypeWithIDSet(ctx context.Context, in []*TypeWithID, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withTypeWithIDTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteTypeWithIDSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TypeWithIDORM, len(in))
 	keys := []uint32{}
-	for _, obj := range in {
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == 0 {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
 	if hook, ok := (interface{}(&TypeWithIDORM{})).(TypeWithIDORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TypeWithIDORM{}).Error
-	if err != nil {
+	before := []*TypeWithIDORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[uint32]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
+	if err = db.Where("id IN (?)", keys).Delete(&TypeWithIDORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
 	if hook, ok := (interface{}(&TypeWithIDORM{})).(TypeWithIDORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -1604,14 +2421,14 @@ type TypeWithIDORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTypeWithID")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	lockedRow := &TypeWithIDORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -1623,7 +2440,7 @@ func DefaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm
 	}
 	filterANestedObject.ANestedObjectTypeWithIDId = new(uint32)
 	*filterANestedObject.ANestedObjectTypeWithIDId = ormObj.Id
-	if err = db.Where(filterANestedObject).Delete(TestTypesORM{}).Error; err != nil {
+	if err = db.Where(filterANestedObject).Delete(&TestTypesORM{}).Error; err != nil {
 		return nil, err
 	}
 	filterThings := TestTypesORM{}
@@ -1632,7 +2449,7 @@ func DefaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm
 	}
 	filterThings.ThingsTypeWithIDId = new(uint32)
 	*filterThings.ThingsTypeWithIDId = ormObj.Id
-	if err = db.Where(filterThings).Delete(TestTypesORM{}).Error; err != nil {
+	if err = db.Where(filterThings).Delete(&TestTypesORM{}).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeStrictUpdateSave); ok {
@@ -1641,7 +2458,7 @@ func DefaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
@@ -1720,25 +2537,150 @@ type TypeWithIDWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTypeWithID executes a bulk gorm update call with patch behavior
+// DefaultPatchSetTypeWithID executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
 func DefaultPatchSetTypeWithID(ctx context.Context, objects []*TypeWithID, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TypeWithID, error) {
 	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
 	}
-
-	results := make([]*TypeWithID, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTypeWithID(ctx, patcher, updateMasks[i], db)
+	var out []*TypeWithID
+	if ok, err := withTypeWithIDTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetTypeWithID(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
 		if err != nil {
 			return nil, err
 		}
-
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TypeWithIDORM, len(objects))
+	hookedRows := make([]*TypeWithIDORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]uint32, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(TypeWithIDWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &TypeWithIDORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&TypeWithIDORM{}).(TypeWithIDORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*TypeWithIDORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[uint32]*TypeWithIDORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *TypeWithID, updateMask *field_mask.FieldMask, row *TypeWithIDORM, db *gorm.DB) (*TypeWithID, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(TypeWithIDWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskTypeWithID(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*TypeWithID, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *TypeWithID, in *TypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*TypeWithID, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(TypeWithIDWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateTypeWithID(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(TypeWithIDWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*TypeWithID, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
 		results = append(results, pbResponse)
 	}
-
 	return results, nil
 }
 
+// TypeWithIDORMWithBeforePatchSetRead called before DefaultPatchSetTypeWithID reads the rows
+type TypeWithIDORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*TypeWithID, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
 // DefaultApplyFieldMaskTypeWithID patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, patcher *TypeWithID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TypeWithID, error) {
 	if patcher == nil {
@@ -1753,6 +2695,7 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 	var updatedSyntheticField bool
 	var updatedFloatField bool
 	var updatedDoubleField bool
+	var updatedDeletedAt bool
 	for i, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
@@ -1838,6 +2781,7 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 			continue
 		}
 		if !updatedSyntheticField && strings.HasPrefix(f, prefix+"SyntheticField.") {
+			updatedSyntheticField = true
 			if patcher.SyntheticField == nil {
 				patchee.SyntheticField = nil
 				continue
@@ -1852,8 +2796,9 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 				}
 			}
 			if err := gorm1.MergeWithMask(patcher.SyntheticField, patchee.SyntheticField, childMask); err != nil {
-				return nil, nil
+				return nil, err
 			}
+			continue
 		}
 		if f == prefix+"SyntheticField" {
 			updatedSyntheticField = true
@@ -1869,12 +2814,13 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 			continue
 		}
 		if !updatedFloatField && strings.HasPrefix(f, prefix+"FloatField.") {
+			updatedFloatField = true
 			if patcher.FloatField == nil {
 				patchee.FloatField = nil
 				continue
 			}
 			if patchee.FloatField == nil {
-				patchee.FloatField = &wrappers.FloatValue{}
+				patchee.FloatField = &wrapperspb.FloatValue{}
 			}
 			childMask := &field_mask.FieldMask{}
 			for j := i; j < len(updateMask.Paths); j++ {
@@ -1883,8 +2829,9 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 				}
 			}
 			if err := gorm1.MergeWithMask(patcher.FloatField, patchee.FloatField, childMask); err != nil {
-				return nil, nil
+				return nil, err
 			}
+			continue
 		}
 		if f == prefix+"FloatField" {
 			updatedFloatField = true
@@ -1892,12 +2839,13 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 			continue
 		}
 		if !updatedDoubleField && strings.HasPrefix(f, prefix+"DoubleField.") {
+			updatedDoubleField = true
 			if patcher.DoubleField == nil {
 				patchee.DoubleField = nil
 				continue
 			}
 			if patchee.DoubleField == nil {
-				patchee.DoubleField = &wrappers.DoubleValue{}
+				patchee.DoubleField = &wrapperspb.DoubleValue{}
 			}
 			childMask := &field_mask.FieldMask{}
 			for j := i; j < len(updateMask.Paths); j++ {
@@ -1906,8 +2854,9 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 				}
 			}
 			if err := gorm1.MergeWithMask(patcher.DoubleField, patchee.DoubleField, childMask); err != nil {
-				return nil, nil
+				return nil, err
 			}
+			continue
 		}
 		if f == prefix+"DoubleField" {
 			updatedDoubleField = true
@@ -1918,7 +2867,28 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 			patchee.TimeOnly = patcher.TimeOnly
 			continue
 		}
+		if !updatedDeletedAt && strings.HasPrefix(f, prefix+"DeletedAt.") {
+			updatedDeletedAt = true
+			if patcher.DeletedAt == nil {
+				patchee.DeletedAt = nil
+				continue
+			}
+			if patchee.DeletedAt == nil {
+				patchee.DeletedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DeletedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.DeletedAt, patchee.DeletedAt, childMask); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		if f == prefix+"DeletedAt" {
+			updatedDeletedAt = true
 			patchee.DeletedAt = patcher.DeletedAt
 			continue
 		}
@@ -1929,95 +2899,813 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 	return patchee, nil
 }
 
-// DefaultListTypeWithID executes a gorm list call
-func DefaultListTypeWithID(ctx context.Context, db *gorm.DB) ([]*TypeWithID, error) {
-	in := TypeWithID{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TypeWithIDORM{}, &TypeWithID{}, nil, nil, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []TypeWithIDORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse := []*TypeWithID{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
-			return nil, err
-		}
-		pbResponse = append(pbResponse, &temp)
-	}
-	return pbResponse, nil
+// The columns of TypeWithIDORM
+const (
+	TypeWithIDORMColumnAddress           = "address"
+	TypeWithIDORMColumnDeletedAt         = "deleted_at"
+	TypeWithIDORMColumnDoubleField       = "double_field"
+	TypeWithIDORMColumnFloatField        = "float_field"
+	TypeWithIDORMColumnId                = "id"
+	TypeWithIDORMColumnIntPointId        = "int_point_id"
+	TypeWithIDORMColumnIp                = "ip_addr"
+	TypeWithIDORMColumnMultiAccountTypes = "multi_account_types"
+	TypeWithIDORMColumnTagSizeTest       = "tag_size_test"
+	TypeWithIDORMColumnTagTest           = "tag_test"
+	TypeWithIDORMColumnTimeOnly          = "time_only"
+	TypeWithIDORMColumnUserId            = "user_id"
+)
+
+// TypeWithIDORMQueryBuilder builds a query of TypeWithIDORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type TypeWithIDORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
 }
 
-type TypeWithIDORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+// TypeWithIDORMQuery returns an empty query builder of TypeWithIDORM
+func TypeWithIDORMQuery() *TypeWithIDORMQueryBuilder {
+	return &TypeWithIDORMQueryBuilder{}
 }
-type TypeWithIDORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+
+func (q *TypeWithIDORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *TypeWithIDORMQueryBuilder {
+	return &TypeWithIDORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
 }
-type TypeWithIDORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TypeWithIDORM) error
+
+func (q *TypeWithIDORMQueryBuilder) where(query string, args ...interface{}) *TypeWithIDORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
 }
 
-// DefaultCreateMultiaccountTypeWithID executes a basic gorm create call
-func DefaultCreateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
-		}
+// Apply returns db with the conditions, orders and preloads of q
+func (q *TypeWithIDORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return db
 }
 
-type MultiaccountTypeWithIDORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+// AddressIsNull adds the condition address IS NULL
+func (q *TypeWithIDORMQueryBuilder) AddressIsNull() *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnAddress + " IS NULL")
 }
-type MultiaccountTypeWithIDORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// AddressIsNotNull adds the condition address IS NOT NULL
+func (q *TypeWithIDORMQueryBuilder) AddressIsNotNull() *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnAddress + " IS NOT NULL")
 }
 
-// DefaultReadMultiaccountTypeWithID executes a basic gorm read call
-func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
-	if in == nil {
+// OrderByAddress adds the order by address, descending when desc
+func (q *TypeWithIDORMQueryBuilder) OrderByAddress(desc bool) *TypeWithIDORMQueryBuilder {
+	order := TypeWithIDORMColumnAddress
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// DeletedAtEq adds the condition deleted_at = v
+func (q *TypeWithIDORMQueryBuilder) DeletedAtEq(v time.Time) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDeletedAt+" = ?", v)
+}
+
+// DeletedAtNe adds the condition deleted_at <> v
+func (q *TypeWithIDORMQueryBuilder) DeletedAtNe(v time.Time) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDeletedAt+" <> ?", v)
+}
+
+// DeletedAtGt adds the condition deleted_at > v
+func (q *TypeWithIDORMQueryBuilder) DeletedAtGt(v time.Time) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDeletedAt+" > ?", v)
+}
+
+// DeletedAtGe adds the condition deleted_at >= v
+func (q *TypeWithIDORMQueryBuilder) DeletedAtGe(v time.Time) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDeletedAt+" >= ?", v)
+}
+
+// DeletedAtLt adds the condition deleted_at < v
+func (q *TypeWithIDORMQueryBuilder) DeletedAtLt(v time.Time) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDeletedAt+" < ?", v)
+}
+
+// DeletedAtLe adds the condition deleted_at <= v
+func (q *TypeWithIDORMQueryBuilder) DeletedAtLe(v time.Time) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDeletedAt+" <= ?", v)
+}
+
+// DeletedAtIn adds the condition deleted_at IN (vs)
+func (q *TypeWithIDORMQueryBuilder) DeletedAtIn(vs ...time.Time) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDeletedAt+" IN (?)", vs)
+}
+
+// DeletedAtIsNull adds the condition deleted_at IS NULL
+func (q *TypeWithIDORMQueryBuilder) DeletedAtIsNull() *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDeletedAt + " IS NULL")
+}
+
+// DeletedAtIsNotNull adds the condition deleted_at IS NOT NULL
+func (q *TypeWithIDORMQueryBuilder) DeletedAtIsNotNull() *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDeletedAt + " IS NOT NULL")
+}
+
+// OrderByDeletedAt adds the order by deleted_at, descending when desc
+func (q *TypeWithIDORMQueryBuilder) OrderByDeletedAt(desc bool) *TypeWithIDORMQueryBuilder {
+	order := TypeWithIDORMColumnDeletedAt
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// DoubleFieldEq adds the condition double_field = v
+func (q *TypeWithIDORMQueryBuilder) DoubleFieldEq(v float64) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDoubleField+" = ?", v)
+}
+
+// DoubleFieldNe adds the condition double_field <> v
+func (q *TypeWithIDORMQueryBuilder) DoubleFieldNe(v float64) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDoubleField+" <> ?", v)
+}
+
+// DoubleFieldGt adds the condition double_field > v
+func (q *TypeWithIDORMQueryBuilder) DoubleFieldGt(v float64) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDoubleField+" > ?", v)
+}
+
+// DoubleFieldGe adds the condition double_field >= v
+func (q *TypeWithIDORMQueryBuilder) DoubleFieldGe(v float64) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDoubleField+" >= ?", v)
+}
+
+// DoubleFieldLt adds the condition double_field < v
+func (q *TypeWithIDORMQueryBuilder) DoubleFieldLt(v float64) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDoubleField+" < ?", v)
+}
+
+// DoubleFieldLe adds the condition double_field <= v
+func (q *TypeWithIDORMQueryBuilder) DoubleFieldLe(v float64) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDoubleField+" <= ?", v)
+}
+
+// DoubleFieldIn adds the condition double_field IN (vs)
+func (q *TypeWithIDORMQueryBuilder) DoubleFieldIn(vs ...float64) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDoubleField+" IN (?)", vs)
+}
+
+// DoubleFieldIsNull adds the condition double_field IS NULL
+func (q *TypeWithIDORMQueryBuilder) DoubleFieldIsNull() *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDoubleField + " IS NULL")
+}
+
+// DoubleFieldIsNotNull adds the condition double_field IS NOT NULL
+func (q *TypeWithIDORMQueryBuilder) DoubleFieldIsNotNull() *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnDoubleField + " IS NOT NULL")
+}
+
+// OrderByDoubleField adds the order by double_field, descending when desc
+func (q *TypeWithIDORMQueryBuilder) OrderByDoubleField(desc bool) *TypeWithIDORMQueryBuilder {
+	order := TypeWithIDORMColumnDoubleField
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// FloatFieldEq adds the condition float_field = v
+func (q *TypeWithIDORMQueryBuilder) FloatFieldEq(v float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnFloatField+" = ?", v)
+}
+
+// FloatFieldNe adds the condition float_field <> v
+func (q *TypeWithIDORMQueryBuilder) FloatFieldNe(v float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnFloatField+" <> ?", v)
+}
+
+// FloatFieldGt adds the condition float_field > v
+func (q *TypeWithIDORMQueryBuilder) FloatFieldGt(v float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnFloatField+" > ?", v)
+}
+
+// FloatFieldGe adds the condition float_field >= v
+func (q *TypeWithIDORMQueryBuilder) FloatFieldGe(v float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnFloatField+" >= ?", v)
+}
+
+// FloatFieldLt adds the condition float_field < v
+func (q *TypeWithIDORMQueryBuilder) FloatFieldLt(v float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnFloatField+" < ?", v)
+}
+
+// FloatFieldLe adds the condition float_field <= v
+func (q *TypeWithIDORMQueryBuilder) FloatFieldLe(v float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnFloatField+" <= ?", v)
+}
+
+// FloatFieldIn adds the condition float_field IN (vs)
+func (q *TypeWithIDORMQueryBuilder) FloatFieldIn(vs ...float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnFloatField+" IN (?)", vs)
+}
+
+// FloatFieldIsNull adds the condition float_field IS NULL
+func (q *TypeWithIDORMQueryBuilder) FloatFieldIsNull() *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnFloatField + " IS NULL")
+}
+
+// FloatFieldIsNotNull adds the condition float_field IS NOT NULL
+func (q *TypeWithIDORMQueryBuilder) FloatFieldIsNotNull() *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnFloatField + " IS NOT NULL")
+}
+
+// OrderByFloatField adds the order by float_field, descending when desc
+func (q *TypeWithIDORMQueryBuilder) OrderByFloatField(desc bool) *TypeWithIDORMQueryBuilder {
+	order := TypeWithIDORMColumnFloatField
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// IdEq adds the condition id = v
+func (q *TypeWithIDORMQueryBuilder) IdEq(v uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *TypeWithIDORMQueryBuilder) IdNe(v uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *TypeWithIDORMQueryBuilder) IdGt(v uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *TypeWithIDORMQueryBuilder) IdGe(v uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *TypeWithIDORMQueryBuilder) IdLt(v uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *TypeWithIDORMQueryBuilder) IdLe(v uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *TypeWithIDORMQueryBuilder) IdIn(vs ...uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnId+" IN (?)", vs)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *TypeWithIDORMQueryBuilder) OrderById(desc bool) *TypeWithIDORMQueryBuilder {
+	order := TypeWithIDORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// IntPointIdEq adds the condition int_point_id = v
+func (q *TypeWithIDORMQueryBuilder) IntPointIdEq(v uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIntPointId+" = ?", v)
+}
+
+// IntPointIdNe adds the condition int_point_id <> v
+func (q *TypeWithIDORMQueryBuilder) IntPointIdNe(v uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIntPointId+" <> ?", v)
+}
+
+// IntPointIdGt adds the condition int_point_id > v
+func (q *TypeWithIDORMQueryBuilder) IntPointIdGt(v uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIntPointId+" > ?", v)
+}
+
+// IntPointIdGe adds the condition int_point_id >= v
+func (q *TypeWithIDORMQueryBuilder) IntPointIdGe(v uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIntPointId+" >= ?", v)
+}
+
+// IntPointIdLt adds the condition int_point_id < v
+func (q *TypeWithIDORMQueryBuilder) IntPointIdLt(v uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIntPointId+" < ?", v)
+}
+
+// IntPointIdLe adds the condition int_point_id <= v
+func (q *TypeWithIDORMQueryBuilder) IntPointIdLe(v uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIntPointId+" <= ?", v)
+}
+
+// IntPointIdIn adds the condition int_point_id IN (vs)
+func (q *TypeWithIDORMQueryBuilder) IntPointIdIn(vs ...uint32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIntPointId+" IN (?)", vs)
+}
+
+// IntPointIdIsNull adds the condition int_point_id IS NULL
+func (q *TypeWithIDORMQueryBuilder) IntPointIdIsNull() *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIntPointId + " IS NULL")
+}
+
+// IntPointIdIsNotNull adds the condition int_point_id IS NOT NULL
+func (q *TypeWithIDORMQueryBuilder) IntPointIdIsNotNull() *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIntPointId + " IS NOT NULL")
+}
+
+// OrderByIntPointId adds the order by int_point_id, descending when desc
+func (q *TypeWithIDORMQueryBuilder) OrderByIntPointId(desc bool) *TypeWithIDORMQueryBuilder {
+	order := TypeWithIDORMColumnIntPointId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// IpEq adds the condition ip_addr = v
+func (q *TypeWithIDORMQueryBuilder) IpEq(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIp+" = ?", v)
+}
+
+// IpNe adds the condition ip_addr <> v
+func (q *TypeWithIDORMQueryBuilder) IpNe(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIp+" <> ?", v)
+}
+
+// IpGt adds the condition ip_addr > v
+func (q *TypeWithIDORMQueryBuilder) IpGt(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIp+" > ?", v)
+}
+
+// IpGe adds the condition ip_addr >= v
+func (q *TypeWithIDORMQueryBuilder) IpGe(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIp+" >= ?", v)
+}
+
+// IpLt adds the condition ip_addr < v
+func (q *TypeWithIDORMQueryBuilder) IpLt(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIp+" < ?", v)
+}
+
+// IpLe adds the condition ip_addr <= v
+func (q *TypeWithIDORMQueryBuilder) IpLe(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIp+" <= ?", v)
+}
+
+// IpIn adds the condition ip_addr IN (vs)
+func (q *TypeWithIDORMQueryBuilder) IpIn(vs ...string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIp+" IN (?)", vs)
+}
+
+// IpLike adds the condition ip_addr LIKE pattern
+func (q *TypeWithIDORMQueryBuilder) IpLike(pattern string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnIp+" LIKE ?", pattern)
+}
+
+// OrderByIp adds the order by ip_addr, descending when desc
+func (q *TypeWithIDORMQueryBuilder) OrderByIp(desc bool) *TypeWithIDORMQueryBuilder {
+	order := TypeWithIDORMColumnIp
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// OrderByMultiAccountTypes adds the order by multi_account_types, descending when desc
+func (q *TypeWithIDORMQueryBuilder) OrderByMultiAccountTypes(desc bool) *TypeWithIDORMQueryBuilder {
+	order := TypeWithIDORMColumnMultiAccountTypes
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// TagSizeTestEq adds the condition tag_size_test = v
+func (q *TypeWithIDORMQueryBuilder) TagSizeTestEq(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagSizeTest+" = ?", v)
+}
+
+// TagSizeTestNe adds the condition tag_size_test <> v
+func (q *TypeWithIDORMQueryBuilder) TagSizeTestNe(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagSizeTest+" <> ?", v)
+}
+
+// TagSizeTestGt adds the condition tag_size_test > v
+func (q *TypeWithIDORMQueryBuilder) TagSizeTestGt(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagSizeTest+" > ?", v)
+}
+
+// TagSizeTestGe adds the condition tag_size_test >= v
+func (q *TypeWithIDORMQueryBuilder) TagSizeTestGe(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagSizeTest+" >= ?", v)
+}
+
+// TagSizeTestLt adds the condition tag_size_test < v
+func (q *TypeWithIDORMQueryBuilder) TagSizeTestLt(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagSizeTest+" < ?", v)
+}
+
+// TagSizeTestLe adds the condition tag_size_test <= v
+func (q *TypeWithIDORMQueryBuilder) TagSizeTestLe(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagSizeTest+" <= ?", v)
+}
+
+// TagSizeTestIn adds the condition tag_size_test IN (vs)
+func (q *TypeWithIDORMQueryBuilder) TagSizeTestIn(vs ...string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagSizeTest+" IN (?)", vs)
+}
+
+// TagSizeTestLike adds the condition tag_size_test LIKE pattern
+func (q *TypeWithIDORMQueryBuilder) TagSizeTestLike(pattern string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagSizeTest+" LIKE ?", pattern)
+}
+
+// OrderByTagSizeTest adds the order by tag_size_test, descending when desc
+func (q *TypeWithIDORMQueryBuilder) OrderByTagSizeTest(desc bool) *TypeWithIDORMQueryBuilder {
+	order := TypeWithIDORMColumnTagSizeTest
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// TagTestEq adds the condition tag_test = v
+func (q *TypeWithIDORMQueryBuilder) TagTestEq(v float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagTest+" = ?", v)
+}
+
+// TagTestNe adds the condition tag_test <> v
+func (q *TypeWithIDORMQueryBuilder) TagTestNe(v float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagTest+" <> ?", v)
+}
+
+// TagTestGt adds the condition tag_test > v
+func (q *TypeWithIDORMQueryBuilder) TagTestGt(v float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagTest+" > ?", v)
+}
+
+// TagTestGe adds the condition tag_test >= v
+func (q *TypeWithIDORMQueryBuilder) TagTestGe(v float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagTest+" >= ?", v)
+}
+
+// TagTestLt adds the condition tag_test < v
+func (q *TypeWithIDORMQueryBuilder) TagTestLt(v float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagTest+" < ?", v)
+}
+
+// TagTestLe adds the condition tag_test <= v
+func (q *TypeWithIDORMQueryBuilder) TagTestLe(v float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagTest+" <= ?", v)
+}
+
+// TagTestIn adds the condition tag_test IN (vs)
+func (q *TypeWithIDORMQueryBuilder) TagTestIn(vs ...float32) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTagTest+" IN (?)", vs)
+}
+
+// OrderByTagTest adds the order by tag_test, descending when desc
+func (q *TypeWithIDORMQueryBuilder) OrderByTagTest(desc bool) *TypeWithIDORMQueryBuilder {
+	order := TypeWithIDORMColumnTagTest
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// TimeOnlyEq adds the condition time_only = v
+func (q *TypeWithIDORMQueryBuilder) TimeOnlyEq(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTimeOnly+" = ?", v)
+}
+
+// TimeOnlyNe adds the condition time_only <> v
+func (q *TypeWithIDORMQueryBuilder) TimeOnlyNe(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTimeOnly+" <> ?", v)
+}
+
+// TimeOnlyGt adds the condition time_only > v
+func (q *TypeWithIDORMQueryBuilder) TimeOnlyGt(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTimeOnly+" > ?", v)
+}
+
+// TimeOnlyGe adds the condition time_only >= v
+func (q *TypeWithIDORMQueryBuilder) TimeOnlyGe(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTimeOnly+" >= ?", v)
+}
+
+// TimeOnlyLt adds the condition time_only < v
+func (q *TypeWithIDORMQueryBuilder) TimeOnlyLt(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTimeOnly+" < ?", v)
+}
+
+// TimeOnlyLe adds the condition time_only <= v
+func (q *TypeWithIDORMQueryBuilder) TimeOnlyLe(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTimeOnly+" <= ?", v)
+}
+
+// TimeOnlyIn adds the condition time_only IN (vs)
+func (q *TypeWithIDORMQueryBuilder) TimeOnlyIn(vs ...string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTimeOnly+" IN (?)", vs)
+}
+
+// TimeOnlyLike adds the condition time_only LIKE pattern
+func (q *TypeWithIDORMQueryBuilder) TimeOnlyLike(pattern string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnTimeOnly+" LIKE ?", pattern)
+}
+
+// OrderByTimeOnly adds the order by time_only, descending when desc
+func (q *TypeWithIDORMQueryBuilder) OrderByTimeOnly(desc bool) *TypeWithIDORMQueryBuilder {
+	order := TypeWithIDORMColumnTimeOnly
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// UserIdEq adds the condition user_id = v
+func (q *TypeWithIDORMQueryBuilder) UserIdEq(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnUserId+" = ?", v)
+}
+
+// UserIdNe adds the condition user_id <> v
+func (q *TypeWithIDORMQueryBuilder) UserIdNe(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnUserId+" <> ?", v)
+}
+
+// UserIdGt adds the condition user_id > v
+func (q *TypeWithIDORMQueryBuilder) UserIdGt(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnUserId+" > ?", v)
+}
+
+// UserIdGe adds the condition user_id >= v
+func (q *TypeWithIDORMQueryBuilder) UserIdGe(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnUserId+" >= ?", v)
+}
+
+// UserIdLt adds the condition user_id < v
+func (q *TypeWithIDORMQueryBuilder) UserIdLt(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnUserId+" < ?", v)
+}
+
+// UserIdLe adds the condition user_id <= v
+func (q *TypeWithIDORMQueryBuilder) UserIdLe(v string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnUserId+" <= ?", v)
+}
+
+// UserIdIn adds the condition user_id IN (vs)
+func (q *TypeWithIDORMQueryBuilder) UserIdIn(vs ...string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnUserId+" IN (?)", vs)
+}
+
+// UserIdLike adds the condition user_id LIKE pattern
+func (q *TypeWithIDORMQueryBuilder) UserIdLike(pattern string) *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnUserId+" LIKE ?", pattern)
+}
+
+// UserIdIsNull adds the condition user_id IS NULL
+func (q *TypeWithIDORMQueryBuilder) UserIdIsNull() *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnUserId + " IS NULL")
+}
+
+// UserIdIsNotNull adds the condition user_id IS NOT NULL
+func (q *TypeWithIDORMQueryBuilder) UserIdIsNotNull() *TypeWithIDORMQueryBuilder {
+	return q.where(TypeWithIDORMColumnUserId + " IS NOT NULL")
+}
+
+// OrderByUserId adds the order by user_id, descending when desc
+func (q *TypeWithIDORMQueryBuilder) OrderByUserId(desc bool) *TypeWithIDORMQueryBuilder {
+	order := TypeWithIDORMColumnUserId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PreloadANestedObject adds the preload of the ANestedObject association
+func (q *TypeWithIDORMQueryBuilder) PreloadANestedObject() *TypeWithIDORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("ANestedObject")
+	})
+}
+
+// PreloadPoint adds the preload of the Point association
+func (q *TypeWithIDORMQueryBuilder) PreloadPoint() *TypeWithIDORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Point")
+	})
+}
+
+// PreloadThings adds the preload of the Things association
+func (q *TypeWithIDORMQueryBuilder) PreloadThings() *TypeWithIDORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Things")
+	})
+}
+
+// PreloadUser adds the preload of the User association
+func (q *TypeWithIDORMQueryBuilder) PreloadUser() *TypeWithIDORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("User")
+	})
+}
+
+// DefaultListTypeWithID executes a gorm list call
+func DefaultListTypeWithID(ctx context.Context, db *gorm.DB) ([]*TypeWithID, error) {
+	in := TypeWithID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TypeWithIDORM{}, &TypeWithID{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TypeWithIDORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TypeWithID{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TypeWithIDORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TypeWithIDORM) error
+}
+
+// withMultiaccountTypeWithIDTransaction runs fn in a new transaction of db, so that the
+// changes of MultiaccountTypeWithIDORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withMultiaccountTypeWithIDTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
+// DefaultCreateMultiaccountTypeWithID executes a basic gorm create call
+func DefaultCreateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithIDORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateMultiaccountTypeWithIDSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateMultiaccountTypeWithIDSet(ctx context.Context, in []*MultiaccountTypeWithID, batchSize int, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*MultiaccountTypeWithID
+	if ok, err := withMultiaccountTypeWithIDTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateMultiaccountTypeWithIDSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*MultiaccountTypeWithIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&MultiaccountTypeWithIDORM{}).(MultiaccountTypeWithIDORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&MultiaccountTypeWithIDORM{}).(MultiaccountTypeWithIDORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*MultiaccountTypeWithID, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// MultiaccountTypeWithIDORMWithBeforeCreateSet called before DefaultCreateMultiaccountTypeWithIDSet inserts the objects
+type MultiaccountTypeWithIDORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*MultiaccountTypeWithIDORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// MultiaccountTypeWithIDORMWithAfterCreateSet called after DefaultCreateMultiaccountTypeWithIDSet inserts the objects
+type MultiaccountTypeWithIDORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*MultiaccountTypeWithIDORM, *gorm.DB) error
+}
+
+// DefaultReadMultiaccountTypeWithID executes a basic gorm read call
+func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	if in == nil {
 		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
@@ -2042,7 +3730,7 @@ func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountType
 	}
 	ormResponse := MultiaccountTypeWithIDORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormResponse).(MultiaccountTypeWithIDORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
@@ -2081,7 +3769,7 @@ func DefaultDeleteMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTy
 	}
 	err = db.Where(&ormObj).Delete(&MultiaccountTypeWithIDORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
@@ -2096,22 +3784,42 @@ type MultiaccountTypeWithIDORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+// DefaultDeleteMultiaccountTypeWithIDSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
 func DefaultDeleteMultiaccountTypeWithIDSet(ctx context.Context, in []*MultiaccountTypeWithID, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withMultiaccountTypeWithIDTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteMultiaccountTypeWithIDSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*MultiaccountTypeWithIDORM, len(in))
 	keys := []uint64{}
-	for _, obj := range in {
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == 0 {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
 	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
@@ -2121,10 +3829,25 @@ func DefaultDeleteMultiaccountTypeWithIDSet(ctx context.Context, in []*Multiacco
 	if err != nil {
 		return err
 	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&MultiaccountTypeWithIDORM{}).Error
-	if err != nil {
+	before := []*MultiaccountTypeWithIDORM{}
+	if err = db.Where("account_id = ? AND id IN (?)", acctId, keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[uint64]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
+	if err = db.Where("account_id = ? AND id IN (?)", acctId, keys).Delete(&MultiaccountTypeWithIDORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
 	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -2141,7 +3864,7 @@ type MultiaccountTypeWithIDORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateMultiaccountTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMultiaccountTypeWithID")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -2153,7 +3876,7 @@ func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *Multiacc
 	}
 	db = db.Where(map[string]interface{}{"account_id": accountID})
 	lockedRow := &MultiaccountTypeWithIDORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -2165,7 +3888,7 @@ func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *Multiacc
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
@@ -2244,25 +3967,154 @@ type MultiaccountTypeWithIDWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetMultiaccountTypeWithID executes a bulk gorm update call with patch behavior
+// DefaultPatchSetMultiaccountTypeWithID executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
 func DefaultPatchSetMultiaccountTypeWithID(ctx context.Context, objects []*MultiaccountTypeWithID, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
 	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
+	}
+	var out []*MultiaccountTypeWithID
+	if ok, err := withMultiaccountTypeWithIDTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetMultiaccountTypeWithID(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	var err error
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	errs := &errors.MultiError{}
+	ormObjs := make([]*MultiaccountTypeWithIDORM, len(objects))
+	hookedRows := make([]*MultiaccountTypeWithIDORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]uint64, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(MultiaccountTypeWithIDWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &MultiaccountTypeWithIDORM{}
+		if err = odb.Where(map[string]interface{}{"account_id": accountID}).Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&MultiaccountTypeWithIDORM{}).(MultiaccountTypeWithIDORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*MultiaccountTypeWithIDORM{}
+	if len(keys) > 0 {
+		if err = db.Where(map[string]interface{}{"account_id": accountID}).Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[uint64]*MultiaccountTypeWithIDORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, row *MultiaccountTypeWithIDORM, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskMultiaccountTypeWithID(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*MultiaccountTypeWithID, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *MultiaccountTypeWithID, in *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(MultiaccountTypeWithIDWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateMultiaccountTypeWithID(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(MultiaccountTypeWithIDWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
 	}
-
 	results := make([]*MultiaccountTypeWithID, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchMultiaccountTypeWithID(ctx, patcher, updateMasks[i], db)
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
 		if err != nil {
-			return nil, err
+			errs.Add(i, err)
+			return nil, errs
 		}
-
 		results = append(results, pbResponse)
 	}
-
 	return results, nil
 }
 
+// MultiaccountTypeWithIDORMWithBeforePatchSetRead called before DefaultPatchSetMultiaccountTypeWithID reads the rows
+type MultiaccountTypeWithIDORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*MultiaccountTypeWithID, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
 // DefaultApplyFieldMaskMultiaccountTypeWithID patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskMultiaccountTypeWithID(ctx context.Context, patchee *MultiaccountTypeWithID, patcher *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithID, error) {
 	if patcher == nil {
@@ -2287,6 +4139,190 @@ func DefaultApplyFieldMaskMultiaccountTypeWithID(ctx context.Context, patchee *M
 	return patchee, nil
 }
 
+// The columns of MultiaccountTypeWithIDORM
+const (
+	MultiaccountTypeWithIDORMColumnAccountID = "account_id"
+	MultiaccountTypeWithIDORMColumnId        = "id"
+	MultiaccountTypeWithIDORMColumnSomeField = "some_field"
+)
+
+// MultiaccountTypeWithIDORMQueryBuilder builds a query of MultiaccountTypeWithIDORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type MultiaccountTypeWithIDORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// MultiaccountTypeWithIDORMQuery returns an empty query builder of MultiaccountTypeWithIDORM
+func MultiaccountTypeWithIDORMQuery() *MultiaccountTypeWithIDORMQueryBuilder {
+	return &MultiaccountTypeWithIDORMQueryBuilder{}
+}
+
+func (q *MultiaccountTypeWithIDORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *MultiaccountTypeWithIDORMQueryBuilder {
+	return &MultiaccountTypeWithIDORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *MultiaccountTypeWithIDORMQueryBuilder) where(query string, args ...interface{}) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *MultiaccountTypeWithIDORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// AccountIDEq adds the condition account_id = v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) AccountIDEq(v string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnAccountID+" = ?", v)
+}
+
+// AccountIDNe adds the condition account_id <> v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) AccountIDNe(v string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnAccountID+" <> ?", v)
+}
+
+// AccountIDGt adds the condition account_id > v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) AccountIDGt(v string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnAccountID+" > ?", v)
+}
+
+// AccountIDGe adds the condition account_id >= v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) AccountIDGe(v string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnAccountID+" >= ?", v)
+}
+
+// AccountIDLt adds the condition account_id < v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) AccountIDLt(v string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnAccountID+" < ?", v)
+}
+
+// AccountIDLe adds the condition account_id <= v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) AccountIDLe(v string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnAccountID+" <= ?", v)
+}
+
+// AccountIDIn adds the condition account_id IN (vs)
+func (q *MultiaccountTypeWithIDORMQueryBuilder) AccountIDIn(vs ...string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnAccountID+" IN (?)", vs)
+}
+
+// AccountIDLike adds the condition account_id LIKE pattern
+func (q *MultiaccountTypeWithIDORMQueryBuilder) AccountIDLike(pattern string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnAccountID+" LIKE ?", pattern)
+}
+
+// OrderByAccountID adds the order by account_id, descending when desc
+func (q *MultiaccountTypeWithIDORMQueryBuilder) OrderByAccountID(desc bool) *MultiaccountTypeWithIDORMQueryBuilder {
+	order := MultiaccountTypeWithIDORMColumnAccountID
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// IdEq adds the condition id = v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) IdEq(v uint64) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) IdNe(v uint64) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) IdGt(v uint64) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) IdGe(v uint64) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) IdLt(v uint64) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) IdLe(v uint64) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *MultiaccountTypeWithIDORMQueryBuilder) IdIn(vs ...uint64) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnId+" IN (?)", vs)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *MultiaccountTypeWithIDORMQueryBuilder) OrderById(desc bool) *MultiaccountTypeWithIDORMQueryBuilder {
+	order := MultiaccountTypeWithIDORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// SomeFieldEq adds the condition some_field = v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) SomeFieldEq(v string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnSomeField+" = ?", v)
+}
+
+// SomeFieldNe adds the condition some_field <> v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) SomeFieldNe(v string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnSomeField+" <> ?", v)
+}
+
+// SomeFieldGt adds the condition some_field > v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) SomeFieldGt(v string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnSomeField+" > ?", v)
+}
+
+// SomeFieldGe adds the condition some_field >= v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) SomeFieldGe(v string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnSomeField+" >= ?", v)
+}
+
+// SomeFieldLt adds the condition some_field < v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) SomeFieldLt(v string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnSomeField+" < ?", v)
+}
+
+// SomeFieldLe adds the condition some_field <= v
+func (q *MultiaccountTypeWithIDORMQueryBuilder) SomeFieldLe(v string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnSomeField+" <= ?", v)
+}
+
+// SomeFieldIn adds the condition some_field IN (vs)
+func (q *MultiaccountTypeWithIDORMQueryBuilder) SomeFieldIn(vs ...string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnSomeField+" IN (?)", vs)
+}
+
+// SomeFieldLike adds the condition some_field LIKE pattern
+func (q *MultiaccountTypeWithIDORMQueryBuilder) SomeFieldLike(pattern string) *MultiaccountTypeWithIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithIDORMColumnSomeField+" LIKE ?", pattern)
+}
+
+// OrderBySomeField adds the order by some_field, descending when desc
+func (q *MultiaccountTypeWithIDORMQueryBuilder) OrderBySomeField(desc bool) *MultiaccountTypeWithIDORMQueryBuilder {
+	order := MultiaccountTypeWithIDORMColumnSomeField
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
 // DefaultListMultiaccountTypeWithID executes a gorm list call
 func DefaultListMultiaccountTypeWithID(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
 	in := MultiaccountTypeWithID{}
@@ -2312,7 +4348,7 @@ func DefaultListMultiaccountTypeWithID(ctx context.Context, db *gorm.DB) ([]*Mul
 	db = db.Order("id")
 	ormResponse := []MultiaccountTypeWithIDORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -2340,6 +4376,24 @@ type MultiaccountTypeWithIDORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithIDORM) error
 }
 
+// withMultiaccountTypeWithoutIDTransaction runs fn in a new transaction of db, so that the
+// changes of MultiaccountTypeWithoutIDORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withMultiaccountTypeWithoutIDTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreateMultiaccountTypeWithoutID executes a basic gorm create call
 func DefaultCreateMultiaccountTypeWithoutID(ctx context.Context, in *MultiaccountTypeWithoutID, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
 	if in == nil {
@@ -2355,7 +4409,7 @@ func DefaultCreateMultiaccountTypeWithoutID(ctx context.Context, in *Multiaccoun
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -2373,6 +4427,71 @@ type MultiaccountTypeWithoutIDORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateMultiaccountTypeWithoutIDSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateMultiaccountTypeWithoutIDSet(ctx context.Context, in []*MultiaccountTypeWithoutID, batchSize int, db *gorm.DB) ([]*MultiaccountTypeWithoutID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*MultiaccountTypeWithoutID
+	if ok, err := withMultiaccountTypeWithoutIDTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateMultiaccountTypeWithoutIDSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*MultiaccountTypeWithoutIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&MultiaccountTypeWithoutIDORM{}).(MultiaccountTypeWithoutIDORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&MultiaccountTypeWithoutIDORM{}).(MultiaccountTypeWithoutIDORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*MultiaccountTypeWithoutID, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// MultiaccountTypeWithoutIDORMWithBeforeCreateSet called before DefaultCreateMultiaccountTypeWithoutIDSet inserts the objects
+type MultiaccountTypeWithoutIDORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*MultiaccountTypeWithoutIDORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// MultiaccountTypeWithoutIDORMWithAfterCreateSet called after DefaultCreateMultiaccountTypeWithoutIDSet inserts the objects
+type MultiaccountTypeWithoutIDORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*MultiaccountTypeWithoutIDORM, *gorm.DB) error
+}
+
 // DefaultApplyFieldMaskMultiaccountTypeWithoutID patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskMultiaccountTypeWithoutID(ctx context.Context, patchee *MultiaccountTypeWithoutID, patcher *MultiaccountTypeWithoutID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
 	if patcher == nil {
@@ -2393,60 +4512,314 @@ func DefaultApplyFieldMaskMultiaccountTypeWithoutID(ctx context.Context, patchee
 	return patchee, nil
 }
 
-// DefaultListMultiaccountTypeWithoutID executes a gorm list call
-func DefaultListMultiaccountTypeWithoutID(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithoutID, error) {
-	in := MultiaccountTypeWithoutID{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+// The columns of MultiaccountTypeWithoutIDORM
+const (
+	MultiaccountTypeWithoutIDORMColumnAccountID = "account_id"
+	MultiaccountTypeWithoutIDORMColumnSomeField = "some_field"
+)
+
+// MultiaccountTypeWithoutIDORMQueryBuilder builds a query of MultiaccountTypeWithoutIDORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type MultiaccountTypeWithoutIDORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// MultiaccountTypeWithoutIDORMQuery returns an empty query builder of MultiaccountTypeWithoutIDORM
+func MultiaccountTypeWithoutIDORMQuery() *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return &MultiaccountTypeWithoutIDORMQueryBuilder{}
+}
+
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return &MultiaccountTypeWithoutIDORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) where(query string, args ...interface{}) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// AccountIDEq adds the condition account_id = v
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) AccountIDEq(v string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnAccountID+" = ?", v)
+}
+
+// AccountIDNe adds the condition account_id <> v
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) AccountIDNe(v string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnAccountID+" <> ?", v)
+}
+
+// AccountIDGt adds the condition account_id > v
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) AccountIDGt(v string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnAccountID+" > ?", v)
+}
+
+// AccountIDGe adds the condition account_id >= v
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) AccountIDGe(v string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnAccountID+" >= ?", v)
+}
+
+// AccountIDLt adds the condition account_id < v
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) AccountIDLt(v string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnAccountID+" < ?", v)
+}
+
+// AccountIDLe adds the condition account_id <= v
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) AccountIDLe(v string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnAccountID+" <= ?", v)
+}
+
+// AccountIDIn adds the condition account_id IN (vs)
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) AccountIDIn(vs ...string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnAccountID+" IN (?)", vs)
+}
+
+// AccountIDLike adds the condition account_id LIKE pattern
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) AccountIDLike(pattern string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnAccountID+" LIKE ?", pattern)
+}
+
+// OrderByAccountID adds the order by account_id, descending when desc
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) OrderByAccountID(desc bool) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	order := MultiaccountTypeWithoutIDORMColumnAccountID
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// SomeFieldEq adds the condition some_field = v
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) SomeFieldEq(v string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnSomeField+" = ?", v)
+}
+
+// SomeFieldNe adds the condition some_field <> v
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) SomeFieldNe(v string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnSomeField+" <> ?", v)
+}
+
+// SomeFieldGt adds the condition some_field > v
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) SomeFieldGt(v string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnSomeField+" > ?", v)
+}
+
+// SomeFieldGe adds the condition some_field >= v
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) SomeFieldGe(v string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnSomeField+" >= ?", v)
+}
+
+// SomeFieldLt adds the condition some_field < v
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) SomeFieldLt(v string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnSomeField+" < ?", v)
+}
+
+// SomeFieldLe adds the condition some_field <= v
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) SomeFieldLe(v string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnSomeField+" <= ?", v)
+}
+
+// SomeFieldIn adds the condition some_field IN (vs)
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) SomeFieldIn(vs ...string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnSomeField+" IN (?)", vs)
+}
+
+// SomeFieldLike adds the condition some_field LIKE pattern
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) SomeFieldLike(pattern string) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	return q.where(MultiaccountTypeWithoutIDORMColumnSomeField+" LIKE ?", pattern)
+}
+
+// OrderBySomeField adds the order by some_field, descending when desc
+func (q *MultiaccountTypeWithoutIDORMQueryBuilder) OrderBySomeField(desc bool) *MultiaccountTypeWithoutIDORMQueryBuilder {
+	order := MultiaccountTypeWithoutIDORMColumnSomeField
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// DefaultListMultiaccountTypeWithoutID executes a gorm list call
+func DefaultListMultiaccountTypeWithoutID(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithoutID, error) {
+	in := MultiaccountTypeWithoutID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &MultiaccountTypeWithoutIDORM{}, &MultiaccountTypeWithoutID{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	ormResponse := []MultiaccountTypeWithoutIDORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*MultiaccountTypeWithoutID{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithoutIDORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithoutIDORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithoutIDORM) error
+}
+
+// withPrimaryUUIDTypeTransaction runs fn in a new transaction of db, so that the
+// changes of PrimaryUUIDTypeORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withPrimaryUUIDTypeTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
+// DefaultCreatePrimaryUUIDType executes a basic gorm create call
+func DefaultCreatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryUUIDTypeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreatePrimaryUUIDTypeSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreatePrimaryUUIDTypeSet(ctx context.Context, in []*PrimaryUUIDType, batchSize int, db *gorm.DB) ([]*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+	var out []*PrimaryUUIDType
+	if ok, err := withPrimaryUUIDTypeTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreatePrimaryUUIDTypeSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
 			return nil, err
 		}
+		return out, nil
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &MultiaccountTypeWithoutIDORM{}, &MultiaccountTypeWithoutID{}, nil, nil, nil, nil)
-	if err != nil {
-		return nil, err
+	ormObjs := make([]*PrimaryUUIDTypeORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+	var err error
+	if hook, ok := interface{}(&PrimaryUUIDTypeORM{}).(PrimaryUUIDTypeORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	ormResponse := []MultiaccountTypeWithoutIDORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	if hook, ok := interface{}(&PrimaryUUIDTypeORM{}).(PrimaryUUIDTypeORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*MultiaccountTypeWithoutID{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	pbResponse := make([]*PrimaryUUIDType, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		pbResponse = append(pbResponse, &pbObj)
 	}
 	return pbResponse, nil
 }
 
-type MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithoutIDORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+// PrimaryUUIDTypeORMWithBeforeCreateSet called before DefaultCreatePrimaryUUIDTypeSet inserts the objects
+type PrimaryUUIDTypeORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*PrimaryUUIDTypeORM, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithoutIDORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithoutIDORM) error
+
+// PrimaryUUIDTypeORMWithAfterCreateSet called after DefaultCreatePrimaryUUIDTypeSet inserts the objects
+type PrimaryUUIDTypeORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*PrimaryUUIDTypeORM, *gorm.DB) error
 }
 
-// DefaultCreatePrimaryUUIDType executes a basic gorm create call
-func DefaultCreatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+// DefaultUpsertPrimaryUUIDType inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2454,28 +4827,43 @@ func DefaultCreatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	// PostgreSQL and SQLite share the ON CONFLICT clause
+	option := "ON CONFLICT (id) DO UPDATE SET id = excluded.id"
+	if db.Dialect().GetName() == "mysql" {
+		option = "ON DUPLICATE KEY UPDATE id = VALUES(id)"
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
+	if err = db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := PrimaryUUIDTypeORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return &pbResponse, err
 }
 
-type PrimaryUUIDTypeORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type PrimaryUUIDTypeORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+type PrimaryUUIDTypeORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
 }
 
 // DefaultReadPrimaryUUIDType executes a basic gorm read call
@@ -2505,7 +4893,7 @@ func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *go
 	}
 	ormResponse := PrimaryUUIDTypeORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormResponse).(PrimaryUUIDTypeORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
@@ -2544,7 +4932,7 @@ func DefaultDeletePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *
 	}
 	err = db.Where(&ormObj).Delete(&PrimaryUUIDTypeORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
@@ -2559,31 +4947,66 @@ type PrimaryUUIDTypeORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+// DefaultDeletePrimaryUUIDTypeSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
 func DefaultDeletePrimaryUUIDTypeSet(ctx context.Context, in []*PrimaryUUIDType, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withPrimaryUUIDTypeTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeletePrimaryUUIDTypeSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
-	keys := []*_go.UUID{}
-	for _, obj := range in {
+	errs := &errors.MultiError{}
+	ormObjs := make([]*PrimaryUUIDTypeORM, len(in))
+	keys := []_go.UUID{}
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == nil || *ormObj.Id == _go.Nil {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
-		keys = append(keys, ormObj.Id)
+		ormObjs[i] = &ormObj
+		keys = append(keys, *ormObj.Id)
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
 	}
 	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&PrimaryUUIDTypeORM{}).Error
-	if err != nil {
+	before := []*PrimaryUUIDTypeORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[_go.UUID]bool, len(before))
+	for _, row := range before {
+		found[*row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[*ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
+	if err = db.Where("id IN (?)", keys).Delete(&PrimaryUUIDTypeORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
 	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -2600,14 +5023,14 @@ type PrimaryUUIDTypeORMWithAfterDeleteSet interface {
 // DefaultStrictUpdatePrimaryUUIDType clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryUUIDType")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	lockedRow := &PrimaryUUIDTypeORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -2619,7 +5042,7 @@ func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType
 	}
 	filterChild.PrimaryUUIDTypeId = new(_go.UUID)
 	*filterChild.PrimaryUUIDTypeId = *ormObj.Id
-	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+	if err = db.Where(filterChild).Delete(&ExternalChildORM{}).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateSave); ok {
@@ -2628,7 +5051,7 @@ func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
@@ -2707,25 +5130,150 @@ type PrimaryUUIDTypeWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetPrimaryUUIDType executes a bulk gorm update call with patch behavior
+// DefaultPatchSetPrimaryUUIDType executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
 func DefaultPatchSetPrimaryUUIDType(ctx context.Context, objects []*PrimaryUUIDType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryUUIDType, error) {
 	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
 	}
-
-	results := make([]*PrimaryUUIDType, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchPrimaryUUIDType(ctx, patcher, updateMasks[i], db)
+	var out []*PrimaryUUIDType
+	if ok, err := withPrimaryUUIDTypeTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetPrimaryUUIDType(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
 		if err != nil {
 			return nil, err
 		}
-
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*PrimaryUUIDTypeORM, len(objects))
+	hookedRows := make([]*PrimaryUUIDTypeORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]_go.UUID, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == nil || *ormObj.Id == _go.Nil {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(PrimaryUUIDTypeWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, *ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &PrimaryUUIDTypeORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&PrimaryUUIDTypeORM{}).(PrimaryUUIDTypeORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*PrimaryUUIDTypeORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[_go.UUID]*PrimaryUUIDTypeORM, len(rows))
+	for _, row := range rows {
+		stored[*row.Id] = row
+	}
+	patch := func(in *PrimaryUUIDType, updateMask *field_mask.FieldMask, row *PrimaryUUIDTypeORM, db *gorm.DB) (*PrimaryUUIDType, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskPrimaryUUIDType(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*PrimaryUUIDType, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[*ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *PrimaryUUIDType, in *PrimaryUUIDType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryUUIDType, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(PrimaryUUIDTypeWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdatePrimaryUUIDType(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(PrimaryUUIDTypeWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*PrimaryUUIDType, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
 		results = append(results, pbResponse)
 	}
-
 	return results, nil
 }
 
+// PrimaryUUIDTypeORMWithBeforePatchSetRead called before DefaultPatchSetPrimaryUUIDType reads the rows
+type PrimaryUUIDTypeORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*PrimaryUUIDType, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
 // DefaultApplyFieldMaskPrimaryUUIDType patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskPrimaryUUIDType(ctx context.Context, patchee *PrimaryUUIDType, patcher *PrimaryUUIDType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryUUIDType, error) {
 	if patcher == nil {
@@ -2768,6 +5316,83 @@ func DefaultApplyFieldMaskPrimaryUUIDType(ctx context.Context, patchee *PrimaryU
 	return patchee, nil
 }
 
+// The columns of PrimaryUUIDTypeORM
+const (
+	PrimaryUUIDTypeORMColumnId = "id"
+)
+
+// PrimaryUUIDTypeORMQueryBuilder builds a query of PrimaryUUIDTypeORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type PrimaryUUIDTypeORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// PrimaryUUIDTypeORMQuery returns an empty query builder of PrimaryUUIDTypeORM
+func PrimaryUUIDTypeORMQuery() *PrimaryUUIDTypeORMQueryBuilder {
+	return &PrimaryUUIDTypeORMQueryBuilder{}
+}
+
+func (q *PrimaryUUIDTypeORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *PrimaryUUIDTypeORMQueryBuilder {
+	return &PrimaryUUIDTypeORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *PrimaryUUIDTypeORMQueryBuilder) where(query string, args ...interface{}) *PrimaryUUIDTypeORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *PrimaryUUIDTypeORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// IdEq adds the condition id = v
+func (q *PrimaryUUIDTypeORMQueryBuilder) IdEq(v _go.UUID) *PrimaryUUIDTypeORMQueryBuilder {
+	return q.where(PrimaryUUIDTypeORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *PrimaryUUIDTypeORMQueryBuilder) IdNe(v _go.UUID) *PrimaryUUIDTypeORMQueryBuilder {
+	return q.where(PrimaryUUIDTypeORMColumnId+" <> ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *PrimaryUUIDTypeORMQueryBuilder) IdIn(vs ..._go.UUID) *PrimaryUUIDTypeORMQueryBuilder {
+	return q.where(PrimaryUUIDTypeORMColumnId+" IN (?)", vs)
+}
+
+// IdIsNull adds the condition id IS NULL
+func (q *PrimaryUUIDTypeORMQueryBuilder) IdIsNull() *PrimaryUUIDTypeORMQueryBuilder {
+	return q.where(PrimaryUUIDTypeORMColumnId + " IS NULL")
+}
+
+// IdIsNotNull adds the condition id IS NOT NULL
+func (q *PrimaryUUIDTypeORMQueryBuilder) IdIsNotNull() *PrimaryUUIDTypeORMQueryBuilder {
+	return q.where(PrimaryUUIDTypeORMColumnId + " IS NOT NULL")
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *PrimaryUUIDTypeORMQueryBuilder) OrderById(desc bool) *PrimaryUUIDTypeORMQueryBuilder {
+	order := PrimaryUUIDTypeORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PreloadChild adds the preload of the Child association
+func (q *PrimaryUUIDTypeORMQueryBuilder) PreloadChild() *PrimaryUUIDTypeORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Child")
+	})
+}
+
 // DefaultListPrimaryUUIDType executes a gorm list call
 func DefaultListPrimaryUUIDType(ctx context.Context, db *gorm.DB) ([]*PrimaryUUIDType, error) {
 	in := PrimaryUUIDType{}
@@ -2775,54 +5400,171 @@ func DefaultListPrimaryUUIDType(ctx context.Context, db *gorm.DB) ([]*PrimaryUUI
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryUUIDTypeORM{}, &PrimaryUUIDType{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PrimaryUUIDTypeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*PrimaryUUIDType{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PrimaryUUIDTypeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PrimaryUUIDTypeORM) error
+}
+
+// withPrimaryStringTypeTransaction runs fn in a new transaction of db, so that the
+// changes of PrimaryStringTypeORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withPrimaryStringTypeTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
+// DefaultCreatePrimaryStringType executes a basic gorm create call
+func DefaultCreatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryStringTypeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreatePrimaryStringTypeSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreatePrimaryStringTypeSet(ctx context.Context, in []*PrimaryStringType, batchSize int, db *gorm.DB) ([]*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*PrimaryStringType
+	if ok, err := withPrimaryStringTypeTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreatePrimaryStringTypeSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
 			return nil, err
 		}
+		return out, nil
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryUUIDTypeORM{}, &PrimaryUUIDType{}, nil, nil, nil, nil)
-	if err != nil {
-		return nil, err
+	ormObjs := make([]*PrimaryStringTypeORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+	var err error
+	if hook, ok := interface{}(&PrimaryStringTypeORM{}).(PrimaryStringTypeORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []PrimaryUUIDTypeORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	if hook, ok := interface{}(&PrimaryStringTypeORM{}).(PrimaryStringTypeORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*PrimaryUUIDType{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	pbResponse := make([]*PrimaryStringType, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		pbResponse = append(pbResponse, &pbObj)
 	}
 	return pbResponse, nil
 }
 
-type PrimaryUUIDTypeORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryUUIDTypeORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+// PrimaryStringTypeORMWithBeforeCreateSet called before DefaultCreatePrimaryStringTypeSet inserts the objects
+type PrimaryStringTypeORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*PrimaryStringTypeORM, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]PrimaryUUIDTypeORM) error
+
+// PrimaryStringTypeORMWithAfterCreateSet called after DefaultCreatePrimaryStringTypeSet inserts the objects
+type PrimaryStringTypeORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*PrimaryStringTypeORM, *gorm.DB) error
 }
 
-// DefaultCreatePrimaryStringType executes a basic gorm create call
-func DefaultCreatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+// DefaultUpsertPrimaryStringType inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertPrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2830,28 +5572,43 @@ func DefaultCreatePrimaryStringType(ctx context.Context, in *PrimaryStringType,
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	// PostgreSQL and SQLite share the ON CONFLICT clause
+	option := "ON CONFLICT (id) DO UPDATE SET id = excluded.id"
+	if db.Dialect().GetName() == "mysql" {
+		option = "ON DUPLICATE KEY UPDATE id = VALUES(id)"
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
+	if err = db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := PrimaryStringTypeORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return &pbResponse, err
 }
 
-type PrimaryStringTypeORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type PrimaryStringTypeORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+type PrimaryStringTypeORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
 }
 
 // DefaultReadPrimaryStringType executes a basic gorm read call
@@ -2881,7 +5638,7 @@ func DefaultReadPrimaryStringType(ctx context.Context, in *PrimaryStringType, db
 	}
 	ormResponse := PrimaryStringTypeORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormResponse).(PrimaryStringTypeORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
@@ -2920,7 +5677,7 @@ func DefaultDeletePrimaryStringType(ctx context.Context, in *PrimaryStringType,
 	}
 	err = db.Where(&ormObj).Delete(&PrimaryStringTypeORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
@@ -2935,31 +5692,66 @@ type PrimaryStringTypeORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+// DefaultDeletePrimaryStringTypeSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
 func DefaultDeletePrimaryStringTypeSet(ctx context.Context, in []*PrimaryStringType, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withPrimaryStringTypeTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeletePrimaryStringTypeSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*PrimaryStringTypeORM, len(in))
 	keys := []string{}
-	for _, obj := range in {
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == "" {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
 	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&PrimaryStringTypeORM{}).Error
-	if err != nil {
+	before := []*PrimaryStringTypeORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[string]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
+	if err = db.Where("id IN (?)", keys).Delete(&PrimaryStringTypeORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
 	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -2976,14 +5768,14 @@ type PrimaryStringTypeORMWithAfterDeleteSet interface {
 // DefaultStrictUpdatePrimaryStringType clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryStringType")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	lockedRow := &PrimaryStringTypeORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -2995,7 +5787,7 @@ func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryString
 	}
 	filterChild.PrimaryStringTypeId = new(string)
 	*filterChild.PrimaryStringTypeId = ormObj.Id
-	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+	if err = db.Where(filterChild).Delete(&ExternalChildORM{}).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateSave); ok {
@@ -3004,7 +5796,7 @@ func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryString
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
@@ -3083,25 +5875,150 @@ type PrimaryStringTypeWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetPrimaryStringType executes a bulk gorm update call with patch behavior
+// DefaultPatchSetPrimaryStringType executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
 func DefaultPatchSetPrimaryStringType(ctx context.Context, objects []*PrimaryStringType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryStringType, error) {
 	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
 	}
-
-	results := make([]*PrimaryStringType, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchPrimaryStringType(ctx, patcher, updateMasks[i], db)
+	var out []*PrimaryStringType
+	if ok, err := withPrimaryStringTypeTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetPrimaryStringType(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
 		if err != nil {
 			return nil, err
 		}
-
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*PrimaryStringTypeORM, len(objects))
+	hookedRows := make([]*PrimaryStringTypeORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]string, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == "" {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(PrimaryStringTypeWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &PrimaryStringTypeORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&PrimaryStringTypeORM{}).(PrimaryStringTypeORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*PrimaryStringTypeORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[string]*PrimaryStringTypeORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *PrimaryStringType, updateMask *field_mask.FieldMask, row *PrimaryStringTypeORM, db *gorm.DB) (*PrimaryStringType, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskPrimaryStringType(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*PrimaryStringType, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *PrimaryStringType, in *PrimaryStringType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryStringType, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(PrimaryStringTypeWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdatePrimaryStringType(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(PrimaryStringTypeWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*PrimaryStringType, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
 		results = append(results, pbResponse)
 	}
-
 	return results, nil
 }
 
+// PrimaryStringTypeORMWithBeforePatchSetRead called before DefaultPatchSetPrimaryStringType reads the rows
+type PrimaryStringTypeORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*PrimaryStringType, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
 // DefaultApplyFieldMaskPrimaryStringType patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskPrimaryStringType(ctx context.Context, patchee *PrimaryStringType, patcher *PrimaryStringType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryStringType, error) {
 	if patcher == nil {
@@ -3144,6 +6061,98 @@ func DefaultApplyFieldMaskPrimaryStringType(ctx context.Context, patchee *Primar
 	return patchee, nil
 }
 
+// The columns of PrimaryStringTypeORM
+const (
+	PrimaryStringTypeORMColumnId = "id"
+)
+
+// PrimaryStringTypeORMQueryBuilder builds a query of PrimaryStringTypeORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type PrimaryStringTypeORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// PrimaryStringTypeORMQuery returns an empty query builder of PrimaryStringTypeORM
+func PrimaryStringTypeORMQuery() *PrimaryStringTypeORMQueryBuilder {
+	return &PrimaryStringTypeORMQueryBuilder{}
+}
+
+func (q *PrimaryStringTypeORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *PrimaryStringTypeORMQueryBuilder {
+	return &PrimaryStringTypeORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *PrimaryStringTypeORMQueryBuilder) where(query string, args ...interface{}) *PrimaryStringTypeORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *PrimaryStringTypeORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// IdEq adds the condition id = v
+func (q *PrimaryStringTypeORMQueryBuilder) IdEq(v string) *PrimaryStringTypeORMQueryBuilder {
+	return q.where(PrimaryStringTypeORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *PrimaryStringTypeORMQueryBuilder) IdNe(v string) *PrimaryStringTypeORMQueryBuilder {
+	return q.where(PrimaryStringTypeORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *PrimaryStringTypeORMQueryBuilder) IdGt(v string) *PrimaryStringTypeORMQueryBuilder {
+	return q.where(PrimaryStringTypeORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *PrimaryStringTypeORMQueryBuilder) IdGe(v string) *PrimaryStringTypeORMQueryBuilder {
+	return q.where(PrimaryStringTypeORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *PrimaryStringTypeORMQueryBuilder) IdLt(v string) *PrimaryStringTypeORMQueryBuilder {
+	return q.where(PrimaryStringTypeORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *PrimaryStringTypeORMQueryBuilder) IdLe(v string) *PrimaryStringTypeORMQueryBuilder {
+	return q.where(PrimaryStringTypeORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *PrimaryStringTypeORMQueryBuilder) IdIn(vs ...string) *PrimaryStringTypeORMQueryBuilder {
+	return q.where(PrimaryStringTypeORMColumnId+" IN (?)", vs)
+}
+
+// IdLike adds the condition id LIKE pattern
+func (q *PrimaryStringTypeORMQueryBuilder) IdLike(pattern string) *PrimaryStringTypeORMQueryBuilder {
+	return q.where(PrimaryStringTypeORMColumnId+" LIKE ?", pattern)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *PrimaryStringTypeORMQueryBuilder) OrderById(desc bool) *PrimaryStringTypeORMQueryBuilder {
+	order := PrimaryStringTypeORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PreloadChild adds the preload of the Child association
+func (q *PrimaryStringTypeORMQueryBuilder) PreloadChild() *PrimaryStringTypeORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Child")
+	})
+}
+
 // DefaultListPrimaryStringType executes a gorm list call
 func DefaultListPrimaryStringType(ctx context.Context, db *gorm.DB) ([]*PrimaryStringType, error) {
 	in := PrimaryStringType{}
@@ -3151,54 +6160,171 @@ func DefaultListPrimaryStringType(ctx context.Context, db *gorm.DB) ([]*PrimaryS
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryStringTypeORM{}, &PrimaryStringType{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PrimaryStringTypeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*PrimaryStringType{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PrimaryStringTypeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PrimaryStringTypeORM) error
+}
+
+// withTestTagTransaction runs fn in a new transaction of db, so that the
+// changes of TestTagORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withTestTagTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
+// DefaultCreateTestTag executes a basic gorm create call
+func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestTagORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTestTagSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateTestTagSet(ctx context.Context, in []*TestTag, batchSize int, db *gorm.DB) ([]*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*TestTag
+	if ok, err := withTestTagTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateTestTagSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
 			return nil, err
 		}
+		return out, nil
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryStringTypeORM{}, &PrimaryStringType{}, nil, nil, nil, nil)
-	if err != nil {
-		return nil, err
+	ormObjs := make([]*TestTagORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+	var err error
+	if hook, ok := interface{}(&TestTagORM{}).(TestTagORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []PrimaryStringTypeORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	if hook, ok := interface{}(&TestTagORM{}).(TestTagORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*PrimaryStringType{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	pbResponse := make([]*TestTag, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		pbResponse = append(pbResponse, &pbObj)
 	}
 	return pbResponse, nil
 }
 
-type PrimaryStringTypeORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryStringTypeORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+// TestTagORMWithBeforeCreateSet called before DefaultCreateTestTagSet inserts the objects
+type TestTagORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestTagORM, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]PrimaryStringTypeORM) error
+
+// TestTagORMWithAfterCreateSet called after DefaultCreateTestTagSet inserts the objects
+type TestTagORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestTagORM, *gorm.DB) error
 }
 
-// DefaultCreateTestTag executes a basic gorm create call
-func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+// DefaultUpsertTestTag inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -3206,28 +6332,43 @@ func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestT
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	// PostgreSQL and SQLite share the ON CONFLICT clause
+	option := "ON CONFLICT (id) DO UPDATE SET id = excluded.id"
+	if db.Dialect().GetName() == "mysql" {
+		option = "ON DUPLICATE KEY UPDATE id = VALUES(id)"
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
+	if err = db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := TestTagORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return &pbResponse, err
 }
 
-type TestTagORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type TestTagORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+type TestTagORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
 }
 
 // DefaultReadTestTag executes a basic gorm read call
@@ -3257,7 +6398,7 @@ func DefaultReadTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag
 	}
 	ormResponse := TestTagORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormResponse).(TestTagORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
@@ -3296,7 +6437,7 @@ func DefaultDeleteTestTag(ctx context.Context, in *TestTag, db *gorm.DB) error {
 	}
 	err = db.Where(&ormObj).Delete(&TestTagORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
@@ -3311,31 +6452,66 @@ type TestTagORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+// DefaultDeleteTestTagSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
 func DefaultDeleteTestTagSet(ctx context.Context, in []*TestTag, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withTestTagTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteTestTagSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TestTagORM, len(in))
 	keys := []string{}
-	for _, obj := range in {
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == "" {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
 	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestTagORM{}).Error
-	if err != nil {
+	before := []*TestTagORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[string]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
+	if err = db.Where("id IN (?)", keys).Delete(&TestTagORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
 	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -3352,14 +6528,14 @@ type TestTagORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateTestTag clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestTag")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	lockedRow := &TestTagORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -3371,7 +6547,7 @@ func DefaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (
 	}
 	filterTestTagAssoc.TestTagId = new(string)
 	*filterTestTagAssoc.TestTagId = ormObj.Id
-	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
+	if err = db.Where(filterTestTagAssoc).Delete(&TestTagAssociationORM{}).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateSave); ok {
@@ -3380,7 +6556,7 @@ func DefaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
@@ -3459,25 +6635,150 @@ type TestTagWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTestTag executes a bulk gorm update call with patch behavior
+// DefaultPatchSetTestTag executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
 func DefaultPatchSetTestTag(ctx context.Context, objects []*TestTag, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestTag, error) {
 	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
 	}
-
-	results := make([]*TestTag, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestTag(ctx, patcher, updateMasks[i], db)
+	var out []*TestTag
+	if ok, err := withTestTagTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetTestTag(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
 		if err != nil {
 			return nil, err
 		}
-
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TestTagORM, len(objects))
+	hookedRows := make([]*TestTagORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]string, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == "" {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(TestTagWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &TestTagORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&TestTagORM{}).(TestTagORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*TestTagORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[string]*TestTagORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *TestTag, updateMask *field_mask.FieldMask, row *TestTagORM, db *gorm.DB) (*TestTag, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskTestTag(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*TestTag, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *TestTag, in *TestTag, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestTag, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(TestTagWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateTestTag(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(TestTagWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*TestTag, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
 		results = append(results, pbResponse)
 	}
-
 	return results, nil
 }
 
+// TestTagORMWithBeforePatchSetRead called before DefaultPatchSetTestTag reads the rows
+type TestTagORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*TestTag, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
 // DefaultApplyFieldMaskTestTag patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskTestTag(ctx context.Context, patchee *TestTag, patcher *TestTag, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTag, error) {
 	if patcher == nil {
@@ -3520,6 +6821,98 @@ func DefaultApplyFieldMaskTestTag(ctx context.Context, patchee *TestTag, patcher
 	return patchee, nil
 }
 
+// The columns of TestTagORM
+const (
+	TestTagORMColumnId = "id"
+)
+
+// TestTagORMQueryBuilder builds a query of TestTagORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type TestTagORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// TestTagORMQuery returns an empty query builder of TestTagORM
+func TestTagORMQuery() *TestTagORMQueryBuilder {
+	return &TestTagORMQueryBuilder{}
+}
+
+func (q *TestTagORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *TestTagORMQueryBuilder {
+	return &TestTagORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *TestTagORMQueryBuilder) where(query string, args ...interface{}) *TestTagORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *TestTagORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// IdEq adds the condition id = v
+func (q *TestTagORMQueryBuilder) IdEq(v string) *TestTagORMQueryBuilder {
+	return q.where(TestTagORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *TestTagORMQueryBuilder) IdNe(v string) *TestTagORMQueryBuilder {
+	return q.where(TestTagORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *TestTagORMQueryBuilder) IdGt(v string) *TestTagORMQueryBuilder {
+	return q.where(TestTagORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *TestTagORMQueryBuilder) IdGe(v string) *TestTagORMQueryBuilder {
+	return q.where(TestTagORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *TestTagORMQueryBuilder) IdLt(v string) *TestTagORMQueryBuilder {
+	return q.where(TestTagORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *TestTagORMQueryBuilder) IdLe(v string) *TestTagORMQueryBuilder {
+	return q.where(TestTagORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *TestTagORMQueryBuilder) IdIn(vs ...string) *TestTagORMQueryBuilder {
+	return q.where(TestTagORMColumnId+" IN (?)", vs)
+}
+
+// IdLike adds the condition id LIKE pattern
+func (q *TestTagORMQueryBuilder) IdLike(pattern string) *TestTagORMQueryBuilder {
+	return q.where(TestTagORMColumnId+" LIKE ?", pattern)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *TestTagORMQueryBuilder) OrderById(desc bool) *TestTagORMQueryBuilder {
+	order := TestTagORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PreloadTestTagAssoc adds the preload of the TestTagAssoc association
+func (q *TestTagORMQueryBuilder) PreloadTestTagAssoc() *TestTagORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("TestTagAssoc")
+	})
+}
+
 // DefaultListTestTag executes a gorm list call
 func DefaultListTestTag(ctx context.Context, db *gorm.DB) ([]*TestTag, error) {
 	in := TestTag{}
@@ -3527,54 +6920,171 @@ func DefaultListTestTag(ctx context.Context, db *gorm.DB) ([]*TestTag, error) {
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTagORM{}, &TestTag{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TestTagORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TestTag{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TestTagORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestTagORM) error
+}
+
+// withTestAssocHandlerDefaultTransaction runs fn in a new transaction of db, so that the
+// changes of TestAssocHandlerDefaultORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withTestAssocHandlerDefaultTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
+// DefaultCreateTestAssocHandlerDefault executes a basic gorm create call
+func DefaultCreateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerDefaultORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTestAssocHandlerDefaultSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateTestAssocHandlerDefaultSet(ctx context.Context, in []*TestAssocHandlerDefault, batchSize int, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*TestAssocHandlerDefault
+	if ok, err := withTestAssocHandlerDefaultTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateTestAssocHandlerDefaultSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
 			return nil, err
 		}
+		return out, nil
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTagORM{}, &TestTag{}, nil, nil, nil, nil)
-	if err != nil {
-		return nil, err
+	ormObjs := make([]*TestAssocHandlerDefaultORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+	var err error
+	if hook, ok := interface{}(&TestAssocHandlerDefaultORM{}).(TestAssocHandlerDefaultORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []TestTagORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	if hook, ok := interface{}(&TestAssocHandlerDefaultORM{}).(TestAssocHandlerDefaultORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestTag{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	pbResponse := make([]*TestAssocHandlerDefault, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		pbResponse = append(pbResponse, &pbObj)
 	}
 	return pbResponse, nil
 }
 
-type TestTagORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+// TestAssocHandlerDefaultORMWithBeforeCreateSet called before DefaultCreateTestAssocHandlerDefaultSet inserts the objects
+type TestAssocHandlerDefaultORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestAssocHandlerDefaultORM, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestTagORM) error
+
+// TestAssocHandlerDefaultORMWithAfterCreateSet called after DefaultCreateTestAssocHandlerDefaultSet inserts the objects
+type TestAssocHandlerDefaultORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestAssocHandlerDefaultORM, *gorm.DB) error
 }
 
-// DefaultCreateTestAssocHandlerDefault executes a basic gorm create call
-func DefaultCreateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+// DefaultUpsertTestAssocHandlerDefault inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -3582,28 +7092,43 @@ func DefaultCreateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHand
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	// PostgreSQL and SQLite share the ON CONFLICT clause
+	option := "ON CONFLICT (id) DO UPDATE SET id = excluded.id"
+	if db.Dialect().GetName() == "mysql" {
+		option = "ON DUPLICATE KEY UPDATE id = VALUES(id)"
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
+	if err = db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := TestAssocHandlerDefaultORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return &pbResponse, err
 }
 
-type TestAssocHandlerDefaultORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerDefaultORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+type TestAssocHandlerDefaultORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
 }
 
 // DefaultReadTestAssocHandlerDefault executes a basic gorm read call
@@ -3633,7 +7158,7 @@ func DefaultReadTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandle
 	}
 	ormResponse := TestAssocHandlerDefaultORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerDefaultORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
@@ -3672,7 +7197,7 @@ func DefaultDeleteTestAssocHandlerDefault(ctx context.Context, in *TestAssocHand
 	}
 	err = db.Where(&ormObj).Delete(&TestAssocHandlerDefaultORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
@@ -3687,31 +7212,66 @@ type TestAssocHandlerDefaultORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+// DefaultDeleteTestAssocHandlerDefaultSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
 func DefaultDeleteTestAssocHandlerDefaultSet(ctx context.Context, in []*TestAssocHandlerDefault, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withTestAssocHandlerDefaultTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteTestAssocHandlerDefaultSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TestAssocHandlerDefaultORM, len(in))
 	keys := []string{}
-	for _, obj := range in {
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == "" {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
 	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerDefaultORM{}).Error
-	if err != nil {
+	before := []*TestAssocHandlerDefaultORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[string]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
+	if err = db.Where("id IN (?)", keys).Delete(&TestAssocHandlerDefaultORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
 	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -3728,14 +7288,14 @@ type TestAssocHandlerDefaultORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateTestAssocHandlerDefault clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerDefault")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	lockedRow := &TestAssocHandlerDefaultORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -3747,7 +7307,7 @@ func DefaultStrictUpdateTestAssocHandlerDefault(ctx context.Context, in *TestAss
 	}
 	filterTestTagAssoc.TestAssocHandlerDefaultId = new(string)
 	*filterTestTagAssoc.TestAssocHandlerDefaultId = ormObj.Id
-	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
+	if err = db.Where(filterTestTagAssoc).Delete(&TestTagAssociationORM{}).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave); ok {
@@ -3756,7 +7316,7 @@ func DefaultStrictUpdateTestAssocHandlerDefault(ctx context.Context, in *TestAss
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
@@ -3835,25 +7395,150 @@ type TestAssocHandlerDefaultWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTestAssocHandlerDefault executes a bulk gorm update call with patch behavior
+// DefaultPatchSetTestAssocHandlerDefault executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
 func DefaultPatchSetTestAssocHandlerDefault(ctx context.Context, objects []*TestAssocHandlerDefault, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
 	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
 	}
-
-	results := make([]*TestAssocHandlerDefault, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerDefault(ctx, patcher, updateMasks[i], db)
+	var out []*TestAssocHandlerDefault
+	if ok, err := withTestAssocHandlerDefaultTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetTestAssocHandlerDefault(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
 		if err != nil {
 			return nil, err
 		}
-
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TestAssocHandlerDefaultORM, len(objects))
+	hookedRows := make([]*TestAssocHandlerDefaultORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]string, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == "" {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(TestAssocHandlerDefaultWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &TestAssocHandlerDefaultORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&TestAssocHandlerDefaultORM{}).(TestAssocHandlerDefaultORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*TestAssocHandlerDefaultORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[string]*TestAssocHandlerDefaultORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, row *TestAssocHandlerDefaultORM, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskTestAssocHandlerDefault(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*TestAssocHandlerDefault, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *TestAssocHandlerDefault, in *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(TestAssocHandlerDefaultWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateTestAssocHandlerDefault(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(TestAssocHandlerDefaultWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*TestAssocHandlerDefault, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
 		results = append(results, pbResponse)
 	}
-
 	return results, nil
 }
 
+// TestAssocHandlerDefaultORMWithBeforePatchSetRead called before DefaultPatchSetTestAssocHandlerDefault reads the rows
+type TestAssocHandlerDefaultORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*TestAssocHandlerDefault, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
 // DefaultApplyFieldMaskTestAssocHandlerDefault patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskTestAssocHandlerDefault(ctx context.Context, patchee *TestAssocHandlerDefault, patcher *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerDefault, error) {
 	if patcher == nil {
@@ -3878,6 +7563,98 @@ func DefaultApplyFieldMaskTestAssocHandlerDefault(ctx context.Context, patchee *
 	return patchee, nil
 }
 
+// The columns of TestAssocHandlerDefaultORM
+const (
+	TestAssocHandlerDefaultORMColumnId = "id"
+)
+
+// TestAssocHandlerDefaultORMQueryBuilder builds a query of TestAssocHandlerDefaultORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type TestAssocHandlerDefaultORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// TestAssocHandlerDefaultORMQuery returns an empty query builder of TestAssocHandlerDefaultORM
+func TestAssocHandlerDefaultORMQuery() *TestAssocHandlerDefaultORMQueryBuilder {
+	return &TestAssocHandlerDefaultORMQueryBuilder{}
+}
+
+func (q *TestAssocHandlerDefaultORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *TestAssocHandlerDefaultORMQueryBuilder {
+	return &TestAssocHandlerDefaultORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *TestAssocHandlerDefaultORMQueryBuilder) where(query string, args ...interface{}) *TestAssocHandlerDefaultORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *TestAssocHandlerDefaultORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// IdEq adds the condition id = v
+func (q *TestAssocHandlerDefaultORMQueryBuilder) IdEq(v string) *TestAssocHandlerDefaultORMQueryBuilder {
+	return q.where(TestAssocHandlerDefaultORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *TestAssocHandlerDefaultORMQueryBuilder) IdNe(v string) *TestAssocHandlerDefaultORMQueryBuilder {
+	return q.where(TestAssocHandlerDefaultORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *TestAssocHandlerDefaultORMQueryBuilder) IdGt(v string) *TestAssocHandlerDefaultORMQueryBuilder {
+	return q.where(TestAssocHandlerDefaultORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *TestAssocHandlerDefaultORMQueryBuilder) IdGe(v string) *TestAssocHandlerDefaultORMQueryBuilder {
+	return q.where(TestAssocHandlerDefaultORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *TestAssocHandlerDefaultORMQueryBuilder) IdLt(v string) *TestAssocHandlerDefaultORMQueryBuilder {
+	return q.where(TestAssocHandlerDefaultORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *TestAssocHandlerDefaultORMQueryBuilder) IdLe(v string) *TestAssocHandlerDefaultORMQueryBuilder {
+	return q.where(TestAssocHandlerDefaultORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *TestAssocHandlerDefaultORMQueryBuilder) IdIn(vs ...string) *TestAssocHandlerDefaultORMQueryBuilder {
+	return q.where(TestAssocHandlerDefaultORMColumnId+" IN (?)", vs)
+}
+
+// IdLike adds the condition id LIKE pattern
+func (q *TestAssocHandlerDefaultORMQueryBuilder) IdLike(pattern string) *TestAssocHandlerDefaultORMQueryBuilder {
+	return q.where(TestAssocHandlerDefaultORMColumnId+" LIKE ?", pattern)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *TestAssocHandlerDefaultORMQueryBuilder) OrderById(desc bool) *TestAssocHandlerDefaultORMQueryBuilder {
+	order := TestAssocHandlerDefaultORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PreloadTestTagAssoc adds the preload of the TestTagAssoc association
+func (q *TestAssocHandlerDefaultORMQueryBuilder) PreloadTestTagAssoc() *TestAssocHandlerDefaultORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("TestTagAssoc")
+	})
+}
+
 // DefaultListTestAssocHandlerDefault executes a gorm list call
 func DefaultListTestAssocHandlerDefault(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
 	in := TestAssocHandlerDefault{}
@@ -3903,7 +7680,7 @@ func DefaultListTestAssocHandlerDefault(ctx context.Context, db *gorm.DB) ([]*Te
 	db = db.Order("id")
 	ormResponse := []TestAssocHandlerDefaultORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -3931,6 +7708,24 @@ type TestAssocHandlerDefaultORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerDefaultORM) error
 }
 
+// withTestAssocHandlerReplaceTransaction runs fn in a new transaction of db, so that the
+// changes of TestAssocHandlerReplaceORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withTestAssocHandlerReplaceTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreateTestAssocHandlerReplace executes a basic gorm create call
 func DefaultCreateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
 	if in == nil {
@@ -3946,7 +7741,7 @@ func DefaultCreateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHand
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -3964,6 +7759,120 @@ type TestAssocHandlerReplaceORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateTestAssocHandlerReplaceSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateTestAssocHandlerReplaceSet(ctx context.Context, in []*TestAssocHandlerReplace, batchSize int, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*TestAssocHandlerReplace
+	if ok, err := withTestAssocHandlerReplaceTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateTestAssocHandlerReplaceSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*TestAssocHandlerReplaceORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&TestAssocHandlerReplaceORM{}).(TestAssocHandlerReplaceORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&TestAssocHandlerReplaceORM{}).(TestAssocHandlerReplaceORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*TestAssocHandlerReplace, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// TestAssocHandlerReplaceORMWithBeforeCreateSet called before DefaultCreateTestAssocHandlerReplaceSet inserts the objects
+type TestAssocHandlerReplaceORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestAssocHandlerReplaceORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// TestAssocHandlerReplaceORMWithAfterCreateSet called after DefaultCreateTestAssocHandlerReplaceSet inserts the objects
+type TestAssocHandlerReplaceORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestAssocHandlerReplaceORM, *gorm.DB) error
+}
+
+// DefaultUpsertTestAssocHandlerReplace inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	// PostgreSQL and SQLite share the ON CONFLICT clause
+	option := "ON CONFLICT (id) DO UPDATE SET id = excluded.id"
+	if db.Dialect().GetName() == "mysql" {
+		option = "ON DUPLICATE KEY UPDATE id = VALUES(id)"
+	}
+	if err = db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := TestAssocHandlerReplaceORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type TestAssocHandlerReplaceORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
 // DefaultReadTestAssocHandlerReplace executes a basic gorm read call
 func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
 	if in == nil {
@@ -3991,7 +7900,7 @@ func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandle
 	}
 	ormResponse := TestAssocHandlerReplaceORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerReplaceORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
@@ -4030,7 +7939,7 @@ func DefaultDeleteTestAssocHandlerReplace(ctx context.Context, in *TestAssocHand
 	}
 	err = db.Where(&ormObj).Delete(&TestAssocHandlerReplaceORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
@@ -4045,31 +7954,66 @@ type TestAssocHandlerReplaceORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+// DefaultDeleteTestAssocHandlerReplaceSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
 func DefaultDeleteTestAssocHandlerReplaceSet(ctx context.Context, in []*TestAssocHandlerReplace, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withTestAssocHandlerReplaceTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteTestAssocHandlerReplaceSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TestAssocHandlerReplaceORM, len(in))
 	keys := []string{}
-	for _, obj := range in {
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == "" {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
 	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerReplaceORM{}).Error
-	if err != nil {
+	before := []*TestAssocHandlerReplaceORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[string]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
+	if err = db.Where("id IN (?)", keys).Delete(&TestAssocHandlerReplaceORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
 	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -4086,14 +8030,14 @@ type TestAssocHandlerReplaceORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateTestAssocHandlerReplace clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerReplace")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	lockedRow := &TestAssocHandlerReplaceORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -4109,7 +8053,7 @@ func DefaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAss
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
@@ -4188,25 +8132,150 @@ type TestAssocHandlerReplaceWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTestAssocHandlerReplace executes a bulk gorm update call with patch behavior
+// DefaultPatchSetTestAssocHandlerReplace executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
 func DefaultPatchSetTestAssocHandlerReplace(ctx context.Context, objects []*TestAssocHandlerReplace, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
 	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
 	}
-
-	results := make([]*TestAssocHandlerReplace, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerReplace(ctx, patcher, updateMasks[i], db)
+	var out []*TestAssocHandlerReplace
+	if ok, err := withTestAssocHandlerReplaceTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetTestAssocHandlerReplace(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
 		if err != nil {
 			return nil, err
 		}
-
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TestAssocHandlerReplaceORM, len(objects))
+	hookedRows := make([]*TestAssocHandlerReplaceORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]string, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == "" {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(TestAssocHandlerReplaceWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &TestAssocHandlerReplaceORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&TestAssocHandlerReplaceORM{}).(TestAssocHandlerReplaceORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*TestAssocHandlerReplaceORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[string]*TestAssocHandlerReplaceORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, row *TestAssocHandlerReplaceORM, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskTestAssocHandlerReplace(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*TestAssocHandlerReplace, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *TestAssocHandlerReplace, in *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(TestAssocHandlerReplaceWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateTestAssocHandlerReplace(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(TestAssocHandlerReplaceWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*TestAssocHandlerReplace, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
 		results = append(results, pbResponse)
 	}
-
 	return results, nil
 }
 
+// TestAssocHandlerReplaceORMWithBeforePatchSetRead called before DefaultPatchSetTestAssocHandlerReplace reads the rows
+type TestAssocHandlerReplaceORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*TestAssocHandlerReplace, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
 // DefaultApplyFieldMaskTestAssocHandlerReplace patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskTestAssocHandlerReplace(ctx context.Context, patchee *TestAssocHandlerReplace, patcher *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerReplace, error) {
 	if patcher == nil {
@@ -4231,6 +8300,98 @@ func DefaultApplyFieldMaskTestAssocHandlerReplace(ctx context.Context, patchee *
 	return patchee, nil
 }
 
+// The columns of TestAssocHandlerReplaceORM
+const (
+	TestAssocHandlerReplaceORMColumnId = "id"
+)
+
+// TestAssocHandlerReplaceORMQueryBuilder builds a query of TestAssocHandlerReplaceORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type TestAssocHandlerReplaceORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// TestAssocHandlerReplaceORMQuery returns an empty query builder of TestAssocHandlerReplaceORM
+func TestAssocHandlerReplaceORMQuery() *TestAssocHandlerReplaceORMQueryBuilder {
+	return &TestAssocHandlerReplaceORMQueryBuilder{}
+}
+
+func (q *TestAssocHandlerReplaceORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *TestAssocHandlerReplaceORMQueryBuilder {
+	return &TestAssocHandlerReplaceORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *TestAssocHandlerReplaceORMQueryBuilder) where(query string, args ...interface{}) *TestAssocHandlerReplaceORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *TestAssocHandlerReplaceORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// IdEq adds the condition id = v
+func (q *TestAssocHandlerReplaceORMQueryBuilder) IdEq(v string) *TestAssocHandlerReplaceORMQueryBuilder {
+	return q.where(TestAssocHandlerReplaceORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *TestAssocHandlerReplaceORMQueryBuilder) IdNe(v string) *TestAssocHandlerReplaceORMQueryBuilder {
+	return q.where(TestAssocHandlerReplaceORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *TestAssocHandlerReplaceORMQueryBuilder) IdGt(v string) *TestAssocHandlerReplaceORMQueryBuilder {
+	return q.where(TestAssocHandlerReplaceORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *TestAssocHandlerReplaceORMQueryBuilder) IdGe(v string) *TestAssocHandlerReplaceORMQueryBuilder {
+	return q.where(TestAssocHandlerReplaceORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *TestAssocHandlerReplaceORMQueryBuilder) IdLt(v string) *TestAssocHandlerReplaceORMQueryBuilder {
+	return q.where(TestAssocHandlerReplaceORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *TestAssocHandlerReplaceORMQueryBuilder) IdLe(v string) *TestAssocHandlerReplaceORMQueryBuilder {
+	return q.where(TestAssocHandlerReplaceORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *TestAssocHandlerReplaceORMQueryBuilder) IdIn(vs ...string) *TestAssocHandlerReplaceORMQueryBuilder {
+	return q.where(TestAssocHandlerReplaceORMColumnId+" IN (?)", vs)
+}
+
+// IdLike adds the condition id LIKE pattern
+func (q *TestAssocHandlerReplaceORMQueryBuilder) IdLike(pattern string) *TestAssocHandlerReplaceORMQueryBuilder {
+	return q.where(TestAssocHandlerReplaceORMColumnId+" LIKE ?", pattern)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *TestAssocHandlerReplaceORMQueryBuilder) OrderById(desc bool) *TestAssocHandlerReplaceORMQueryBuilder {
+	order := TestAssocHandlerReplaceORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PreloadTestTagAssoc adds the preload of the TestTagAssoc association
+func (q *TestAssocHandlerReplaceORMQueryBuilder) PreloadTestTagAssoc() *TestAssocHandlerReplaceORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("TestTagAssoc")
+	})
+}
+
 // DefaultListTestAssocHandlerReplace executes a gorm list call
 func DefaultListTestAssocHandlerReplace(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
 	in := TestAssocHandlerReplace{}
@@ -4256,7 +8417,7 @@ func DefaultListTestAssocHandlerReplace(ctx context.Context, db *gorm.DB) ([]*Te
 	db = db.Order("id")
 	ormResponse := []TestAssocHandlerReplaceORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -4284,6 +8445,24 @@ type TestAssocHandlerReplaceORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerReplaceORM) error
 }
 
+// withTestAssocHandlerClearTransaction runs fn in a new transaction of db, so that the
+// changes of TestAssocHandlerClearORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withTestAssocHandlerClearTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreateTestAssocHandlerClear executes a basic gorm create call
 func DefaultCreateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
 	if in == nil {
@@ -4299,7 +8478,7 @@ func DefaultCreateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandle
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -4317,6 +8496,120 @@ type TestAssocHandlerClearORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateTestAssocHandlerClearSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateTestAssocHandlerClearSet(ctx context.Context, in []*TestAssocHandlerClear, batchSize int, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*TestAssocHandlerClear
+	if ok, err := withTestAssocHandlerClearTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateTestAssocHandlerClearSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*TestAssocHandlerClearORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&TestAssocHandlerClearORM{}).(TestAssocHandlerClearORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&TestAssocHandlerClearORM{}).(TestAssocHandlerClearORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*TestAssocHandlerClear, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// TestAssocHandlerClearORMWithBeforeCreateSet called before DefaultCreateTestAssocHandlerClearSet inserts the objects
+type TestAssocHandlerClearORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestAssocHandlerClearORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// TestAssocHandlerClearORMWithAfterCreateSet called after DefaultCreateTestAssocHandlerClearSet inserts the objects
+type TestAssocHandlerClearORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestAssocHandlerClearORM, *gorm.DB) error
+}
+
+// DefaultUpsertTestAssocHandlerClear inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	// PostgreSQL and SQLite share the ON CONFLICT clause
+	option := "ON CONFLICT (id) DO UPDATE SET id = excluded.id"
+	if db.Dialect().GetName() == "mysql" {
+		option = "ON DUPLICATE KEY UPDATE id = VALUES(id)"
+	}
+	if err = db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := TestAssocHandlerClearORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type TestAssocHandlerClearORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
 // DefaultReadTestAssocHandlerClear executes a basic gorm read call
 func DefaultReadTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
 	if in == nil {
@@ -4344,7 +8637,7 @@ func DefaultReadTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerC
 	}
 	ormResponse := TestAssocHandlerClearORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerClearORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
@@ -4383,7 +8676,7 @@ func DefaultDeleteTestAssocHandlerClear(ctx context.Context, in *TestAssocHandle
 	}
 	err = db.Where(&ormObj).Delete(&TestAssocHandlerClearORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
@@ -4398,31 +8691,66 @@ type TestAssocHandlerClearORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+// DefaultDeleteTestAssocHandlerClearSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
 func DefaultDeleteTestAssocHandlerClearSet(ctx context.Context, in []*TestAssocHandlerClear, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withTestAssocHandlerClearTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteTestAssocHandlerClearSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TestAssocHandlerClearORM, len(in))
 	keys := []string{}
-	for _, obj := range in {
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == "" {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
 	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerClearORM{}).Error
-	if err != nil {
+	before := []*TestAssocHandlerClearORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[string]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
+	if err = db.Where("id IN (?)", keys).Delete(&TestAssocHandlerClearORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
 	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -4439,14 +8767,14 @@ type TestAssocHandlerClearORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateTestAssocHandlerClear clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerClear")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	lockedRow := &TestAssocHandlerClearORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -4462,7 +8790,7 @@ func DefaultStrictUpdateTestAssocHandlerClear(ctx context.Context, in *TestAssoc
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
@@ -4516,50 +8844,175 @@ func DefaultPatchTestAssocHandlerClear(ctx context.Context, in *TestAssocHandler
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTestAssocHandlerClear(ctx, &pbObj, db)
-	if err != nil {
+	pbResponse, err := DefaultStrictUpdateTestAssocHandlerClear(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TestAssocHandlerClearWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerClearWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTestAssocHandlerClear executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
+func DefaultPatchSetTestAssocHandlerClear(ctx context.Context, objects []*TestAssocHandlerClear, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
+	}
+	var out []*TestAssocHandlerClear
+	if ok, err := withTestAssocHandlerClearTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetTestAssocHandlerClear(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TestAssocHandlerClearORM, len(objects))
+	hookedRows := make([]*TestAssocHandlerClearORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]string, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == "" {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(TestAssocHandlerClearWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &TestAssocHandlerClearORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&TestAssocHandlerClearORM{}).(TestAssocHandlerClearORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*TestAssocHandlerClearORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[string]*TestAssocHandlerClearORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *TestAssocHandlerClear, updateMask *field_mask.FieldMask, row *TestAssocHandlerClearORM, db *gorm.DB) (*TestAssocHandlerClear, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskTestAssocHandlerClear(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*TestAssocHandlerClear, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TestAssocHandlerClearWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+	save := func(pbObj *TestAssocHandlerClear, in *TestAssocHandlerClear, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerClear, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(TestAssocHandlerClearWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateTestAssocHandlerClear(ctx, pbObj, db)
+		if err != nil {
 			return nil, err
 		}
+		if hook, ok := interface{}(pbResponse).(TestAssocHandlerClearWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
 	}
-	return pbResponse, nil
-}
-
-type TestAssocHandlerClearWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerClearWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerClearWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerClearWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) error
-}
-
-// DefaultPatchSetTestAssocHandlerClear executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestAssocHandlerClear(ctx context.Context, objects []*TestAssocHandlerClear, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
-	}
-
 	results := make([]*TestAssocHandlerClear, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerClear(ctx, patcher, updateMasks[i], db)
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
 		if err != nil {
-			return nil, err
+			errs.Add(i, err)
+			return nil, errs
 		}
-
 		results = append(results, pbResponse)
 	}
-
 	return results, nil
 }
 
+// TestAssocHandlerClearORMWithBeforePatchSetRead called before DefaultPatchSetTestAssocHandlerClear reads the rows
+type TestAssocHandlerClearORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*TestAssocHandlerClear, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
 // DefaultApplyFieldMaskTestAssocHandlerClear patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskTestAssocHandlerClear(ctx context.Context, patchee *TestAssocHandlerClear, patcher *TestAssocHandlerClear, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerClear, error) {
 	if patcher == nil {
@@ -4584,6 +9037,98 @@ func DefaultApplyFieldMaskTestAssocHandlerClear(ctx context.Context, patchee *Te
 	return patchee, nil
 }
 
+// The columns of TestAssocHandlerClearORM
+const (
+	TestAssocHandlerClearORMColumnId = "id"
+)
+
+// TestAssocHandlerClearORMQueryBuilder builds a query of TestAssocHandlerClearORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type TestAssocHandlerClearORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// TestAssocHandlerClearORMQuery returns an empty query builder of TestAssocHandlerClearORM
+func TestAssocHandlerClearORMQuery() *TestAssocHandlerClearORMQueryBuilder {
+	return &TestAssocHandlerClearORMQueryBuilder{}
+}
+
+func (q *TestAssocHandlerClearORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *TestAssocHandlerClearORMQueryBuilder {
+	return &TestAssocHandlerClearORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *TestAssocHandlerClearORMQueryBuilder) where(query string, args ...interface{}) *TestAssocHandlerClearORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *TestAssocHandlerClearORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// IdEq adds the condition id = v
+func (q *TestAssocHandlerClearORMQueryBuilder) IdEq(v string) *TestAssocHandlerClearORMQueryBuilder {
+	return q.where(TestAssocHandlerClearORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *TestAssocHandlerClearORMQueryBuilder) IdNe(v string) *TestAssocHandlerClearORMQueryBuilder {
+	return q.where(TestAssocHandlerClearORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *TestAssocHandlerClearORMQueryBuilder) IdGt(v string) *TestAssocHandlerClearORMQueryBuilder {
+	return q.where(TestAssocHandlerClearORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *TestAssocHandlerClearORMQueryBuilder) IdGe(v string) *TestAssocHandlerClearORMQueryBuilder {
+	return q.where(TestAssocHandlerClearORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *TestAssocHandlerClearORMQueryBuilder) IdLt(v string) *TestAssocHandlerClearORMQueryBuilder {
+	return q.where(TestAssocHandlerClearORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *TestAssocHandlerClearORMQueryBuilder) IdLe(v string) *TestAssocHandlerClearORMQueryBuilder {
+	return q.where(TestAssocHandlerClearORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *TestAssocHandlerClearORMQueryBuilder) IdIn(vs ...string) *TestAssocHandlerClearORMQueryBuilder {
+	return q.where(TestAssocHandlerClearORMColumnId+" IN (?)", vs)
+}
+
+// IdLike adds the condition id LIKE pattern
+func (q *TestAssocHandlerClearORMQueryBuilder) IdLike(pattern string) *TestAssocHandlerClearORMQueryBuilder {
+	return q.where(TestAssocHandlerClearORMColumnId+" LIKE ?", pattern)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *TestAssocHandlerClearORMQueryBuilder) OrderById(desc bool) *TestAssocHandlerClearORMQueryBuilder {
+	order := TestAssocHandlerClearORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PreloadTestTagAssoc adds the preload of the TestTagAssoc association
+func (q *TestAssocHandlerClearORMQueryBuilder) PreloadTestTagAssoc() *TestAssocHandlerClearORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("TestTagAssoc")
+	})
+}
+
 // DefaultListTestAssocHandlerClear executes a gorm list call
 func DefaultListTestAssocHandlerClear(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
 	in := TestAssocHandlerClear{}
@@ -4609,7 +9154,7 @@ func DefaultListTestAssocHandlerClear(ctx context.Context, db *gorm.DB) ([]*Test
 	db = db.Order("id")
 	ormResponse := []TestAssocHandlerClearORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -4637,6 +9182,24 @@ type TestAssocHandlerClearORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerClearORM) error
 }
 
+// withTestAssocHandlerAppendTransaction runs fn in a new transaction of db, so that the
+// changes of TestAssocHandlerAppendORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withTestAssocHandlerAppendTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreateTestAssocHandlerAppend executes a basic gorm create call
 func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
 	if in == nil {
@@ -4652,7 +9215,7 @@ func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandl
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -4670,6 +9233,120 @@ type TestAssocHandlerAppendORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateTestAssocHandlerAppendSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateTestAssocHandlerAppendSet(ctx context.Context, in []*TestAssocHandlerAppend, batchSize int, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*TestAssocHandlerAppend
+	if ok, err := withTestAssocHandlerAppendTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateTestAssocHandlerAppendSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*TestAssocHandlerAppendORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&TestAssocHandlerAppendORM{}).(TestAssocHandlerAppendORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&TestAssocHandlerAppendORM{}).(TestAssocHandlerAppendORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*TestAssocHandlerAppend, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// TestAssocHandlerAppendORMWithBeforeCreateSet called before DefaultCreateTestAssocHandlerAppendSet inserts the objects
+type TestAssocHandlerAppendORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestAssocHandlerAppendORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// TestAssocHandlerAppendORMWithAfterCreateSet called after DefaultCreateTestAssocHandlerAppendSet inserts the objects
+type TestAssocHandlerAppendORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestAssocHandlerAppendORM, *gorm.DB) error
+}
+
+// DefaultUpsertTestAssocHandlerAppend inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	// PostgreSQL and SQLite share the ON CONFLICT clause
+	option := "ON CONFLICT (id) DO UPDATE SET id = excluded.id"
+	if db.Dialect().GetName() == "mysql" {
+		option = "ON DUPLICATE KEY UPDATE id = VALUES(id)"
+	}
+	if err = db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := TestAssocHandlerAppendORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type TestAssocHandlerAppendORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
 // DefaultReadTestAssocHandlerAppend executes a basic gorm read call
 func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
 	if in == nil {
@@ -4697,7 +9374,7 @@ func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandler
 	}
 	ormResponse := TestAssocHandlerAppendORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerAppendORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
@@ -4736,7 +9413,7 @@ func DefaultDeleteTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandl
 	}
 	err = db.Where(&ormObj).Delete(&TestAssocHandlerAppendORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
@@ -4751,31 +9428,66 @@ type TestAssocHandlerAppendORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+// DefaultDeleteTestAssocHandlerAppendSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
 func DefaultDeleteTestAssocHandlerAppendSet(ctx context.Context, in []*TestAssocHandlerAppend, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withTestAssocHandlerAppendTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteTestAssocHandlerAppendSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TestAssocHandlerAppendORM, len(in))
 	keys := []string{}
-	for _, obj := range in {
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == "" {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
 	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerAppendORM{}).Error
-	if err != nil {
+	before := []*TestAssocHandlerAppendORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[string]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
+	if err = db.Where("id IN (?)", keys).Delete(&TestAssocHandlerAppendORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
 	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -4792,14 +9504,14 @@ type TestAssocHandlerAppendORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateTestAssocHandlerAppend clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerAppend")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	lockedRow := &TestAssocHandlerAppendORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -4815,7 +9527,7 @@ func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAsso
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
@@ -4894,25 +9606,150 @@ type TestAssocHandlerAppendWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTestAssocHandlerAppend executes a bulk gorm update call with patch behavior
+// DefaultPatchSetTestAssocHandlerAppend executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
 func DefaultPatchSetTestAssocHandlerAppend(ctx context.Context, objects []*TestAssocHandlerAppend, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
 	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
 	}
-
-	results := make([]*TestAssocHandlerAppend, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerAppend(ctx, patcher, updateMasks[i], db)
+	var out []*TestAssocHandlerAppend
+	if ok, err := withTestAssocHandlerAppendTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetTestAssocHandlerAppend(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
 		if err != nil {
 			return nil, err
 		}
-
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TestAssocHandlerAppendORM, len(objects))
+	hookedRows := make([]*TestAssocHandlerAppendORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]string, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == "" {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(TestAssocHandlerAppendWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &TestAssocHandlerAppendORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&TestAssocHandlerAppendORM{}).(TestAssocHandlerAppendORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*TestAssocHandlerAppendORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[string]*TestAssocHandlerAppendORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, row *TestAssocHandlerAppendORM, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskTestAssocHandlerAppend(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*TestAssocHandlerAppend, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *TestAssocHandlerAppend, in *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(TestAssocHandlerAppendWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateTestAssocHandlerAppend(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(TestAssocHandlerAppendWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*TestAssocHandlerAppend, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
 		results = append(results, pbResponse)
 	}
-
 	return results, nil
 }
 
+// TestAssocHandlerAppendORMWithBeforePatchSetRead called before DefaultPatchSetTestAssocHandlerAppend reads the rows
+type TestAssocHandlerAppendORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*TestAssocHandlerAppend, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
 // DefaultApplyFieldMaskTestAssocHandlerAppend patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskTestAssocHandlerAppend(ctx context.Context, patchee *TestAssocHandlerAppend, patcher *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerAppend, error) {
 	if patcher == nil {
@@ -4937,6 +9774,98 @@ func DefaultApplyFieldMaskTestAssocHandlerAppend(ctx context.Context, patchee *T
 	return patchee, nil
 }
 
+// The columns of TestAssocHandlerAppendORM
+const (
+	TestAssocHandlerAppendORMColumnId = "id"
+)
+
+// TestAssocHandlerAppendORMQueryBuilder builds a query of TestAssocHandlerAppendORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type TestAssocHandlerAppendORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// TestAssocHandlerAppendORMQuery returns an empty query builder of TestAssocHandlerAppendORM
+func TestAssocHandlerAppendORMQuery() *TestAssocHandlerAppendORMQueryBuilder {
+	return &TestAssocHandlerAppendORMQueryBuilder{}
+}
+
+func (q *TestAssocHandlerAppendORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *TestAssocHandlerAppendORMQueryBuilder {
+	return &TestAssocHandlerAppendORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *TestAssocHandlerAppendORMQueryBuilder) where(query string, args ...interface{}) *TestAssocHandlerAppendORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *TestAssocHandlerAppendORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// IdEq adds the condition id = v
+func (q *TestAssocHandlerAppendORMQueryBuilder) IdEq(v string) *TestAssocHandlerAppendORMQueryBuilder {
+	return q.where(TestAssocHandlerAppendORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *TestAssocHandlerAppendORMQueryBuilder) IdNe(v string) *TestAssocHandlerAppendORMQueryBuilder {
+	return q.where(TestAssocHandlerAppendORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *TestAssocHandlerAppendORMQueryBuilder) IdGt(v string) *TestAssocHandlerAppendORMQueryBuilder {
+	return q.where(TestAssocHandlerAppendORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *TestAssocHandlerAppendORMQueryBuilder) IdGe(v string) *TestAssocHandlerAppendORMQueryBuilder {
+	return q.where(TestAssocHandlerAppendORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *TestAssocHandlerAppendORMQueryBuilder) IdLt(v string) *TestAssocHandlerAppendORMQueryBuilder {
+	return q.where(TestAssocHandlerAppendORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *TestAssocHandlerAppendORMQueryBuilder) IdLe(v string) *TestAssocHandlerAppendORMQueryBuilder {
+	return q.where(TestAssocHandlerAppendORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *TestAssocHandlerAppendORMQueryBuilder) IdIn(vs ...string) *TestAssocHandlerAppendORMQueryBuilder {
+	return q.where(TestAssocHandlerAppendORMColumnId+" IN (?)", vs)
+}
+
+// IdLike adds the condition id LIKE pattern
+func (q *TestAssocHandlerAppendORMQueryBuilder) IdLike(pattern string) *TestAssocHandlerAppendORMQueryBuilder {
+	return q.where(TestAssocHandlerAppendORMColumnId+" LIKE ?", pattern)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *TestAssocHandlerAppendORMQueryBuilder) OrderById(desc bool) *TestAssocHandlerAppendORMQueryBuilder {
+	order := TestAssocHandlerAppendORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PreloadTestTagAssoc adds the preload of the TestTagAssoc association
+func (q *TestAssocHandlerAppendORMQueryBuilder) PreloadTestTagAssoc() *TestAssocHandlerAppendORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("TestTagAssoc")
+	})
+}
+
 // DefaultListTestAssocHandlerAppend executes a gorm list call
 func DefaultListTestAssocHandlerAppend(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
 	in := TestAssocHandlerAppend{}
@@ -4962,7 +9891,7 @@ func DefaultListTestAssocHandlerAppend(ctx context.Context, db *gorm.DB) ([]*Tes
 	db = db.Order("id")
 	ormResponse := []TestAssocHandlerAppendORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -4990,6 +9919,24 @@ type TestAssocHandlerAppendORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerAppendORM) error
 }
 
+// withTestTagAssociationTransaction runs fn in a new transaction of db, so that the
+// changes of TestTagAssociationORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withTestTagAssociationTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreateTestTagAssociation executes a basic gorm create call
 func DefaultCreateTestTagAssociation(ctx context.Context, in *TestTagAssociation, db *gorm.DB) (*TestTagAssociation, error) {
 	if in == nil {
@@ -5005,7 +9952,7 @@ func DefaultCreateTestTagAssociation(ctx context.Context, in *TestTagAssociation
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -5023,6 +9970,71 @@ type TestTagAssociationORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateTestTagAssociationSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateTestTagAssociationSet(ctx context.Context, in []*TestTagAssociation, batchSize int, db *gorm.DB) ([]*TestTagAssociation, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*TestTagAssociation
+	if ok, err := withTestTagAssociationTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateTestTagAssociationSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*TestTagAssociationORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&TestTagAssociationORM{}).(TestTagAssociationORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&TestTagAssociationORM{}).(TestTagAssociationORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*TestTagAssociation, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// TestTagAssociationORMWithBeforeCreateSet called before DefaultCreateTestTagAssociationSet inserts the objects
+type TestTagAssociationORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestTagAssociationORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// TestTagAssociationORMWithAfterCreateSet called after DefaultCreateTestTagAssociationSet inserts the objects
+type TestTagAssociationORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestTagAssociationORM, *gorm.DB) error
+}
+
 // DefaultApplyFieldMaskTestTagAssociation patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskTestTagAssociation(ctx context.Context, patchee *TestTagAssociation, patcher *TestTagAssociation, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTagAssociation, error) {
 	if patcher == nil {
@@ -5043,6 +10055,401 @@ func DefaultApplyFieldMaskTestTagAssociation(ctx context.Context, patchee *TestT
 	return patchee, nil
 }
 
+// The columns of TestTagAssociationORM
+const (
+	TestTagAssociationORMColumnSomeField                 = "some_field"
+	TestTagAssociationORMColumnTestAssocHandlerAppendId  = "test_assoc_handler_append_id"
+	TestTagAssociationORMColumnTestAssocHandlerClearId   = "test_assoc_handler_clear_id"
+	TestTagAssociationORMColumnTestAssocHandlerDefaultId = "test_assoc_handler_default_id"
+	TestTagAssociationORMColumnTestAssocHandlerReplaceId = "test_assoc_handler_replace_id"
+	TestTagAssociationORMColumnTestTagId                 = "test_tag_id"
+)
+
+// TestTagAssociationORMQueryBuilder builds a query of TestTagAssociationORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type TestTagAssociationORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// TestTagAssociationORMQuery returns an empty query builder of TestTagAssociationORM
+func TestTagAssociationORMQuery() *TestTagAssociationORMQueryBuilder {
+	return &TestTagAssociationORMQueryBuilder{}
+}
+
+func (q *TestTagAssociationORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *TestTagAssociationORMQueryBuilder {
+	return &TestTagAssociationORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *TestTagAssociationORMQueryBuilder) where(query string, args ...interface{}) *TestTagAssociationORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *TestTagAssociationORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// SomeFieldEq adds the condition some_field = v
+func (q *TestTagAssociationORMQueryBuilder) SomeFieldEq(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnSomeField+" = ?", v)
+}
+
+// SomeFieldNe adds the condition some_field <> v
+func (q *TestTagAssociationORMQueryBuilder) SomeFieldNe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnSomeField+" <> ?", v)
+}
+
+// SomeFieldGt adds the condition some_field > v
+func (q *TestTagAssociationORMQueryBuilder) SomeFieldGt(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnSomeField+" > ?", v)
+}
+
+// SomeFieldGe adds the condition some_field >= v
+func (q *TestTagAssociationORMQueryBuilder) SomeFieldGe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnSomeField+" >= ?", v)
+}
+
+// SomeFieldLt adds the condition some_field < v
+func (q *TestTagAssociationORMQueryBuilder) SomeFieldLt(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnSomeField+" < ?", v)
+}
+
+// SomeFieldLe adds the condition some_field <= v
+func (q *TestTagAssociationORMQueryBuilder) SomeFieldLe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnSomeField+" <= ?", v)
+}
+
+// SomeFieldIn adds the condition some_field IN (vs)
+func (q *TestTagAssociationORMQueryBuilder) SomeFieldIn(vs ...string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnSomeField+" IN (?)", vs)
+}
+
+// SomeFieldLike adds the condition some_field LIKE pattern
+func (q *TestTagAssociationORMQueryBuilder) SomeFieldLike(pattern string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnSomeField+" LIKE ?", pattern)
+}
+
+// OrderBySomeField adds the order by some_field, descending when desc
+func (q *TestTagAssociationORMQueryBuilder) OrderBySomeField(desc bool) *TestTagAssociationORMQueryBuilder {
+	order := TestTagAssociationORMColumnSomeField
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// TestAssocHandlerAppendIdEq adds the condition test_assoc_handler_append_id = v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerAppendIdEq(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerAppendId+" = ?", v)
+}
+
+// TestAssocHandlerAppendIdNe adds the condition test_assoc_handler_append_id <> v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerAppendIdNe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerAppendId+" <> ?", v)
+}
+
+// TestAssocHandlerAppendIdGt adds the condition test_assoc_handler_append_id > v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerAppendIdGt(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerAppendId+" > ?", v)
+}
+
+// TestAssocHandlerAppendIdGe adds the condition test_assoc_handler_append_id >= v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerAppendIdGe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerAppendId+" >= ?", v)
+}
+
+// TestAssocHandlerAppendIdLt adds the condition test_assoc_handler_append_id < v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerAppendIdLt(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerAppendId+" < ?", v)
+}
+
+// TestAssocHandlerAppendIdLe adds the condition test_assoc_handler_append_id <= v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerAppendIdLe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerAppendId+" <= ?", v)
+}
+
+// TestAssocHandlerAppendIdIn adds the condition test_assoc_handler_append_id IN (vs)
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerAppendIdIn(vs ...string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerAppendId+" IN (?)", vs)
+}
+
+// TestAssocHandlerAppendIdLike adds the condition test_assoc_handler_append_id LIKE pattern
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerAppendIdLike(pattern string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerAppendId+" LIKE ?", pattern)
+}
+
+// TestAssocHandlerAppendIdIsNull adds the condition test_assoc_handler_append_id IS NULL
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerAppendIdIsNull() *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerAppendId + " IS NULL")
+}
+
+// TestAssocHandlerAppendIdIsNotNull adds the condition test_assoc_handler_append_id IS NOT NULL
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerAppendIdIsNotNull() *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerAppendId + " IS NOT NULL")
+}
+
+// OrderByTestAssocHandlerAppendId adds the order by test_assoc_handler_append_id, descending when desc
+func (q *TestTagAssociationORMQueryBuilder) OrderByTestAssocHandlerAppendId(desc bool) *TestTagAssociationORMQueryBuilder {
+	order := TestTagAssociationORMColumnTestAssocHandlerAppendId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// TestAssocHandlerClearIdEq adds the condition test_assoc_handler_clear_id = v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerClearIdEq(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerClearId+" = ?", v)
+}
+
+// TestAssocHandlerClearIdNe adds the condition test_assoc_handler_clear_id <> v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerClearIdNe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerClearId+" <> ?", v)
+}
+
+// TestAssocHandlerClearIdGt adds the condition test_assoc_handler_clear_id > v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerClearIdGt(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerClearId+" > ?", v)
+}
+
+// TestAssocHandlerClearIdGe adds the condition test_assoc_handler_clear_id >= v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerClearIdGe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerClearId+" >= ?", v)
+}
+
+// TestAssocHandlerClearIdLt adds the condition test_assoc_handler_clear_id < v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerClearIdLt(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerClearId+" < ?", v)
+}
+
+// TestAssocHandlerClearIdLe adds the condition test_assoc_handler_clear_id <= v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerClearIdLe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerClearId+" <= ?", v)
+}
+
+// TestAssocHandlerClearIdIn adds the condition test_assoc_handler_clear_id IN (vs)
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerClearIdIn(vs ...string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerClearId+" IN (?)", vs)
+}
+
+// TestAssocHandlerClearIdLike adds the condition test_assoc_handler_clear_id LIKE pattern
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerClearIdLike(pattern string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerClearId+" LIKE ?", pattern)
+}
+
+// TestAssocHandlerClearIdIsNull adds the condition test_assoc_handler_clear_id IS NULL
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerClearIdIsNull() *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerClearId + " IS NULL")
+}
+
+// TestAssocHandlerClearIdIsNotNull adds the condition test_assoc_handler_clear_id IS NOT NULL
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerClearIdIsNotNull() *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerClearId + " IS NOT NULL")
+}
+
+// OrderByTestAssocHandlerClearId adds the order by test_assoc_handler_clear_id, descending when desc
+func (q *TestTagAssociationORMQueryBuilder) OrderByTestAssocHandlerClearId(desc bool) *TestTagAssociationORMQueryBuilder {
+	order := TestTagAssociationORMColumnTestAssocHandlerClearId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// TestAssocHandlerDefaultIdEq adds the condition test_assoc_handler_default_id = v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerDefaultIdEq(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerDefaultId+" = ?", v)
+}
+
+// TestAssocHandlerDefaultIdNe adds the condition test_assoc_handler_default_id <> v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerDefaultIdNe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerDefaultId+" <> ?", v)
+}
+
+// TestAssocHandlerDefaultIdGt adds the condition test_assoc_handler_default_id > v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerDefaultIdGt(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerDefaultId+" > ?", v)
+}
+
+// TestAssocHandlerDefaultIdGe adds the condition test_assoc_handler_default_id >= v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerDefaultIdGe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerDefaultId+" >= ?", v)
+}
+
+// TestAssocHandlerDefaultIdLt adds the condition test_assoc_handler_default_id < v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerDefaultIdLt(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerDefaultId+" < ?", v)
+}
+
+// TestAssocHandlerDefaultIdLe adds the condition test_assoc_handler_default_id <= v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerDefaultIdLe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerDefaultId+" <= ?", v)
+}
+
+// TestAssocHandlerDefaultIdIn adds the condition test_assoc_handler_default_id IN (vs)
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerDefaultIdIn(vs ...string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerDefaultId+" IN (?)", vs)
+}
+
+// TestAssocHandlerDefaultIdLike adds the condition test_assoc_handler_default_id LIKE pattern
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerDefaultIdLike(pattern string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerDefaultId+" LIKE ?", pattern)
+}
+
+// TestAssocHandlerDefaultIdIsNull adds the condition test_assoc_handler_default_id IS NULL
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerDefaultIdIsNull() *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerDefaultId + " IS NULL")
+}
+
+// TestAssocHandlerDefaultIdIsNotNull adds the condition test_assoc_handler_default_id IS NOT NULL
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerDefaultIdIsNotNull() *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerDefaultId + " IS NOT NULL")
+}
+
+// OrderByTestAssocHandlerDefaultId adds the order by test_assoc_handler_default_id, descending when desc
+func (q *TestTagAssociationORMQueryBuilder) OrderByTestAssocHandlerDefaultId(desc bool) *TestTagAssociationORMQueryBuilder {
+	order := TestTagAssociationORMColumnTestAssocHandlerDefaultId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// TestAssocHandlerReplaceIdEq adds the condition test_assoc_handler_replace_id = v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerReplaceIdEq(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerReplaceId+" = ?", v)
+}
+
+// TestAssocHandlerReplaceIdNe adds the condition test_assoc_handler_replace_id <> v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerReplaceIdNe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerReplaceId+" <> ?", v)
+}
+
+// TestAssocHandlerReplaceIdGt adds the condition test_assoc_handler_replace_id > v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerReplaceIdGt(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerReplaceId+" > ?", v)
+}
+
+// TestAssocHandlerReplaceIdGe adds the condition test_assoc_handler_replace_id >= v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerReplaceIdGe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerReplaceId+" >= ?", v)
+}
+
+// TestAssocHandlerReplaceIdLt adds the condition test_assoc_handler_replace_id < v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerReplaceIdLt(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerReplaceId+" < ?", v)
+}
+
+// TestAssocHandlerReplaceIdLe adds the condition test_assoc_handler_replace_id <= v
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerReplaceIdLe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerReplaceId+" <= ?", v)
+}
+
+// TestAssocHandlerReplaceIdIn adds the condition test_assoc_handler_replace_id IN (vs)
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerReplaceIdIn(vs ...string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerReplaceId+" IN (?)", vs)
+}
+
+// TestAssocHandlerReplaceIdLike adds the condition test_assoc_handler_replace_id LIKE pattern
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerReplaceIdLike(pattern string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerReplaceId+" LIKE ?", pattern)
+}
+
+// TestAssocHandlerReplaceIdIsNull adds the condition test_assoc_handler_replace_id IS NULL
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerReplaceIdIsNull() *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerReplaceId + " IS NULL")
+}
+
+// TestAssocHandlerReplaceIdIsNotNull adds the condition test_assoc_handler_replace_id IS NOT NULL
+func (q *TestTagAssociationORMQueryBuilder) TestAssocHandlerReplaceIdIsNotNull() *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestAssocHandlerReplaceId + " IS NOT NULL")
+}
+
+// OrderByTestAssocHandlerReplaceId adds the order by test_assoc_handler_replace_id, descending when desc
+func (q *TestTagAssociationORMQueryBuilder) OrderByTestAssocHandlerReplaceId(desc bool) *TestTagAssociationORMQueryBuilder {
+	order := TestTagAssociationORMColumnTestAssocHandlerReplaceId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// TestTagIdEq adds the condition test_tag_id = v
+func (q *TestTagAssociationORMQueryBuilder) TestTagIdEq(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestTagId+" = ?", v)
+}
+
+// TestTagIdNe adds the condition test_tag_id <> v
+func (q *TestTagAssociationORMQueryBuilder) TestTagIdNe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestTagId+" <> ?", v)
+}
+
+// TestTagIdGt adds the condition test_tag_id > v
+func (q *TestTagAssociationORMQueryBuilder) TestTagIdGt(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestTagId+" > ?", v)
+}
+
+// TestTagIdGe adds the condition test_tag_id >= v
+func (q *TestTagAssociationORMQueryBuilder) TestTagIdGe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestTagId+" >= ?", v)
+}
+
+// TestTagIdLt adds the condition test_tag_id < v
+func (q *TestTagAssociationORMQueryBuilder) TestTagIdLt(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestTagId+" < ?", v)
+}
+
+// TestTagIdLe adds the condition test_tag_id <= v
+func (q *TestTagAssociationORMQueryBuilder) TestTagIdLe(v string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestTagId+" <= ?", v)
+}
+
+// TestTagIdIn adds the condition test_tag_id IN (vs)
+func (q *TestTagAssociationORMQueryBuilder) TestTagIdIn(vs ...string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestTagId+" IN (?)", vs)
+}
+
+// TestTagIdLike adds the condition test_tag_id LIKE pattern
+func (q *TestTagAssociationORMQueryBuilder) TestTagIdLike(pattern string) *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestTagId+" LIKE ?", pattern)
+}
+
+// TestTagIdIsNull adds the condition test_tag_id IS NULL
+func (q *TestTagAssociationORMQueryBuilder) TestTagIdIsNull() *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestTagId + " IS NULL")
+}
+
+// TestTagIdIsNotNull adds the condition test_tag_id IS NOT NULL
+func (q *TestTagAssociationORMQueryBuilder) TestTagIdIsNotNull() *TestTagAssociationORMQueryBuilder {
+	return q.where(TestTagAssociationORMColumnTestTagId + " IS NOT NULL")
+}
+
+// OrderByTestTagId adds the order by test_tag_id, descending when desc
+func (q *TestTagAssociationORMQueryBuilder) OrderByTestTagId(desc bool) *TestTagAssociationORMQueryBuilder {
+	order := TestTagAssociationORMColumnTestTagId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
 // DefaultListTestTagAssociation executes a gorm list call
 func DefaultListTestTagAssociation(ctx context.Context, db *gorm.DB) ([]*TestTagAssociation, error) {
 	in := TestTagAssociation{}
@@ -5067,7 +10474,7 @@ func DefaultListTestTagAssociation(ctx context.Context, db *gorm.DB) ([]*TestTag
 	db = db.Where(&ormObj)
 	ormResponse := []TestTagAssociationORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -5095,6 +10502,24 @@ type TestTagAssociationORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]TestTagAssociationORM) error
 }
 
+// withPrimaryIncludedTransaction runs fn in a new transaction of db, so that the
+// changes of PrimaryIncludedORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withPrimaryIncludedTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreatePrimaryIncluded executes a basic gorm create call
 func DefaultCreatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
 	if in == nil {
@@ -5110,7 +10535,7 @@ func DefaultCreatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -5128,6 +10553,71 @@ type PrimaryIncludedORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreatePrimaryIncludedSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreatePrimaryIncludedSet(ctx context.Context, in []*PrimaryIncluded, batchSize int, db *gorm.DB) ([]*PrimaryIncluded, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*PrimaryIncluded
+	if ok, err := withPrimaryIncludedTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreatePrimaryIncludedSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*PrimaryIncludedORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&PrimaryIncludedORM{}).(PrimaryIncludedORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&PrimaryIncludedORM{}).(PrimaryIncludedORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*PrimaryIncluded, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// PrimaryIncludedORMWithBeforeCreateSet called before DefaultCreatePrimaryIncludedSet inserts the objects
+type PrimaryIncludedORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*PrimaryIncludedORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// PrimaryIncludedORMWithAfterCreateSet called after DefaultCreatePrimaryIncludedSet inserts the objects
+type PrimaryIncludedORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*PrimaryIncludedORM, *gorm.DB) error
+}
+
 // DefaultApplyFieldMaskPrimaryIncluded patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskPrimaryIncluded(ctx context.Context, patchee *PrimaryIncluded, patcher *PrimaryIncluded, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryIncluded, error) {
 	if patcher == nil {
@@ -5166,6 +10656,73 @@ func DefaultApplyFieldMaskPrimaryIncluded(ctx context.Context, patchee *PrimaryI
 	return patchee, nil
 }
 
+// The columns of PrimaryIncludedORM
+const (
+	PrimaryIncludedORMColumnId = "id"
+)
+
+// PrimaryIncludedORMQueryBuilder builds a query of PrimaryIncludedORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type PrimaryIncludedORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// PrimaryIncludedORMQuery returns an empty query builder of PrimaryIncludedORM
+func PrimaryIncludedORMQuery() *PrimaryIncludedORMQueryBuilder {
+	return &PrimaryIncludedORMQueryBuilder{}
+}
+
+func (q *PrimaryIncludedORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *PrimaryIncludedORMQueryBuilder {
+	return &PrimaryIncludedORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *PrimaryIncludedORMQueryBuilder) where(query string, args ...interface{}) *PrimaryIncludedORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *PrimaryIncludedORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// IdEq adds the condition id = v
+func (q *PrimaryIncludedORMQueryBuilder) IdEq(v _go.UUID) *PrimaryIncludedORMQueryBuilder {
+	return q.where(PrimaryIncludedORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *PrimaryIncludedORMQueryBuilder) IdNe(v _go.UUID) *PrimaryIncludedORMQueryBuilder {
+	return q.where(PrimaryIncludedORMColumnId+" <> ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *PrimaryIncludedORMQueryBuilder) IdIn(vs ..._go.UUID) *PrimaryIncludedORMQueryBuilder {
+	return q.where(PrimaryIncludedORMColumnId+" IN (?)", vs)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *PrimaryIncludedORMQueryBuilder) OrderById(desc bool) *PrimaryIncludedORMQueryBuilder {
+	order := PrimaryIncludedORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PreloadChild adds the preload of the Child association
+func (q *PrimaryIncludedORMQueryBuilder) PreloadChild() *PrimaryIncludedORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Child")
+	})
+}
+
 // DefaultListPrimaryIncluded executes a gorm list call
 func DefaultListPrimaryIncluded(ctx context.Context, db *gorm.DB) ([]*PrimaryIncluded, error) {
 	in := PrimaryIncluded{}
@@ -5191,7 +10748,7 @@ func DefaultListPrimaryIncluded(ctx context.Context, db *gorm.DB) ([]*PrimaryInc
 	db = db.Order("id")
 	ormResponse := []PrimaryIncludedORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -0,0 +1,2669 @@
+package gormv2
+
+import (
+	context "context"
+	fmt "fmt"
+	errors "github.com/edhaight/protoc-gen-gorm/errors"
+	query "github.com/edhaight/protoc-gen-gorm/query"
+	ptypes "github.com/golang/protobuf/ptypes"
+	pq "github.com/lib/pq"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	clause "gorm.io/gorm/clause"
+	strings "strings"
+	time "time"
+)
+
+type TaskORM struct {
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	Due       *time.Time
+	Id        uint64
+	Labels    pq.StringArray `gorm:"type:text[]"`
+	Project   *ProjectORM    `gorm:"foreignKey:ProjectId;references:Id"`
+	ProjectId *uint64
+	Title     string
+	Version   int64
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TaskORM) TableName() string {
+	return "tasks"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Task) ToORM(ctx context.Context) (TaskORM, error) {
+	to := TaskORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TaskWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	if m.Labels != nil {
+		to.Labels = make(pq.StringArray, len(m.Labels))
+		copy(to.Labels, m.Labels)
+	}
+	if m.Due != nil {
+		var t time.Time
+		if t, err = ptypes.Timestamp(m.Due); err != nil {
+			return to, err
+		}
+		to.Due = &t
+	}
+	to.Version = m.Version
+	if m.Project != nil {
+		tempProject, err := m.Project.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Project = &tempProject
+	}
+	if posthook, ok := interface{}(m).(TaskWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TaskORM) ToPB(ctx context.Context) (Task, error) {
+	to := Task{}
+	var err error
+	if prehook, ok := interface{}(m).(TaskWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	if m.Labels != nil {
+		to.Labels = make(pq.StringArray, len(m.Labels))
+		copy(to.Labels, m.Labels)
+	}
+	if m.Due != nil {
+		if to.Due, err = ptypes.TimestampProto(*m.Due); err != nil {
+			return to, err
+		}
+	}
+	to.Version = m.Version
+	if m.Project != nil {
+		tempProject, err := m.Project.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Project = &tempProject
+	}
+	if posthook, ok := interface{}(m).(TaskWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Task the arg will be the target, the caller the one being converted from
+
+// TaskBeforeToORM called before default ToORM code
+type TaskWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TaskORM) error
+}
+
+// TaskAfterToORM called after default ToORM code
+type TaskWithAfterToORM interface {
+	AfterToORM(context.Context, *TaskORM) error
+}
+
+// TaskBeforeToPB called before default ToPB code
+type TaskWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Task) error
+}
+
+// TaskAfterToPB called after default ToPB code
+type TaskWithAfterToPB interface {
+	AfterToPB(context.Context, *Task) error
+}
+
+type ProjectORM struct {
+	Id    uint64
+	Name  string     `gorm:"uniqueIndex:idx_projects_name"`
+	Tasks []*TaskORM `gorm:"foreignKey:ProjectId;references:Id"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ProjectORM) TableName() string {
+	return "projects"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Project) ToORM(ctx context.Context) (ProjectORM, error) {
+	to := ProjectORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ProjectWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	for _, v := range m.Tasks {
+		if v != nil {
+			if tempTasks, cErr := v.ToORM(ctx); cErr == nil {
+				to.Tasks = append(to.Tasks, &tempTasks)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Tasks = append(to.Tasks, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(ProjectWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ProjectORM) ToPB(ctx context.Context) (Project, error) {
+	to := Project{}
+	var err error
+	if prehook, ok := interface{}(m).(ProjectWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	for _, v := range m.Tasks {
+		if v != nil {
+			if tempTasks, cErr := v.ToPB(ctx); cErr == nil {
+				to.Tasks = append(to.Tasks, &tempTasks)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Tasks = append(to.Tasks, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(ProjectWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Project the arg will be the target, the caller the one being converted from
+
+// ProjectBeforeToORM called before default ToORM code
+type ProjectWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ProjectORM) error
+}
+
+// ProjectAfterToORM called after default ToORM code
+type ProjectWithAfterToORM interface {
+	AfterToORM(context.Context, *ProjectORM) error
+}
+
+// ProjectBeforeToPB called before default ToPB code
+type ProjectWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Project) error
+}
+
+// ProjectAfterToPB called after default ToPB code
+type ProjectWithAfterToPB interface {
+	AfterToPB(context.Context, *Project) error
+}
+
+// withTaskTransaction runs fn in a new transaction of db, so that the
+// changes of TaskORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withTaskTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.Statement.ConnPool.(gorm.TxCommitter); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
+// DefaultCreateTask executes a basic gorm create call
+func DefaultCreateTask(ctx context.Context, in *Task, db *gorm.DB) (*Task, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TaskORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTaskSet executes a bulk gorm create call in a transaction,
+// inserting the objects in batches of batchSize, all at once when 0
+func DefaultCreateTaskSet(ctx context.Context, in []*Task, batchSize int, db *gorm.DB) ([]*Task, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*Task
+	if ok, err := withTaskTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateTaskSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*TaskORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&TaskORM{}).(TaskORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	if batchSize <= 0 {
+		batchSize = len(ormObjs)
+	}
+	if len(ormObjs) > 0 {
+		if err = db.CreateInBatches(ormObjs, batchSize).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&TaskORM{}).(TaskORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*Task, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// TaskORMWithBeforeCreateSet called before DefaultCreateTaskSet inserts the objects
+type TaskORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TaskORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// TaskORMWithAfterCreateSet called after DefaultCreateTaskSet inserts the objects
+type TaskORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TaskORM, *gorm.DB) error
+}
+
+// DefaultUpsertTask inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertTask(ctx context.Context, in *Task, db *gorm.DB) (*Task, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var deleted int64
+	if err = db.Unscoped().Model(&TaskORM{}).Where("id = ?", ormObj.Id).Where("deleted_at IS NOT NULL").Count(&deleted).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if deleted > 0 {
+		return nil, errors.NotFound(gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	onConflict := clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "id"},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{"due", "labels", "project_id", "title"}),
+	}
+	switch {
+	case db.Dialector.Name() == "mysql":
+		onConflict.DoUpdates = []clause.Assignment{
+			{Column: clause.Column{Name: "due"}, Value: gorm.Expr("IF(version = ?, VALUES(due), due)", ormObj.Version)},
+			{Column: clause.Column{Name: "labels"}, Value: gorm.Expr("IF(version = ?, VALUES(labels), labels)", ormObj.Version)},
+			{Column: clause.Column{Name: "project_id"}, Value: gorm.Expr("IF(version = ?, VALUES(project_id), project_id)", ormObj.Version)},
+			{Column: clause.Column{Name: "title"}, Value: gorm.Expr("IF(version = ?, VALUES(title), title)", ormObj.Version)},
+			{Column: clause.Column{Name: "version"}, Value: gorm.Expr("IF(version = ?, version + 1, version)", ormObj.Version)},
+		}
+	default:
+		onConflict.DoUpdates = append(onConflict.DoUpdates, clause.Assignment{Column: clause.Column{Name: "version"}, Value: gorm.Expr("tasks.version + 1")})
+		onConflict.Where = clause.Where{Exprs: []clause.Expression{gorm.Expr("tasks.version = ?", ormObj.Version)}}
+	}
+	res := db.Omit(clause.Associations).Clauses(onConflict).Create(&ormObj)
+	// the conflicting row of another version is neither updated nor returned by PostgreSQL
+	if err = res.Error; err == nil && res.RowsAffected == 0 {
+		return nil, errors.VersionConflictError
+	}
+	if err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := TaskORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type TaskORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultReadTask executes a basic gorm read call
+func DefaultReadTask(ctx context.Context, in *Task, db *gorm.DB, fs *query.FieldSelection) (*Task, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db, fs); err != nil {
+			return nil, err
+		}
+	}
+	if err = query.ApplyFieldSelection(ctx, &db, fs, &TaskORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db, fs); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TaskORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormResponse).(TaskORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db, fs); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TaskORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB, *query.FieldSelection) (*gorm.DB, error)
+}
+type TaskORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB, *query.FieldSelection) (*gorm.DB, error)
+}
+type TaskORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB, *query.FieldSelection) error
+}
+
+func DefaultDeleteTask(ctx context.Context, in *Task, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TaskORM{}).Error
+	if err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TaskORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+// DefaultDeleteTaskSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
+func DefaultDeleteTaskSet(ctx context.Context, in []*Task, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	if ok, err := withTaskTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteTaskSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TaskORM, len(in))
+	keys := []uint64{}
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		ormObjs[i] = &ormObj
+		keys = append(keys, ormObj.Id)
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TaskORM{})).(TaskORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	before := []*TaskORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[uint64]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
+	if err = db.Where("id IN (?)", keys).Delete(&TaskORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := (interface{}(&TaskORM{})).(TaskORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TaskORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Task, *gorm.DB) (*gorm.DB, error)
+}
+type TaskORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Task, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTask clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTask(ctx context.Context, in *Task, db *gorm.DB) (*Task, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &TaskORM{}
+	count = db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", ormObj.Id).First(lockedRow).RowsAffected
+	if count == 0 {
+		var deleted int64
+		if err = db.Unscoped().Model(&TaskORM{}).Where("id = ?", ormObj.Id).Where("deleted_at IS NOT NULL").Count(&deleted).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+		if deleted > 0 {
+			return nil, errors.NotFound(gorm.ErrRecordNotFound)
+		}
+	}
+	res := db.Model(&TaskORM{}).Where("id = ? AND version = ?", ormObj.Id, ormObj.Version).UpdateColumn("version", gorm.Expr("version + 1"))
+	if err = res.Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if res.RowsAffected == 0 {
+		return nil, errors.VersionConflictError
+	}
+	ormObj.Version++
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type TaskORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTask executes a basic gorm update call with patch behavior
+func DefaultPatchTask(ctx context.Context, in *Task, updateMask *field_mask.FieldMask, db *gorm.DB) (*Task, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Task
+	var err error
+	if hook, ok := interface{}(&pbObj).(TaskWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTask(ctx, &Task{Id: in.GetId()}, db, nil)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TaskWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTask(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	pbObj.Version = in.Version
+	if hook, ok := interface{}(&pbObj).(TaskWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTask(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TaskWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TaskWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Task, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TaskWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Task, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TaskWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Task, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TaskWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Task, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTask executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
+func DefaultPatchSetTask(ctx context.Context, objects []*Task, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Task, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
+	}
+	var out []*Task
+	if ok, err := withTaskTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetTask(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*TaskORM, len(objects))
+	hookedRows := make([]*TaskORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]uint64, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(TaskWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &TaskORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&TaskORM{}).(TaskORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*TaskORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[uint64]*TaskORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *Task, updateMask *field_mask.FieldMask, row *TaskORM, db *gorm.DB) (*Task, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if pbObj.Version != in.Version {
+			return nil, errors.VersionConflictError
+		}
+		if hook, ok := interface{}(&pbObj).(TaskWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskTask(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*Task, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *Task, in *Task, updateMask *field_mask.FieldMask, db *gorm.DB) (*Task, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(TaskWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateTask(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(TaskWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*Task, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
+		results = append(results, pbResponse)
+	}
+	return results, nil
+}
+
+// TaskORMWithBeforePatchSetRead called before DefaultPatchSetTask reads the rows
+type TaskORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*Task, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
+// DefaultRestoreTask clears the deletion of a soft deleted Task and then executes a gorm read call
+func DefaultRestoreTask(ctx context.Context, in *Task, db *gorm.DB) (*Task, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeRestore_); ok {
+		if db, err = hook.BeforeRestore_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res := db.Unscoped().Model(&TaskORM{}).Where(&ormObj).Where("deleted_at IS NOT NULL").Update("deleted_at", nil)
+	if err = res.Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if res.RowsAffected == 0 {
+		return nil, errors.NotFound(gorm.ErrRecordNotFound)
+	}
+	ormResponse := TaskORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterRestore_); ok {
+		if err = hook.AfterRestore_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TaskORMWithBeforeRestore_ interface {
+	BeforeRestore_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskORMWithAfterRestore_ interface {
+	AfterRestore_(context.Context, *gorm.DB) error
+}
+
+// DefaultHardDeleteTask executes a gorm delete call removing the row, soft deleted or not
+func DefaultHardDeleteTask(ctx context.Context, in *Task, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeHardDelete_); ok {
+		if db, err = hook.BeforeHardDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Unscoped().Where(&ormObj).Delete(&TaskORM{}).Error
+	if err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterHardDelete_); ok {
+		err = hook.AfterHardDelete_(ctx, db)
+	}
+	return err
+}
+
+type TaskORMWithBeforeHardDelete_ interface {
+	BeforeHardDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaskORMWithAfterHardDelete_ interface {
+	AfterHardDelete_(context.Context, *gorm.DB) error
+}
+
+// DefaultApplyFieldMaskTask patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTask(ctx context.Context, patchee *Task, patcher *Task, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Task, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedDue bool
+	var updatedProject bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Title" {
+			patchee.Title = patcher.Title
+			continue
+		}
+		if f == prefix+"Labels" {
+			patchee.Labels = patcher.Labels
+			continue
+		}
+		if !updatedDue && strings.HasPrefix(f, prefix+"Due.") {
+			updatedDue = true
+			if patcher.Due == nil {
+				patchee.Due = nil
+				continue
+			}
+			if patchee.Due == nil {
+				patchee.Due = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Due."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := query.MergeWithMask(patcher.Due, patchee.Due, childMask); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if f == prefix+"Due" {
+			updatedDue = true
+			patchee.Due = patcher.Due
+			continue
+		}
+		if f == prefix+"Version" {
+			patchee.Version = patcher.Version
+			continue
+		}
+		if !updatedProject && strings.HasPrefix(f, prefix+"Project.") {
+			updatedProject = true
+			if patcher.Project == nil {
+				patchee.Project = nil
+				continue
+			}
+			if patchee.Project == nil {
+				patchee.Project = &Project{}
+			}
+			if o, err := DefaultApplyFieldMaskProject(ctx, patchee.Project, patcher.Project, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Project.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Project = o
+			}
+			continue
+		}
+		if f == prefix+"Project" {
+			updatedProject = true
+			patchee.Project = patcher.Project
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// QueryColumn returns the column and a pointer to the field of the field path,
+// or a nil pointer when path isn't a column of TaskORM
+func (m *TaskORM) QueryColumn(path string) (string, interface{}) {
+	switch path {
+	case "due":
+		return "due", &m.Due
+	case "id":
+		return "id", &m.Id
+	case "labels":
+		return "labels", &m.Labels
+	case "title":
+		return "title", &m.Title
+	case "version":
+		return "version", &m.Version
+	}
+	return "", nil
+}
+
+// The columns of TaskORM
+const (
+	TaskORMColumnDeletedAt = "deleted_at"
+	TaskORMColumnDue       = "due"
+	TaskORMColumnId        = "id"
+	TaskORMColumnLabels    = "labels"
+	TaskORMColumnProjectId = "project_id"
+	TaskORMColumnTitle     = "title"
+	TaskORMColumnVersion   = "version"
+)
+
+// TaskORMQueryBuilder builds a query of TaskORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type TaskORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// TaskORMQuery returns an empty query builder of TaskORM
+func TaskORMQuery() *TaskORMQueryBuilder {
+	return &TaskORMQueryBuilder{}
+}
+
+func (q *TaskORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *TaskORMQueryBuilder {
+	return &TaskORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *TaskORMQueryBuilder) where(query string, args ...interface{}) *TaskORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *TaskORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// OrderByDeletedAt adds the order by deleted_at, descending when desc
+func (q *TaskORMQueryBuilder) OrderByDeletedAt(desc bool) *TaskORMQueryBuilder {
+	order := TaskORMColumnDeletedAt
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// DueEq adds the condition due = v
+func (q *TaskORMQueryBuilder) DueEq(v time.Time) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDue+" = ?", v)
+}
+
+// DueNe adds the condition due <> v
+func (q *TaskORMQueryBuilder) DueNe(v time.Time) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDue+" <> ?", v)
+}
+
+// DueGt adds the condition due > v
+func (q *TaskORMQueryBuilder) DueGt(v time.Time) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDue+" > ?", v)
+}
+
+// DueGe adds the condition due >= v
+func (q *TaskORMQueryBuilder) DueGe(v time.Time) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDue+" >= ?", v)
+}
+
+// DueLt adds the condition due < v
+func (q *TaskORMQueryBuilder) DueLt(v time.Time) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDue+" < ?", v)
+}
+
+// DueLe adds the condition due <= v
+func (q *TaskORMQueryBuilder) DueLe(v time.Time) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDue+" <= ?", v)
+}
+
+// DueIn adds the condition due IN (vs)
+func (q *TaskORMQueryBuilder) DueIn(vs ...time.Time) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDue+" IN (?)", vs)
+}
+
+// DueIsNull adds the condition due IS NULL
+func (q *TaskORMQueryBuilder) DueIsNull() *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDue + " IS NULL")
+}
+
+// DueIsNotNull adds the condition due IS NOT NULL
+func (q *TaskORMQueryBuilder) DueIsNotNull() *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDue + " IS NOT NULL")
+}
+
+// OrderByDue adds the order by due, descending when desc
+func (q *TaskORMQueryBuilder) OrderByDue(desc bool) *TaskORMQueryBuilder {
+	order := TaskORMColumnDue
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// IdEq adds the condition id = v
+func (q *TaskORMQueryBuilder) IdEq(v uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *TaskORMQueryBuilder) IdNe(v uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *TaskORMQueryBuilder) IdGt(v uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *TaskORMQueryBuilder) IdGe(v uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *TaskORMQueryBuilder) IdLt(v uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *TaskORMQueryBuilder) IdLe(v uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *TaskORMQueryBuilder) IdIn(vs ...uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnId+" IN (?)", vs)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *TaskORMQueryBuilder) OrderById(desc bool) *TaskORMQueryBuilder {
+	order := TaskORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// OrderByLabels adds the order by labels, descending when desc
+func (q *TaskORMQueryBuilder) OrderByLabels(desc bool) *TaskORMQueryBuilder {
+	order := TaskORMColumnLabels
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// ProjectIdEq adds the condition project_id = v
+func (q *TaskORMQueryBuilder) ProjectIdEq(v uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnProjectId+" = ?", v)
+}
+
+// ProjectIdNe adds the condition project_id <> v
+func (q *TaskORMQueryBuilder) ProjectIdNe(v uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnProjectId+" <> ?", v)
+}
+
+// ProjectIdGt adds the condition project_id > v
+func (q *TaskORMQueryBuilder) ProjectIdGt(v uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnProjectId+" > ?", v)
+}
+
+// ProjectIdGe adds the condition project_id >= v
+func (q *TaskORMQueryBuilder) ProjectIdGe(v uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnProjectId+" >= ?", v)
+}
+
+// ProjectIdLt adds the condition project_id < v
+func (q *TaskORMQueryBuilder) ProjectIdLt(v uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnProjectId+" < ?", v)
+}
+
+// ProjectIdLe adds the condition project_id <= v
+func (q *TaskORMQueryBuilder) ProjectIdLe(v uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnProjectId+" <= ?", v)
+}
+
+// ProjectIdIn adds the condition project_id IN (vs)
+func (q *TaskORMQueryBuilder) ProjectIdIn(vs ...uint64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnProjectId+" IN (?)", vs)
+}
+
+// ProjectIdIsNull adds the condition project_id IS NULL
+func (q *TaskORMQueryBuilder) ProjectIdIsNull() *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnProjectId + " IS NULL")
+}
+
+// ProjectIdIsNotNull adds the condition project_id IS NOT NULL
+func (q *TaskORMQueryBuilder) ProjectIdIsNotNull() *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnProjectId + " IS NOT NULL")
+}
+
+// OrderByProjectId adds the order by project_id, descending when desc
+func (q *TaskORMQueryBuilder) OrderByProjectId(desc bool) *TaskORMQueryBuilder {
+	order := TaskORMColumnProjectId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// TitleEq adds the condition title = v
+func (q *TaskORMQueryBuilder) TitleEq(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnTitle+" = ?", v)
+}
+
+// TitleNe adds the condition title <> v
+func (q *TaskORMQueryBuilder) TitleNe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnTitle+" <> ?", v)
+}
+
+// TitleGt adds the condition title > v
+func (q *TaskORMQueryBuilder) TitleGt(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnTitle+" > ?", v)
+}
+
+// TitleGe adds the condition title >= v
+func (q *TaskORMQueryBuilder) TitleGe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnTitle+" >= ?", v)
+}
+
+// TitleLt adds the condition title < v
+func (q *TaskORMQueryBuilder) TitleLt(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnTitle+" < ?", v)
+}
+
+// TitleLe adds the condition title <= v
+func (q *TaskORMQueryBuilder) TitleLe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnTitle+" <= ?", v)
+}
+
+// TitleIn adds the condition title IN (vs)
+func (q *TaskORMQueryBuilder) TitleIn(vs ...string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnTitle+" IN (?)", vs)
+}
+
+// TitleLike adds the condition title LIKE pattern
+func (q *TaskORMQueryBuilder) TitleLike(pattern string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnTitle+" LIKE ?", pattern)
+}
+
+// OrderByTitle adds the order by title, descending when desc
+func (q *TaskORMQueryBuilder) OrderByTitle(desc bool) *TaskORMQueryBuilder {
+	order := TaskORMColumnTitle
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// VersionEq adds the condition version = v
+func (q *TaskORMQueryBuilder) VersionEq(v int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnVersion+" = ?", v)
+}
+
+// VersionNe adds the condition version <> v
+func (q *TaskORMQueryBuilder) VersionNe(v int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnVersion+" <> ?", v)
+}
+
+// VersionGt adds the condition version > v
+func (q *TaskORMQueryBuilder) VersionGt(v int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnVersion+" > ?", v)
+}
+
+// VersionGe adds the condition version >= v
+func (q *TaskORMQueryBuilder) VersionGe(v int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnVersion+" >= ?", v)
+}
+
+// VersionLt adds the condition version < v
+func (q *TaskORMQueryBuilder) VersionLt(v int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnVersion+" < ?", v)
+}
+
+// VersionLe adds the condition version <= v
+func (q *TaskORMQueryBuilder) VersionLe(v int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnVersion+" <= ?", v)
+}
+
+// VersionIn adds the condition version IN (vs)
+func (q *TaskORMQueryBuilder) VersionIn(vs ...int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnVersion+" IN (?)", vs)
+}
+
+// OrderByVersion adds the order by version, descending when desc
+func (q *TaskORMQueryBuilder) OrderByVersion(desc bool) *TaskORMQueryBuilder {
+	order := TaskORMColumnVersion
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PreloadProject adds the preload of the Project association
+func (q *TaskORMQueryBuilder) PreloadProject() *TaskORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Project")
+	})
+}
+
+// DefaultListTask executes a gorm list call
+func DefaultListTask(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Task, error) {
+	in := Task{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	if err = query.ApplyCollectionOperators(ctx, &db, &TaskORM{}, f, s, p, fs); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TaskORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Task{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TaskORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) (*gorm.DB, error)
+}
+type TaskORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) (*gorm.DB, error)
+}
+type TaskORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TaskORM, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) error
+}
+
+// DefaultCountTask returns the count of the rows DefaultListTask lists, without
+// ordering and paging them
+func DefaultCountTask(ctx context.Context, db *gorm.DB, f *query.Filtering) (int64, error) {
+	in := Task{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithListCount); ok {
+		if count, ok, err := hook.ListCount(ctx, db, f); err != nil || ok {
+			return count, err
+		}
+	}
+	if err = query.ApplyCollectionOperators(ctx, &db, &TaskORM{}, f, nil, nil, nil); err != nil {
+		return 0, err
+	}
+	db = db.Where(&ormObj)
+	var count int64
+	if err := db.Model(&TaskORM{}).Count(&count).Error; err != nil {
+		return 0, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	return count, nil
+}
+
+// TaskORMWithListCount replaces the count of DefaultCountTask when ok, e.g.
+// to skip counting or to estimate the count of huge tables
+type TaskORMWithListCount interface {
+	ListCount(context.Context, *gorm.DB, *query.Filtering) (count int64, ok bool, err error)
+}
+
+// DefaultListDeletedTask executes a gorm list call of the soft deleted rows
+func DefaultListDeletedTask(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Task, error) {
+	in := Task{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListDeletedApplyQuery); ok {
+		if db, err = hook.BeforeListDeletedApplyQuery(ctx, db, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	if err = query.ApplyCollectionOperators(ctx, &db, &TaskORM{}, f, s, p, fs); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListDeletedFind); ok {
+		if db, err = hook.BeforeListDeletedFind(ctx, db, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Unscoped().Where("deleted_at IS NOT NULL")
+	db = db.Order("id")
+	ormResponse := []TaskORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterListDeletedFind); ok {
+		if err = hook.AfterListDeletedFind(ctx, db, &ormResponse, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Task{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TaskORMWithBeforeListDeletedApplyQuery interface {
+	BeforeListDeletedApplyQuery(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) (*gorm.DB, error)
+}
+type TaskORMWithBeforeListDeletedFind interface {
+	BeforeListDeletedFind(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) (*gorm.DB, error)
+}
+type TaskORMWithAfterListDeletedFind interface {
+	AfterListDeletedFind(context.Context, *gorm.DB, *[]TaskORM, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) error
+}
+
+// DefaultCountDeletedTask returns the count of the rows DefaultListDeletedTask lists, without
+// ordering and paging them
+func DefaultCountDeletedTask(ctx context.Context, db *gorm.DB, f *query.Filtering) (int64, error) {
+	in := Task{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithListDeletedCount); ok {
+		if count, ok, err := hook.ListDeletedCount(ctx, db, f); err != nil || ok {
+			return count, err
+		}
+	}
+	if err = query.ApplyCollectionOperators(ctx, &db, &TaskORM{}, f, nil, nil, nil); err != nil {
+		return 0, err
+	}
+	db = db.Where(&ormObj)
+	db = db.Unscoped().Where("deleted_at IS NOT NULL")
+	var count int64
+	if err := db.Model(&TaskORM{}).Count(&count).Error; err != nil {
+		return 0, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	return count, nil
+}
+
+// TaskORMWithListDeletedCount replaces the count of DefaultCountDeletedTask when ok, e.g.
+// to skip counting or to estimate the count of huge tables
+type TaskORMWithListDeletedCount interface {
+	ListDeletedCount(context.Context, *gorm.DB, *query.Filtering) (count int64, ok bool, err error)
+}
+
+// withProjectTransaction runs fn in a new transaction of db, so that the
+// changes of ProjectORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withProjectTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.Statement.ConnPool.(gorm.TxCommitter); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
+// DefaultCreateProject executes a basic gorm create call
+func DefaultCreateProject(ctx context.Context, in *Project, db *gorm.DB) (*Project, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ProjectORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateProjectSet executes a bulk gorm create call in a transaction,
+// inserting the objects in batches of batchSize, all at once when 0
+func DefaultCreateProjectSet(ctx context.Context, in []*Project, batchSize int, db *gorm.DB) ([]*Project, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*Project
+	if ok, err := withProjectTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateProjectSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*ProjectORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&ProjectORM{}).(ProjectORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	if batchSize <= 0 {
+		batchSize = len(ormObjs)
+	}
+	if len(ormObjs) > 0 {
+		if err = db.CreateInBatches(ormObjs, batchSize).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&ProjectORM{}).(ProjectORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*Project, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// ProjectORMWithBeforeCreateSet called before DefaultCreateProjectSet inserts the objects
+type ProjectORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*ProjectORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// ProjectORMWithAfterCreateSet called after DefaultCreateProjectSet inserts the objects
+type ProjectORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*ProjectORM, *gorm.DB) error
+}
+
+// DefaultUpsertProject inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertProject(ctx context.Context, in *Project, db *gorm.DB) (*Project, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	onConflict := clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "id"},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{"name"}),
+	}
+	if err = db.Omit(clause.Associations).Clauses(onConflict).Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := ProjectORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ProjectORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultReadProject executes a basic gorm read call
+func DefaultReadProject(ctx context.Context, in *Project, db *gorm.DB) (*Project, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = query.ApplyFieldSelection(ctx, &db, nil, &ProjectORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ProjectORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormResponse).(ProjectORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ProjectORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteProject(ctx context.Context, in *Project, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ProjectORM{}).Error
+	if err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ProjectORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+// DefaultDeleteProjectSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
+func DefaultDeleteProjectSet(ctx context.Context, in []*Project, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	if ok, err := withProjectTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteProjectSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*ProjectORM, len(in))
+	keys := []uint64{}
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		ormObjs[i] = &ormObj
+		keys = append(keys, ormObj.Id)
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ProjectORM{})).(ProjectORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	before := []*ProjectORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[uint64]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
+	if err = db.Where("id IN (?)", keys).Delete(&ProjectORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := (interface{}(&ProjectORM{})).(ProjectORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ProjectORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Project, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Project, *gorm.DB) error
+}
+
+// DefaultStrictUpdateProject clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateProject(ctx context.Context, in *Project, db *gorm.DB) (*Project, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ProjectORM{}
+	db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterTasks := TaskORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterTasks.ProjectId = new(uint64)
+	*filterTasks.ProjectId = ormObj.Id
+	if err = db.Where(filterTasks).Delete(&TaskORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ProjectORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchProject executes a basic gorm update call with patch behavior
+func DefaultPatchProject(ctx context.Context, in *Project, updateMask *field_mask.FieldMask, db *gorm.DB) (*Project, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Project
+	var err error
+	if hook, ok := interface{}(&pbObj).(ProjectWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadProject(ctx, &Project{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ProjectWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskProject(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ProjectWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateProject(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ProjectWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ProjectWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Project, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Project, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Project, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Project, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetProject executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
+func DefaultPatchSetProject(ctx context.Context, objects []*Project, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Project, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
+	}
+	var out []*Project
+	if ok, err := withProjectTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetProject(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*ProjectORM, len(objects))
+	hookedRows := make([]*ProjectORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]uint64, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(ProjectWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &ProjectORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&ProjectORM{}).(ProjectORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*ProjectORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[uint64]*ProjectORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *Project, updateMask *field_mask.FieldMask, row *ProjectORM, db *gorm.DB) (*Project, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(ProjectWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskProject(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*Project, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *Project, in *Project, updateMask *field_mask.FieldMask, db *gorm.DB) (*Project, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(ProjectWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateProject(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(ProjectWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*Project, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
+		results = append(results, pbResponse)
+	}
+	return results, nil
+}
+
+// ProjectORMWithBeforePatchSetRead called before DefaultPatchSetProject reads the rows
+type ProjectORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*Project, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
+// DefaultApplyFieldMaskProject patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskProject(ctx context.Context, patchee *Project, patcher *Project, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Project, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Tasks" {
+			patchee.Tasks = patcher.Tasks
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// QueryColumn returns the column and a pointer to the field of the field path,
+// or a nil pointer when path isn't a column of ProjectORM
+func (m *ProjectORM) QueryColumn(path string) (string, interface{}) {
+	switch path {
+	case "id":
+		return "id", &m.Id
+	case "name":
+		return "name", &m.Name
+	}
+	return "", nil
+}
+
+// The columns of ProjectORM
+const (
+	ProjectORMColumnId   = "id"
+	ProjectORMColumnName = "name"
+)
+
+// ProjectORMQueryBuilder builds a query of ProjectORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type ProjectORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// ProjectORMQuery returns an empty query builder of ProjectORM
+func ProjectORMQuery() *ProjectORMQueryBuilder {
+	return &ProjectORMQueryBuilder{}
+}
+
+func (q *ProjectORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *ProjectORMQueryBuilder {
+	return &ProjectORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *ProjectORMQueryBuilder) where(query string, args ...interface{}) *ProjectORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *ProjectORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// IdEq adds the condition id = v
+func (q *ProjectORMQueryBuilder) IdEq(v uint64) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *ProjectORMQueryBuilder) IdNe(v uint64) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *ProjectORMQueryBuilder) IdGt(v uint64) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *ProjectORMQueryBuilder) IdGe(v uint64) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *ProjectORMQueryBuilder) IdLt(v uint64) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *ProjectORMQueryBuilder) IdLe(v uint64) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *ProjectORMQueryBuilder) IdIn(vs ...uint64) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnId+" IN (?)", vs)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *ProjectORMQueryBuilder) OrderById(desc bool) *ProjectORMQueryBuilder {
+	order := ProjectORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// NameEq adds the condition name = v
+func (q *ProjectORMQueryBuilder) NameEq(v string) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnName+" = ?", v)
+}
+
+// NameNe adds the condition name <> v
+func (q *ProjectORMQueryBuilder) NameNe(v string) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnName+" <> ?", v)
+}
+
+// NameGt adds the condition name > v
+func (q *ProjectORMQueryBuilder) NameGt(v string) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnName+" > ?", v)
+}
+
+// NameGe adds the condition name >= v
+func (q *ProjectORMQueryBuilder) NameGe(v string) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnName+" >= ?", v)
+}
+
+// NameLt adds the condition name < v
+func (q *ProjectORMQueryBuilder) NameLt(v string) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnName+" < ?", v)
+}
+
+// NameLe adds the condition name <= v
+func (q *ProjectORMQueryBuilder) NameLe(v string) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnName+" <= ?", v)
+}
+
+// NameIn adds the condition name IN (vs)
+func (q *ProjectORMQueryBuilder) NameIn(vs ...string) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnName+" IN (?)", vs)
+}
+
+// NameLike adds the condition name LIKE pattern
+func (q *ProjectORMQueryBuilder) NameLike(pattern string) *ProjectORMQueryBuilder {
+	return q.where(ProjectORMColumnName+" LIKE ?", pattern)
+}
+
+// OrderByName adds the order by name, descending when desc
+func (q *ProjectORMQueryBuilder) OrderByName(desc bool) *ProjectORMQueryBuilder {
+	order := ProjectORMColumnName
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PreloadTasks adds the preload of the Tasks association
+func (q *ProjectORMQueryBuilder) PreloadTasks() *ProjectORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Tasks")
+	})
+}
+
+// DefaultListProject executes a gorm list call
+func DefaultListProject(ctx context.Context, db *gorm.DB) ([]*Project, error) {
+	in := Project{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = query.ApplyCollectionOperators(ctx, &db, &ProjectORM{}, nil, nil, nil, nil); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ProjectORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Project{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ProjectORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ProjectORM) error
+}
+type TaskServiceDefaultServer struct {
+	DB *gorm.DB
+	// ErrorTranslator converts the errors returned by the methods, errors.StatusTranslator
+	// is used when nil
+	ErrorTranslator errors.Translator
+}
+
+// translateError converts err with the ErrorTranslator of the server
+func (m *TaskServiceDefaultServer) translateError(err error) error {
+	if m.ErrorTranslator == nil {
+		return errors.StatusTranslator.Translate(err)
+	}
+	return m.ErrorTranslator.Translate(err)
+}
+
+// Create ...
+func (m *TaskServiceDefaultServer) Create(ctx context.Context, in *CreateTaskRequest) (*CreateTaskResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(TaskServiceTaskWithBeforeCreate); ok {
+		var err error
+		if db, err = custom.BeforeCreate(ctx, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	res, err := DefaultCreateTask(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, m.translateError(err)
+	}
+	out := &CreateTaskResponse{Result: res}
+	if custom, ok := interface{}(in).(TaskServiceTaskWithAfterCreate); ok {
+		var err error
+		if err = custom.AfterCreate(ctx, out, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	return out, nil
+}
+
+// TaskServiceTaskWithBeforeCreate called before DefaultCreateTask in the default Create handler
+type TaskServiceTaskWithBeforeCreate interface {
+	BeforeCreate(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TaskServiceTaskWithAfterCreate called before DefaultCreateTask in the default Create handler
+type TaskServiceTaskWithAfterCreate interface {
+	AfterCreate(context.Context, *CreateTaskResponse, *gorm.DB) error
+}
+
+// Read ...
+func (m *TaskServiceDefaultServer) Read(ctx context.Context, in *ReadTaskRequest) (*ReadTaskResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(TaskServiceTaskWithBeforeRead); ok {
+		var err error
+		if db, err = custom.BeforeRead(ctx, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	res, err := DefaultReadTask(ctx, &Task{Id: in.GetId()}, db, in.Fields)
+	if err != nil {
+		return nil, m.translateError(err)
+	}
+	out := &ReadTaskResponse{Result: res}
+	if custom, ok := interface{}(in).(TaskServiceTaskWithAfterRead); ok {
+		var err error
+		if err = custom.AfterRead(ctx, out, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	return out, nil
+}
+
+// TaskServiceTaskWithBeforeRead called before DefaultReadTask in the default Read handler
+type TaskServiceTaskWithBeforeRead interface {
+	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TaskServiceTaskWithAfterRead called before DefaultReadTask in the default Read handler
+type TaskServiceTaskWithAfterRead interface {
+	AfterRead(context.Context, *ReadTaskResponse, *gorm.DB) error
+}
+
+// Update ...
+func (m *TaskServiceDefaultServer) Update(ctx context.Context, in *UpdateTaskRequest) (*UpdateTaskResponse, error) {
+	var err error
+	var res *Task
+	db := m.DB
+	if custom, ok := interface{}(in).(TaskServiceTaskWithBeforeUpdate); ok {
+		var err error
+		if db, err = custom.BeforeUpdate(ctx, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	res, err = DefaultStrictUpdateTask(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, m.translateError(err)
+	}
+	out := &UpdateTaskResponse{Result: res}
+	if custom, ok := interface{}(in).(TaskServiceTaskWithAfterUpdate); ok {
+		var err error
+		if err = custom.AfterUpdate(ctx, out, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	return out, nil
+}
+
+// TaskServiceTaskWithBeforeUpdate called before DefaultUpdateTask in the default Update handler
+type TaskServiceTaskWithBeforeUpdate interface {
+	BeforeUpdate(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TaskServiceTaskWithAfterUpdate called before DefaultUpdateTask in the default Update handler
+type TaskServiceTaskWithAfterUpdate interface {
+	AfterUpdate(context.Context, *UpdateTaskResponse, *gorm.DB) error
+}
+
+// List ...
+func (m *TaskServiceDefaultServer) List(ctx context.Context, in *ListTasksRequest) (*ListTasksResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(TaskServiceTaskWithBeforeList); ok {
+		var err error
+		if db, err = custom.BeforeList(ctx, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	pagedRequest := false
+	if in.GetPaging().GetLimit() >= 1 {
+		in.Paging.Limit++
+		pagedRequest = true
+	}
+	res, err := DefaultListTask(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
+	if err != nil {
+		return nil, m.translateError(err)
+	}
+	var resPaging *query.PageInfo
+	if pagedRequest {
+		var offset int32
+		var size int32 = int32(len(res))
+		if size == in.GetPaging().GetLimit() {
+			size--
+			res = res[:size]
+			offset = in.GetPaging().GetOffset() + size
+		}
+		resPaging = &query.PageInfo{Offset: offset}
+	}
+	if resPaging != nil {
+		count, err := DefaultCountTask(ctx, db, in.Filter)
+		if err != nil {
+			return nil, m.translateError(err)
+		}
+		resPaging.Size = int32(count)
+	}
+	out := &ListTasksResponse{Results: res, PageInfo: resPaging}
+	if custom, ok := interface{}(in).(TaskServiceTaskWithAfterList); ok {
+		var err error
+		if err = custom.AfterList(ctx, out, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	return out, nil
+}
+
+// TaskServiceTaskWithBeforeList called before DefaultListTask in the default List handler
+type TaskServiceTaskWithBeforeList interface {
+	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TaskServiceTaskWithAfterList called before DefaultListTask in the default List handler
+type TaskServiceTaskWithAfterList interface {
+	AfterList(context.Context, *ListTasksResponse, *gorm.DB) error
+}
+
+// Delete ...
+func (m *TaskServiceDefaultServer) Delete(ctx context.Context, in *DeleteTaskRequest) (*DeleteTaskResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(TaskServiceTaskWithBeforeDelete); ok {
+		var err error
+		if db, err = custom.BeforeDelete(ctx, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	err := DefaultDeleteTask(ctx, &Task{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, m.translateError(err)
+	}
+	out := &DeleteTaskResponse{}
+	if custom, ok := interface{}(in).(TaskServiceTaskWithAfterDelete); ok {
+		var err error
+		if err = custom.AfterDelete(ctx, out, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	return out, nil
+}
+
+// TaskServiceTaskWithBeforeDelete called before DefaultDeleteTask in the default Delete handler
+type TaskServiceTaskWithBeforeDelete interface {
+	BeforeDelete(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TaskServiceTaskWithAfterDelete called before DefaultDeleteTask in the default Delete handler
+type TaskServiceTaskWithAfterDelete interface {
+	AfterDelete(context.Context, *DeleteTaskResponse, *gorm.DB) error
+}
+
+// DeleteSet ...
+func (m *TaskServiceDefaultServer) DeleteSet(ctx context.Context, in *DeleteTasksRequest) (*DeleteTaskResponse, error) {
+	db := m.DB
+	objs := []*Task{}
+	for _, id := range in.Ids {
+		objs = append(objs, &Task{Id: id})
+	}
+	if custom, ok := interface{}(in).(TaskServiceTaskWithBeforeDeleteSet); ok {
+		var err error
+		if db, err = custom.BeforeDeleteSet(ctx, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	err := DefaultDeleteTaskSet(ctx, objs, db)
+	if err != nil {
+		return nil, m.translateError(err)
+	}
+	out := &DeleteTaskResponse{}
+	if custom, ok := interface{}(in).(TaskServiceTaskWithAfterDeleteSet); ok {
+		var err error
+		if err = custom.AfterDeleteSet(ctx, out, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	return out, nil
+}
+
+// TaskServiceTaskWithBeforeDeleteSet called before DefaultDeleteSetTask in the default DeleteSet handler
+type TaskServiceTaskWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TaskServiceTaskWithAfterDeleteSet called before DefaultDeleteSetTask in the default DeleteSet handler
+type TaskServiceTaskWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, *DeleteTaskResponse, *gorm.DB) error
+}
+
+// ListDeleted ...
+func (m *TaskServiceDefaultServer) ListDeleted(ctx context.Context, in *ListTasksRequest) (*ListTasksResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(TaskServiceTaskWithBeforeListDeleted); ok {
+		var err error
+		if db, err = custom.BeforeListDeleted(ctx, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	pagedRequest := false
+	if in.GetPaging().GetLimit() >= 1 {
+		in.Paging.Limit++
+		pagedRequest = true
+	}
+	res, err := DefaultListDeletedTask(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
+	if err != nil {
+		return nil, m.translateError(err)
+	}
+	var resPaging *query.PageInfo
+	if pagedRequest {
+		var offset int32
+		var size int32 = int32(len(res))
+		if size == in.GetPaging().GetLimit() {
+			size--
+			res = res[:size]
+			offset = in.GetPaging().GetOffset() + size
+		}
+		resPaging = &query.PageInfo{Offset: offset}
+	}
+	if resPaging != nil {
+		count, err := DefaultCountDeletedTask(ctx, db, in.Filter)
+		if err != nil {
+			return nil, m.translateError(err)
+		}
+		resPaging.Size = int32(count)
+	}
+	out := &ListTasksResponse{Results: res, PageInfo: resPaging}
+	if custom, ok := interface{}(in).(TaskServiceTaskWithAfterListDeleted); ok {
+		var err error
+		if err = custom.AfterListDeleted(ctx, out, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	return out, nil
+}
+
+// TaskServiceTaskWithBeforeListDeleted called before DefaultListDeletedTask in the default ListDeleted handler
+type TaskServiceTaskWithBeforeListDeleted interface {
+	BeforeListDeleted(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TaskServiceTaskWithAfterListDeleted called before DefaultListDeletedTask in the default ListDeleted handler
+type TaskServiceTaskWithAfterListDeleted interface {
+	AfterListDeleted(context.Context, *ListTasksResponse, *gorm.DB) error
+}
+
+// Restore ...
+func (m *TaskServiceDefaultServer) Restore(ctx context.Context, in *RestoreTaskRequest) (*RestoreTaskResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(TaskServiceTaskWithBeforeRestore); ok {
+		var err error
+		if db, err = custom.BeforeRestore(ctx, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	res, err := DefaultRestoreTask(ctx, &Task{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, m.translateError(err)
+	}
+	out := &RestoreTaskResponse{Result: res}
+	if custom, ok := interface{}(in).(TaskServiceTaskWithAfterRestore); ok {
+		var err error
+		if err = custom.AfterRestore(ctx, out, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	return out, nil
+}
+
+// TaskServiceTaskWithBeforeRestore called before DefaultRestoreTask in the default Restore handler
+type TaskServiceTaskWithBeforeRestore interface {
+	BeforeRestore(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TaskServiceTaskWithAfterRestore called before DefaultRestoreTask in the default Restore handler
+type TaskServiceTaskWithAfterRestore interface {
+	AfterRestore(context.Context, *RestoreTaskResponse, *gorm.DB) error
+}
+
+// Upsert ...
+func (m *TaskServiceDefaultServer) Upsert(ctx context.Context, in *UpsertTaskRequest) (*UpsertTaskResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(TaskServiceTaskWithBeforeUpsert); ok {
+		var err error
+		if db, err = custom.BeforeUpsert(ctx, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	res, err := DefaultUpsertTask(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, m.translateError(err)
+	}
+	out := &UpsertTaskResponse{Result: res}
+	if custom, ok := interface{}(in).(TaskServiceTaskWithAfterUpsert); ok {
+		var err error
+		if err = custom.AfterUpsert(ctx, out, db); err != nil {
+			return nil, m.translateError(err)
+		}
+	}
+	return out, nil
+}
+
+// TaskServiceTaskWithBeforeUpsert called before DefaultUpsertTask in the default Upsert handler
+type TaskServiceTaskWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TaskServiceTaskWithAfterUpsert called before DefaultUpsertTask in the default Upsert handler
+type TaskServiceTaskWithAfterUpsert interface {
+	AfterUpsert(context.Context, *UpsertTaskResponse, *gorm.DB) error
+}
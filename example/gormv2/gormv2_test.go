@@ -0,0 +1,116 @@
+package gormv2
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/protobuf/field_mask"
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+// BeforePatchRead restricts the read of the row of a patched task to the
+// ones of the project of the task.
+func (m *Task) BeforePatchRead(ctx context.Context, in *Task, updateMask *field_mask.FieldMask, db *gorm.DB) (*gorm.DB, error) {
+	return db.Where("project_id = ?", in.GetProject().GetId()), nil
+}
+
+func TestPatchSetReadHooks(t *testing.T) {
+	statements := &recorder{}
+	db, err := gorm.Open(dryRunDialector{}, &gorm.Config{DryRun: true, Logger: statements})
+	if err != nil {
+		t.Fatal(err)
+	}
+	objects := []*Task{
+		{Id: 1, Title: "a", Project: &Project{Id: 10}},
+		{Id: 2, Title: "b", Project: &Project{Id: 20}},
+	}
+	masks := []*field_mask.FieldMask{{Paths: []string{"Title"}}, {Paths: []string{"Title"}}}
+	// the dry run reads no row, only the statements matter
+	DefaultPatchSetTask(context.Background(), objects, masks, db)
+
+	var reads []string
+	for _, statement := range statements.sql {
+		if strings.HasPrefix(statement, "SELECT") && strings.Contains(statement, "project_id =") {
+			reads = append(reads, statement)
+		}
+	}
+	expected := []struct{ own, other string }{
+		{"project_id = 10", "project_id = 20"},
+		{"project_id = 20", "project_id = 10"},
+	}
+	if len(reads) < len(expected) {
+		t.Fatalf("Expected a read of each task, got %q", statements.sql)
+	}
+	for i, v := range expected {
+		if !strings.Contains(reads[i], v.own) || strings.Contains(reads[i], v.other) {
+			t.Errorf("Expected the read of task %d to only have %q, got %q", i+1, v.own, reads[i])
+		}
+	}
+	for _, statement := range statements.sql {
+		if strings.Contains(statement, "IN (") {
+			t.Errorf("Expected no batched read of the hooked tasks, got %q", statement)
+		}
+	}
+}
+
+// recorder is a gorm logger recording the statements.
+type recorder struct {
+	sql []string
+}
+
+func (r *recorder) LogMode(logger.LogLevel) logger.Interface      { return r }
+func (r *recorder) Info(context.Context, string, ...interface{})  {}
+func (r *recorder) Warn(context.Context, string, ...interface{})  {}
+func (r *recorder) Error(context.Context, string, ...interface{}) {}
+
+func (r *recorder) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	statement, _ := fc()
+	r.sql = append(r.sql, statement)
+}
+
+// dryRunDialector builds the statements of a dry run session, with a
+// connection which reports being a transaction and runs nothing.
+type dryRunDialector struct{}
+
+func (dryRunDialector) Name() string { return "dryrun" }
+
+func (dryRunDialector) Initialize(db *gorm.DB) error {
+	callbacks.RegisterDefaultCallbacks(db, &callbacks.Config{})
+	db.ConnPool = dryRunConn{}
+	return nil
+}
+
+func (dryRunDialector) Migrator(*gorm.DB) gorm.Migrator { return nil }
+func (dryRunDialector) DataTypeOf(*schema.Field) string { return "" }
+func (dryRunDialector) DefaultValueOf(*schema.Field) clause.Expression {
+	return clause.Expr{SQL: "DEFAULT"}
+}
+func (dryRunDialector) BindVarTo(w clause.Writer, _ *gorm.Statement, _ interface{}) { w.WriteByte('?') }
+func (dryRunDialector) QuoteTo(w clause.Writer, s string)                           { w.WriteString(s) }
+
+func (dryRunDialector) Explain(sql string, vars ...interface{}) string {
+	return logger.ExplainSQL(sql, nil, `'`, vars...)
+}
+
+var errDryRun = errors.New("dry run")
+
+type dryRunConn struct{}
+
+func (dryRunConn) PrepareContext(context.Context, string) (*sql.Stmt, error) { return nil, errDryRun }
+func (dryRunConn) ExecContext(context.Context, string, ...interface{}) (sql.Result, error) {
+	return nil, errDryRun
+}
+func (dryRunConn) QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error) {
+	return nil, errDryRun
+}
+func (dryRunConn) QueryRowContext(context.Context, string, ...interface{}) *sql.Row { return nil }
+func (dryRunConn) Commit() error                                                    { return nil }
+func (dryRunConn) Rollback() error                                                  { return nil }
@@ -0,0 +1,31 @@
+package type_mappings
+
+import (
+	"fmt"
+)
+
+// MoneyToCents converts a Money to a number of cents, the currency is not
+// stored.
+func MoneyToCents(m *Money) (int64, error) {
+	return m.Units*100 + int64(m.Nanos)/1e7, nil
+}
+
+// CentsToMoney converts a number of cents to a Money in USD.
+func CentsToMoney(cents int64) (*Money, error) {
+	return &Money{CurrencyCode: "USD", Units: cents / 100, Nanos: int32(cents%100) * 1e7}, nil
+}
+
+// LatLngToText converts a LatLng to its "lat,lng" representation.
+func LatLngToText(l *LatLng) (*string, error) {
+	text := fmt.Sprintf("%g,%g", l.Latitude, l.Longitude)
+	return &text, nil
+}
+
+// TextToLatLng parses the "lat,lng" representation of a LatLng.
+func TextToLatLng(text *string) (*LatLng, error) {
+	l := &LatLng{}
+	if _, err := fmt.Sscanf(*text, "%g,%g", &l.Latitude, &l.Longitude); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
@@ -0,0 +1,991 @@
+package type_mappings
+
+import (
+	context "context"
+	sql "database/sql"
+	fmt "fmt"
+	errors "github.com/edhaight/protoc-gen-gorm/errors"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
+	gorm "github.com/jinzhu/gorm"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+)
+
+type StoreORM struct {
+	Id       int64
+	Location *string
+	Rent     int64 `gorm:"type:bigint;not null"`
+	Revenue  int64 `gorm:"type:bigint"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (StoreORM) TableName() string {
+	return "stores"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Store) ToORM(ctx context.Context) (StoreORM, error) {
+	to := StoreORM{}
+	var err error
+	if prehook, ok := interface{}(m).(StoreWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Revenue != nil {
+		if to.Revenue, err = MoneyToCents(m.Revenue); err != nil {
+			return to, err
+		}
+	}
+	if m.Rent != nil {
+		if to.Rent, err = MoneyToCents(m.Rent); err != nil {
+			return to, err
+		}
+	}
+	if m.Location != nil {
+		if to.Location, err = LatLngToText(m.Location); err != nil {
+			return to, err
+		}
+	}
+	if posthook, ok := interface{}(m).(StoreWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *StoreORM) ToPB(ctx context.Context) (Store, error) {
+	to := Store{}
+	var err error
+	if prehook, ok := interface{}(m).(StoreWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if to.Revenue, err = CentsToMoney(m.Revenue); err != nil {
+		return to, err
+	}
+	if to.Rent, err = CentsToMoney(m.Rent); err != nil {
+		return to, err
+	}
+	if m.Location != nil {
+		if to.Location, err = TextToLatLng(m.Location); err != nil {
+			return to, err
+		}
+	}
+	if posthook, ok := interface{}(m).(StoreWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Store the arg will be the target, the caller the one being converted from
+
+// StoreBeforeToORM called before default ToORM code
+type StoreWithBeforeToORM interface {
+	BeforeToORM(context.Context, *StoreORM) error
+}
+
+// StoreAfterToORM called after default ToORM code
+type StoreWithAfterToORM interface {
+	AfterToORM(context.Context, *StoreORM) error
+}
+
+// StoreBeforeToPB called before default ToPB code
+type StoreWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Store) error
+}
+
+// StoreAfterToPB called after default ToPB code
+type StoreWithAfterToPB interface {
+	AfterToPB(context.Context, *Store) error
+}
+
+// withStoreTransaction runs fn in a new transaction of db, so that the
+// changes of StoreORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withStoreTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
+// DefaultCreateStore executes a basic gorm create call
+func DefaultCreateStore(ctx context.Context, in *Store, db *gorm.DB) (*Store, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StoreORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(StoreORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type StoreORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StoreORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateStoreSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateStoreSet(ctx context.Context, in []*Store, batchSize int, db *gorm.DB) ([]*Store, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*Store
+	if ok, err := withStoreTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateStoreSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*StoreORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&StoreORM{}).(StoreORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&StoreORM{}).(StoreORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*Store, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// StoreORMWithBeforeCreateSet called before DefaultCreateStoreSet inserts the objects
+type StoreORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*StoreORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// StoreORMWithAfterCreateSet called after DefaultCreateStoreSet inserts the objects
+type StoreORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*StoreORM, *gorm.DB) error
+}
+
+// DefaultUpsertStore inserts in, or updates the row with the same id
+// in a single statement, and returns the stored row. The associations aren't saved
+func DefaultUpsertStore(ctx context.Context, in *Store, db *gorm.DB) (*Store, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StoreORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	// PostgreSQL and SQLite share the ON CONFLICT clause
+	option := "ON CONFLICT (id) DO UPDATE SET location = excluded.location, rent = excluded.rent, revenue = excluded.revenue"
+	if db.Dialect().GetName() == "mysql" {
+		option = "ON DUPLICATE KEY UPDATE location = VALUES(location), rent = VALUES(rent), revenue = VALUES(revenue)"
+	}
+	if err = db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	// the row is read back as the inserted values are partly ignored by the update,
+	// and some dialects don't return the primary key of the updated row
+	stored := StoreORM{}
+	if err = db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(StoreORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type StoreORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StoreORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultReadStore executes a basic gorm read call
+func DefaultReadStore(ctx context.Context, in *Store, db *gorm.DB) (*Store, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(StoreORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &StoreORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StoreORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := StoreORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormResponse).(StoreORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type StoreORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StoreORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StoreORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteStore(ctx context.Context, in *Store, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(StoreORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&StoreORM{}).Error
+	if err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(StoreORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type StoreORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StoreORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+// DefaultDeleteStoreSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
+func DefaultDeleteStoreSet(ctx context.Context, in []*Store, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	if ok, err := withStoreTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteStoreSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*StoreORM, len(in))
+	keys := []int64{}
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		ormObjs[i] = &ormObj
+		keys = append(keys, ormObj.Id)
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&StoreORM{})).(StoreORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	before := []*StoreORM{}
+	if err = db.Where("id IN (?)", keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[int64]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
+	if err = db.Where("id IN (?)", keys).Delete(&StoreORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := (interface{}(&StoreORM{})).(StoreORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type StoreORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Store, *gorm.DB) (*gorm.DB, error)
+}
+type StoreORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Store, *gorm.DB) error
+}
+
+// DefaultStrictUpdateStore clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateStore(ctx context.Context, in *Store, db *gorm.DB) (*Store, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &StoreORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(StoreORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(StoreORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(StoreORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type StoreORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StoreORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StoreORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchStore executes a basic gorm update call with patch behavior
+func DefaultPatchStore(ctx context.Context, in *Store, updateMask *field_mask.FieldMask, db *gorm.DB) (*Store, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Store
+	var err error
+	if hook, ok := interface{}(&pbObj).(StoreWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadStore(ctx, &Store{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(StoreWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskStore(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(StoreWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateStore(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(StoreWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type StoreWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Store, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type StoreWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Store, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type StoreWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Store, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type StoreWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Store, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetStore executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
+func DefaultPatchSetStore(ctx context.Context, objects []*Store, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Store, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
+	}
+	var out []*Store
+	if ok, err := withStoreTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetStore(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*StoreORM, len(objects))
+	hookedRows := make([]*StoreORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]int64, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(StoreWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &StoreORM{}
+		if err = odb.Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&StoreORM{}).(StoreORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*StoreORM{}
+	if len(keys) > 0 {
+		if err = db.Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[int64]*StoreORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *Store, updateMask *field_mask.FieldMask, row *StoreORM, db *gorm.DB) (*Store, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(StoreWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskStore(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*Store, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *Store, in *Store, updateMask *field_mask.FieldMask, db *gorm.DB) (*Store, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(StoreWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateStore(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(StoreWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*Store, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
+		results = append(results, pbResponse)
+	}
+	return results, nil
+}
+
+// StoreORMWithBeforePatchSetRead called before DefaultPatchSetStore reads the rows
+type StoreORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*Store, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
+// DefaultApplyFieldMaskStore patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskStore(ctx context.Context, patchee *Store, patcher *Store, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Store, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Revenue" {
+			patchee.Revenue = patcher.Revenue
+			continue
+		}
+		if f == prefix+"Rent" {
+			patchee.Rent = patcher.Rent
+			continue
+		}
+		if f == prefix+"Location" {
+			patchee.Location = patcher.Location
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// The columns of StoreORM
+const (
+	StoreORMColumnId       = "id"
+	StoreORMColumnLocation = "location"
+	StoreORMColumnRent     = "rent"
+	StoreORMColumnRevenue  = "revenue"
+)
+
+// StoreORMQueryBuilder builds a query of StoreORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type StoreORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// StoreORMQuery returns an empty query builder of StoreORM
+func StoreORMQuery() *StoreORMQueryBuilder {
+	return &StoreORMQueryBuilder{}
+}
+
+func (q *StoreORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *StoreORMQueryBuilder {
+	return &StoreORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *StoreORMQueryBuilder) where(query string, args ...interface{}) *StoreORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *StoreORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// IdEq adds the condition id = v
+func (q *StoreORMQueryBuilder) IdEq(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *StoreORMQueryBuilder) IdNe(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *StoreORMQueryBuilder) IdGt(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *StoreORMQueryBuilder) IdGe(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *StoreORMQueryBuilder) IdLt(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *StoreORMQueryBuilder) IdLe(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *StoreORMQueryBuilder) IdIn(vs ...int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnId+" IN (?)", vs)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *StoreORMQueryBuilder) OrderById(desc bool) *StoreORMQueryBuilder {
+	order := StoreORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// LocationEq adds the condition location = v
+func (q *StoreORMQueryBuilder) LocationEq(v string) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnLocation+" = ?", v)
+}
+
+// LocationNe adds the condition location <> v
+func (q *StoreORMQueryBuilder) LocationNe(v string) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnLocation+" <> ?", v)
+}
+
+// LocationGt adds the condition location > v
+func (q *StoreORMQueryBuilder) LocationGt(v string) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnLocation+" > ?", v)
+}
+
+// LocationGe adds the condition location >= v
+func (q *StoreORMQueryBuilder) LocationGe(v string) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnLocation+" >= ?", v)
+}
+
+// LocationLt adds the condition location < v
+func (q *StoreORMQueryBuilder) LocationLt(v string) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnLocation+" < ?", v)
+}
+
+// LocationLe adds the condition location <= v
+func (q *StoreORMQueryBuilder) LocationLe(v string) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnLocation+" <= ?", v)
+}
+
+// LocationIn adds the condition location IN (vs)
+func (q *StoreORMQueryBuilder) LocationIn(vs ...string) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnLocation+" IN (?)", vs)
+}
+
+// LocationLike adds the condition location LIKE pattern
+func (q *StoreORMQueryBuilder) LocationLike(pattern string) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnLocation+" LIKE ?", pattern)
+}
+
+// LocationIsNull adds the condition location IS NULL
+func (q *StoreORMQueryBuilder) LocationIsNull() *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnLocation + " IS NULL")
+}
+
+// LocationIsNotNull adds the condition location IS NOT NULL
+func (q *StoreORMQueryBuilder) LocationIsNotNull() *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnLocation + " IS NOT NULL")
+}
+
+// OrderByLocation adds the order by location, descending when desc
+func (q *StoreORMQueryBuilder) OrderByLocation(desc bool) *StoreORMQueryBuilder {
+	order := StoreORMColumnLocation
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// RentEq adds the condition rent = v
+func (q *StoreORMQueryBuilder) RentEq(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRent+" = ?", v)
+}
+
+// RentNe adds the condition rent <> v
+func (q *StoreORMQueryBuilder) RentNe(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRent+" <> ?", v)
+}
+
+// RentGt adds the condition rent > v
+func (q *StoreORMQueryBuilder) RentGt(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRent+" > ?", v)
+}
+
+// RentGe adds the condition rent >= v
+func (q *StoreORMQueryBuilder) RentGe(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRent+" >= ?", v)
+}
+
+// RentLt adds the condition rent < v
+func (q *StoreORMQueryBuilder) RentLt(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRent+" < ?", v)
+}
+
+// RentLe adds the condition rent <= v
+func (q *StoreORMQueryBuilder) RentLe(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRent+" <= ?", v)
+}
+
+// RentIn adds the condition rent IN (vs)
+func (q *StoreORMQueryBuilder) RentIn(vs ...int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRent+" IN (?)", vs)
+}
+
+// OrderByRent adds the order by rent, descending when desc
+func (q *StoreORMQueryBuilder) OrderByRent(desc bool) *StoreORMQueryBuilder {
+	order := StoreORMColumnRent
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// RevenueEq adds the condition revenue = v
+func (q *StoreORMQueryBuilder) RevenueEq(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRevenue+" = ?", v)
+}
+
+// RevenueNe adds the condition revenue <> v
+func (q *StoreORMQueryBuilder) RevenueNe(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRevenue+" <> ?", v)
+}
+
+// RevenueGt adds the condition revenue > v
+func (q *StoreORMQueryBuilder) RevenueGt(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRevenue+" > ?", v)
+}
+
+// RevenueGe adds the condition revenue >= v
+func (q *StoreORMQueryBuilder) RevenueGe(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRevenue+" >= ?", v)
+}
+
+// RevenueLt adds the condition revenue < v
+func (q *StoreORMQueryBuilder) RevenueLt(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRevenue+" < ?", v)
+}
+
+// RevenueLe adds the condition revenue <= v
+func (q *StoreORMQueryBuilder) RevenueLe(v int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRevenue+" <= ?", v)
+}
+
+// RevenueIn adds the condition revenue IN (vs)
+func (q *StoreORMQueryBuilder) RevenueIn(vs ...int64) *StoreORMQueryBuilder {
+	return q.where(StoreORMColumnRevenue+" IN (?)", vs)
+}
+
+// OrderByRevenue adds the order by revenue, descending when desc
+func (q *StoreORMQueryBuilder) OrderByRevenue(desc bool) *StoreORMQueryBuilder {
+	order := StoreORMColumnRevenue
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// DefaultListStore executes a gorm list call
+func DefaultListStore(ctx context.Context, db *gorm.DB) ([]*Store, error) {
+	in := Store{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StoreORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &StoreORM{}, &Store{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StoreORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []StoreORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(StoreORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Store{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type StoreORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StoreORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StoreORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]StoreORM) error
+}
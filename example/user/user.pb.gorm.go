@@ -2,6 +2,7 @@ package user
 
 import (
 	context "context"
+	sql "database/sql"
 	fmt "fmt"
 	errors "github.com/edhaight/protoc-gen-gorm/errors"
 	ptypes "github.com/golang/protobuf/ptypes"
@@ -10,6 +11,7 @@ import (
 	resource "github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
 	gorm "github.com/jinzhu/gorm"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	strings "strings"
 	time "time"
 )
@@ -842,6 +844,24 @@ type TaskWithAfterToPB interface {
 	AfterToPB(context.Context, *Task) error
 }
 
+// withUserTransaction runs fn in a new transaction of db, so that the
+// changes of UserORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withUserTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreateUser executes a basic gorm create call
 func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
 	if in == nil {
@@ -857,7 +877,7 @@ func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(UserORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -875,6 +895,71 @@ type UserORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateUserSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateUserSet(ctx context.Context, in []*User, batchSize int, db *gorm.DB) ([]*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*User
+	if ok, err := withUserTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateUserSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*UserORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&UserORM{}).(UserORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&UserORM{}).(UserORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*User, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// UserORMWithBeforeCreateSet called before DefaultCreateUserSet inserts the objects
+type UserORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*UserORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// UserORMWithAfterCreateSet called after DefaultCreateUserSet inserts the objects
+type UserORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*UserORM, *gorm.DB) error
+}
+
 // DefaultReadUser executes a basic gorm read call
 func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
 	if in == nil {
@@ -902,7 +987,7 @@ func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error)
 	}
 	ormResponse := UserORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormResponse).(UserORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
@@ -941,7 +1026,7 @@ func DefaultDeleteUser(ctx context.Context, in *User, db *gorm.DB) error {
 	}
 	err = db.Where(&ormObj).Delete(&UserORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(UserORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
@@ -956,22 +1041,42 @@ type UserORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+// DefaultDeleteUserSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
 func DefaultDeleteUserSet(ctx context.Context, in []*User, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withUserTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteUserSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*UserORM, len(in))
 	keys := []string{}
-	for _, obj := range in {
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == "" {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
 	if hook, ok := (interface{}(&UserORM{})).(UserORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
@@ -981,10 +1086,25 @@ func DefaultDeleteUserSet(ctx context.Context, in []*User, db *gorm.DB) error {
 	if err != nil {
 		return err
 	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&UserORM{}).Error
-	if err != nil {
+	before := []*UserORM{}
+	if err = db.Where("account_id = ? AND id IN (?)", acctId, keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[string]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
+	if err = db.Where("account_id = ? AND id IN (?)", acctId, keys).Delete(&UserORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
 	if hook, ok := (interface{}(&UserORM{})).(UserORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -1001,7 +1121,7 @@ type UserORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateUser clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUser")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -1013,7 +1133,7 @@ func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User,
 	}
 	db = db.Where(map[string]interface{}{"account_id": accountID})
 	lockedRow := &UserORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -1025,7 +1145,7 @@ func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User,
 	}
 	filterCreditCard.UserId = new(string)
 	*filterCreditCard.UserId = ormObj.Id
-	if err = db.Where(filterCreditCard).Delete(CreditCardORM{}).Error; err != nil {
+	if err = db.Where(filterCreditCard).Delete(&CreditCardORM{}).Error; err != nil {
 		return nil, err
 	}
 	filterEmails := EmailORM{}
@@ -1034,7 +1154,7 @@ func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User,
 	}
 	filterEmails.UserId = new(string)
 	*filterEmails.UserId = ormObj.Id
-	if err = db.Where(filterEmails).Delete(EmailORM{}).Error; err != nil {
+	if err = db.Where(filterEmails).Delete(&EmailORM{}).Error; err != nil {
 		return nil, err
 	}
 	if err = db.Model(&ormObj).Association("Friends").Replace(ormObj.Friends).Error; err != nil {
@@ -1050,7 +1170,7 @@ func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User,
 		return nil, errors.EmptyIdError
 	}
 	filterTasks.UserId = ormObj.Id
-	if err = db.Where(filterTasks).Delete(TaskORM{}).Error; err != nil {
+	if err = db.Where(filterTasks).Delete(&TaskORM{}).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateSave); ok {
@@ -1059,7 +1179,7 @@ func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User,
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(UserORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
@@ -1138,25 +1258,154 @@ type UserWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetUser executes a bulk gorm update call with patch behavior
+// DefaultPatchSetUser executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
 func DefaultPatchSetUser(ctx context.Context, objects []*User, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*User, error) {
 	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
 	}
-
-	results := make([]*User, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchUser(ctx, patcher, updateMasks[i], db)
+	var out []*User
+	if ok, err := withUserTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetUser(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
 		if err != nil {
 			return nil, err
 		}
-
+		return out, nil
+	}
+	var err error
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	errs := &errors.MultiError{}
+	ormObjs := make([]*UserORM, len(objects))
+	hookedRows := make([]*UserORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]string, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == "" {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(UserWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &UserORM{}
+		if err = odb.Where(map[string]interface{}{"account_id": accountID}).Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&UserORM{}).(UserORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*UserORM{}
+	if len(keys) > 0 {
+		if err = db.Where(map[string]interface{}{"account_id": accountID}).Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[string]*UserORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *User, updateMask *field_mask.FieldMask, row *UserORM, db *gorm.DB) (*User, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(UserWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskUser(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*User, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *User, in *User, updateMask *field_mask.FieldMask, db *gorm.DB) (*User, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(UserWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateUser(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(UserWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*User, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
 		results = append(results, pbResponse)
 	}
-
 	return results, nil
 }
 
+// UserORMWithBeforePatchSetRead called before DefaultPatchSetUser reads the rows
+type UserORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*User, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
 // DefaultApplyFieldMaskUser patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*User, error) {
 	if patcher == nil {
@@ -1165,6 +1414,9 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 		return nil, errors.NilArgumentError
 	}
 	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedBirthday bool
 	var updatedCreditCard bool
 	var updatedBillingAddress bool
 	var updatedShippingAddress bool
@@ -1173,15 +1425,78 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 			patchee.Id = patcher.Id
 			continue
 		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			updatedCreatedAt = true
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
 			patchee.CreatedAt = patcher.CreatedAt
 			continue
 		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			updatedUpdatedAt = true
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
 			patchee.UpdatedAt = patcher.UpdatedAt
 			continue
 		}
+		if !updatedBirthday && strings.HasPrefix(f, prefix+"Birthday.") {
+			updatedBirthday = true
+			if patcher.Birthday == nil {
+				patchee.Birthday = nil
+				continue
+			}
+			if patchee.Birthday == nil {
+				patchee.Birthday = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Birthday."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Birthday, patchee.Birthday, childMask); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		if f == prefix+"Birthday" {
+			updatedBirthday = true
 			patchee.Birthday = patcher.Birthday
 			continue
 		}
@@ -1287,409 +1602,619 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 	return patchee, nil
 }
 
-// DefaultListUser executes a gorm list call
-func DefaultListUser(ctx context.Context, db *gorm.DB) ([]*User, error) {
-	in := User{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &UserORM{}, &User{}, nil, nil, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []UserORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse := []*User{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
-			return nil, err
-		}
-		pbResponse = append(pbResponse, &temp)
-	}
-	return pbResponse, nil
+// The columns of UserORM
+const (
+	UserORMColumnAccountID         = "account_id"
+	UserORMColumnBillingAddressId  = "billing_address_id"
+	UserORMColumnBirthday          = "birthday"
+	UserORMColumnCreatedAt         = "created_at"
+	UserORMColumnExternalUuid      = "external_uuid"
+	UserORMColumnId                = "id"
+	UserORMColumnNum               = "num"
+	UserORMColumnShippingAddressId = "shipping_address_id"
+	UserORMColumnUpdatedAt         = "updated_at"
+)
+
+// UserORMQueryBuilder builds a query of UserORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type UserORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
 }
 
-type UserORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+// UserORMQuery returns an empty query builder of UserORM
+func UserORMQuery() *UserORMQueryBuilder {
+	return &UserORMQueryBuilder{}
 }
-type UserORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+
+func (q *UserORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *UserORMQueryBuilder {
+	return &UserORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
 }
-type UserORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]UserORM) error
+
+func (q *UserORMQueryBuilder) where(query string, args ...interface{}) *UserORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
 }
 
-// DefaultCreateEmail executes a basic gorm create call
-func DefaultCreateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
-		}
+// Apply returns db with the conditions, orders and preloads of q
+func (q *UserORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return db
 }
 
-type EmailORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+// AccountIDEq adds the condition account_id = v
+func (q *UserORMQueryBuilder) AccountIDEq(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnAccountID+" = ?", v)
 }
-type EmailORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// AccountIDNe adds the condition account_id <> v
+func (q *UserORMQueryBuilder) AccountIDNe(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnAccountID+" <> ?", v)
 }
 
-// DefaultReadEmail executes a basic gorm read call
-func DefaultReadEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
+// AccountIDGt adds the condition account_id > v
+func (q *UserORMQueryBuilder) AccountIDGt(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnAccountID+" > ?", v)
+}
+
+// AccountIDGe adds the condition account_id >= v
+func (q *UserORMQueryBuilder) AccountIDGe(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnAccountID+" >= ?", v)
+}
+
+// AccountIDLt adds the condition account_id < v
+func (q *UserORMQueryBuilder) AccountIDLt(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnAccountID+" < ?", v)
+}
+
+// AccountIDLe adds the condition account_id <= v
+func (q *UserORMQueryBuilder) AccountIDLe(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnAccountID+" <= ?", v)
+}
+
+// AccountIDIn adds the condition account_id IN (vs)
+func (q *UserORMQueryBuilder) AccountIDIn(vs ...string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnAccountID+" IN (?)", vs)
+}
+
+// AccountIDLike adds the condition account_id LIKE pattern
+func (q *UserORMQueryBuilder) AccountIDLike(pattern string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnAccountID+" LIKE ?", pattern)
+}
+
+// OrderByAccountID adds the order by account_id, descending when desc
+func (q *UserORMQueryBuilder) OrderByAccountID(desc bool) *UserORMQueryBuilder {
+	order := UserORMColumnAccountID
+	if desc {
+		order += " DESC"
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &EmailORM{}); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	ormResponse := EmailORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormResponse).(EmailORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
 }
 
-type EmailORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+// BillingAddressIdEq adds the condition billing_address_id = v
+func (q *UserORMQueryBuilder) BillingAddressIdEq(v int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBillingAddressId+" = ?", v)
 }
-type EmailORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+
+// BillingAddressIdNe adds the condition billing_address_id <> v
+func (q *UserORMQueryBuilder) BillingAddressIdNe(v int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBillingAddressId+" <> ?", v)
 }
-type EmailORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+
+// BillingAddressIdGt adds the condition billing_address_id > v
+func (q *UserORMQueryBuilder) BillingAddressIdGt(v int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBillingAddressId+" > ?", v)
 }
 
-func DefaultDeleteEmail(ctx context.Context, in *Email, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
-	}
-	if ormObj.Id == "" {
-		return errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
-		}
-	}
-	err = db.Where(&ormObj).Delete(&EmailORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
-	}
-	return err
+// BillingAddressIdGe adds the condition billing_address_id >= v
+func (q *UserORMQueryBuilder) BillingAddressIdGe(v int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBillingAddressId+" >= ?", v)
 }
 
-type EmailORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+// BillingAddressIdLt adds the condition billing_address_id < v
+func (q *UserORMQueryBuilder) BillingAddressIdLt(v int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBillingAddressId+" < ?", v)
 }
-type EmailORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+
+// BillingAddressIdLe adds the condition billing_address_id <= v
+func (q *UserORMQueryBuilder) BillingAddressIdLe(v int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBillingAddressId+" <= ?", v)
 }
 
-func DefaultDeleteEmailSet(ctx context.Context, in []*Email, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	var err error
-	keys := []string{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == "" {
-			return errors.EmptyIdError
-		}
-		keys = append(keys, ormObj.Id)
-	}
-	if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
-		}
-	}
-	acctId, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return err
-	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&EmailORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
-	}
-	return err
+// BillingAddressIdIn adds the condition billing_address_id IN (vs)
+func (q *UserORMQueryBuilder) BillingAddressIdIn(vs ...int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBillingAddressId+" IN (?)", vs)
 }
 
-type EmailORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Email, *gorm.DB) (*gorm.DB, error)
+// BillingAddressIdIsNull adds the condition billing_address_id IS NULL
+func (q *UserORMQueryBuilder) BillingAddressIdIsNull() *UserORMQueryBuilder {
+	return q.where(UserORMColumnBillingAddressId + " IS NULL")
 }
-type EmailORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Email, *gorm.DB) error
+
+// BillingAddressIdIsNotNull adds the condition billing_address_id IS NOT NULL
+func (q *UserORMQueryBuilder) BillingAddressIdIsNotNull() *UserORMQueryBuilder {
+	return q.where(UserORMColumnBillingAddressId + " IS NOT NULL")
 }
 
-// DefaultStrictUpdateEmail clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
-	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateEmail")
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	accountID, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	db = db.Where(map[string]interface{}{"account_id": accountID})
-	lockedRow := &EmailORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	if err != nil {
-		return nil, err
+// OrderByBillingAddressId adds the order by billing_address_id, descending when desc
+func (q *UserORMQueryBuilder) OrderByBillingAddressId(desc bool) *UserORMQueryBuilder {
+	order := UserORMColumnBillingAddressId
+	if desc {
+		order += " DESC"
 	}
-	return &pbResponse, err
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
 }
 
-type EmailORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+// BirthdayEq adds the condition birthday = v
+func (q *UserORMQueryBuilder) BirthdayEq(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBirthday+" = ?", v)
 }
-type EmailORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+
+// BirthdayNe adds the condition birthday <> v
+func (q *UserORMQueryBuilder) BirthdayNe(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBirthday+" <> ?", v)
 }
-type EmailORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+
+// BirthdayGt adds the condition birthday > v
+func (q *UserORMQueryBuilder) BirthdayGt(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBirthday+" > ?", v)
 }
 
-// DefaultPatchEmail executes a basic gorm update call with patch behavior
-func DefaultPatchEmail(ctx context.Context, in *Email, updateMask *field_mask.FieldMask, db *gorm.DB) (*Email, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	var pbObj Email
-	var err error
-	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	pbReadRes, err := DefaultReadEmail(ctx, &Email{Id: in.GetId()}, db)
-	if err != nil {
-		return nil, err
-	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	if _, err := DefaultApplyFieldMaskEmail(ctx, &pbObj, in, updateMask, "", db); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := DefaultStrictUpdateEmail(ctx, &pbObj, db)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(pbResponse).(EmailWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	return pbResponse, nil
+// BirthdayGe adds the condition birthday >= v
+func (q *UserORMQueryBuilder) BirthdayGe(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBirthday+" >= ?", v)
 }
 
-type EmailWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+// BirthdayLt adds the condition birthday < v
+func (q *UserORMQueryBuilder) BirthdayLt(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBirthday+" < ?", v)
 }
-type EmailWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+
+// BirthdayLe adds the condition birthday <= v
+func (q *UserORMQueryBuilder) BirthdayLe(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBirthday+" <= ?", v)
 }
-type EmailWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+
+// BirthdayIn adds the condition birthday IN (vs)
+func (q *UserORMQueryBuilder) BirthdayIn(vs ...time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnBirthday+" IN (?)", vs)
 }
-type EmailWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) error
+
+// BirthdayIsNull adds the condition birthday IS NULL
+func (q *UserORMQueryBuilder) BirthdayIsNull() *UserORMQueryBuilder {
+	return q.where(UserORMColumnBirthday + " IS NULL")
 }
 
-// DefaultPatchSetEmail executes a bulk gorm update call with patch behavior
-func DefaultPatchSetEmail(ctx context.Context, objects []*Email, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Email, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+// BirthdayIsNotNull adds the condition birthday IS NOT NULL
+func (q *UserORMQueryBuilder) BirthdayIsNotNull() *UserORMQueryBuilder {
+	return q.where(UserORMColumnBirthday + " IS NOT NULL")
+}
+
+// OrderByBirthday adds the order by birthday, descending when desc
+func (q *UserORMQueryBuilder) OrderByBirthday(desc bool) *UserORMQueryBuilder {
+	order := UserORMColumnBirthday
+	if desc {
+		order += " DESC"
 	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
 
-	results := make([]*Email, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchEmail(ctx, patcher, updateMasks[i], db)
-		if err != nil {
-			return nil, err
-		}
+// CreatedAtEq adds the condition created_at = v
+func (q *UserORMQueryBuilder) CreatedAtEq(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnCreatedAt+" = ?", v)
+}
 
-		results = append(results, pbResponse)
-	}
+// CreatedAtNe adds the condition created_at <> v
+func (q *UserORMQueryBuilder) CreatedAtNe(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnCreatedAt+" <> ?", v)
+}
 
-	return results, nil
+// CreatedAtGt adds the condition created_at > v
+func (q *UserORMQueryBuilder) CreatedAtGt(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnCreatedAt+" > ?", v)
 }
 
-// DefaultApplyFieldMaskEmail patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskEmail(ctx context.Context, patchee *Email, patcher *Email, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Email, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
-	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"Email" {
-			patchee.Email = patcher.Email
-			continue
-		}
-		if f == prefix+"Subscribed" {
-			patchee.Subscribed = patcher.Subscribed
-			continue
-		}
-		if f == prefix+"UserId" {
-			patchee.UserId = patcher.UserId
-			continue
-		}
-		if f == prefix+"ExternalNotNull" {
-			patchee.ExternalNotNull = patcher.ExternalNotNull
-			continue
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return patchee, nil
+// CreatedAtGe adds the condition created_at >= v
+func (q *UserORMQueryBuilder) CreatedAtGe(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnCreatedAt+" >= ?", v)
 }
 
-// DefaultListEmail executes a gorm list call
-func DefaultListEmail(ctx context.Context, db *gorm.DB) ([]*Email, error) {
-	in := Email{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+// CreatedAtLt adds the condition created_at < v
+func (q *UserORMQueryBuilder) CreatedAtLt(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnCreatedAt+" < ?", v)
+}
+
+// CreatedAtLe adds the condition created_at <= v
+func (q *UserORMQueryBuilder) CreatedAtLe(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnCreatedAt+" <= ?", v)
+}
+
+// CreatedAtIn adds the condition created_at IN (vs)
+func (q *UserORMQueryBuilder) CreatedAtIn(vs ...time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnCreatedAt+" IN (?)", vs)
+}
+
+// CreatedAtIsNull adds the condition created_at IS NULL
+func (q *UserORMQueryBuilder) CreatedAtIsNull() *UserORMQueryBuilder {
+	return q.where(UserORMColumnCreatedAt + " IS NULL")
+}
+
+// CreatedAtIsNotNull adds the condition created_at IS NOT NULL
+func (q *UserORMQueryBuilder) CreatedAtIsNotNull() *UserORMQueryBuilder {
+	return q.where(UserORMColumnCreatedAt + " IS NOT NULL")
+}
+
+// OrderByCreatedAt adds the order by created_at, descending when desc
+func (q *UserORMQueryBuilder) OrderByCreatedAt(desc bool) *UserORMQueryBuilder {
+	order := UserORMColumnCreatedAt
+	if desc {
+		order += " DESC"
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// ExternalUuidEq adds the condition external_uuid = v
+func (q *UserORMQueryBuilder) ExternalUuidEq(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnExternalUuid+" = ?", v)
+}
+
+// ExternalUuidNe adds the condition external_uuid <> v
+func (q *UserORMQueryBuilder) ExternalUuidNe(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnExternalUuid+" <> ?", v)
+}
+
+// ExternalUuidGt adds the condition external_uuid > v
+func (q *UserORMQueryBuilder) ExternalUuidGt(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnExternalUuid+" > ?", v)
+}
+
+// ExternalUuidGe adds the condition external_uuid >= v
+func (q *UserORMQueryBuilder) ExternalUuidGe(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnExternalUuid+" >= ?", v)
+}
+
+// ExternalUuidLt adds the condition external_uuid < v
+func (q *UserORMQueryBuilder) ExternalUuidLt(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnExternalUuid+" < ?", v)
+}
+
+// ExternalUuidLe adds the condition external_uuid <= v
+func (q *UserORMQueryBuilder) ExternalUuidLe(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnExternalUuid+" <= ?", v)
+}
+
+// ExternalUuidIn adds the condition external_uuid IN (vs)
+func (q *UserORMQueryBuilder) ExternalUuidIn(vs ...string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnExternalUuid+" IN (?)", vs)
+}
+
+// ExternalUuidLike adds the condition external_uuid LIKE pattern
+func (q *UserORMQueryBuilder) ExternalUuidLike(pattern string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnExternalUuid+" LIKE ?", pattern)
+}
+
+// ExternalUuidIsNull adds the condition external_uuid IS NULL
+func (q *UserORMQueryBuilder) ExternalUuidIsNull() *UserORMQueryBuilder {
+	return q.where(UserORMColumnExternalUuid + " IS NULL")
+}
+
+// ExternalUuidIsNotNull adds the condition external_uuid IS NOT NULL
+func (q *UserORMQueryBuilder) ExternalUuidIsNotNull() *UserORMQueryBuilder {
+	return q.where(UserORMColumnExternalUuid + " IS NOT NULL")
+}
+
+// OrderByExternalUuid adds the order by external_uuid, descending when desc
+func (q *UserORMQueryBuilder) OrderByExternalUuid(desc bool) *UserORMQueryBuilder {
+	order := UserORMColumnExternalUuid
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// IdEq adds the condition id = v
+func (q *UserORMQueryBuilder) IdEq(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *UserORMQueryBuilder) IdNe(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *UserORMQueryBuilder) IdGt(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *UserORMQueryBuilder) IdGe(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *UserORMQueryBuilder) IdLt(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *UserORMQueryBuilder) IdLe(v string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *UserORMQueryBuilder) IdIn(vs ...string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnId+" IN (?)", vs)
+}
+
+// IdLike adds the condition id LIKE pattern
+func (q *UserORMQueryBuilder) IdLike(pattern string) *UserORMQueryBuilder {
+	return q.where(UserORMColumnId+" LIKE ?", pattern)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *UserORMQueryBuilder) OrderById(desc bool) *UserORMQueryBuilder {
+	order := UserORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// NumEq adds the condition num = v
+func (q *UserORMQueryBuilder) NumEq(v uint32) *UserORMQueryBuilder {
+	return q.where(UserORMColumnNum+" = ?", v)
+}
+
+// NumNe adds the condition num <> v
+func (q *UserORMQueryBuilder) NumNe(v uint32) *UserORMQueryBuilder {
+	return q.where(UserORMColumnNum+" <> ?", v)
+}
+
+// NumGt adds the condition num > v
+func (q *UserORMQueryBuilder) NumGt(v uint32) *UserORMQueryBuilder {
+	return q.where(UserORMColumnNum+" > ?", v)
+}
+
+// NumGe adds the condition num >= v
+func (q *UserORMQueryBuilder) NumGe(v uint32) *UserORMQueryBuilder {
+	return q.where(UserORMColumnNum+" >= ?", v)
+}
+
+// NumLt adds the condition num < v
+func (q *UserORMQueryBuilder) NumLt(v uint32) *UserORMQueryBuilder {
+	return q.where(UserORMColumnNum+" < ?", v)
+}
+
+// NumLe adds the condition num <= v
+func (q *UserORMQueryBuilder) NumLe(v uint32) *UserORMQueryBuilder {
+	return q.where(UserORMColumnNum+" <= ?", v)
+}
+
+// NumIn adds the condition num IN (vs)
+func (q *UserORMQueryBuilder) NumIn(vs ...uint32) *UserORMQueryBuilder {
+	return q.where(UserORMColumnNum+" IN (?)", vs)
+}
+
+// OrderByNum adds the order by num, descending when desc
+func (q *UserORMQueryBuilder) OrderByNum(desc bool) *UserORMQueryBuilder {
+	order := UserORMColumnNum
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// ShippingAddressIdEq adds the condition shipping_address_id = v
+func (q *UserORMQueryBuilder) ShippingAddressIdEq(v int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnShippingAddressId+" = ?", v)
+}
+
+// ShippingAddressIdNe adds the condition shipping_address_id <> v
+func (q *UserORMQueryBuilder) ShippingAddressIdNe(v int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnShippingAddressId+" <> ?", v)
+}
+
+// ShippingAddressIdGt adds the condition shipping_address_id > v
+func (q *UserORMQueryBuilder) ShippingAddressIdGt(v int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnShippingAddressId+" > ?", v)
+}
+
+// ShippingAddressIdGe adds the condition shipping_address_id >= v
+func (q *UserORMQueryBuilder) ShippingAddressIdGe(v int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnShippingAddressId+" >= ?", v)
+}
+
+// ShippingAddressIdLt adds the condition shipping_address_id < v
+func (q *UserORMQueryBuilder) ShippingAddressIdLt(v int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnShippingAddressId+" < ?", v)
+}
+
+// ShippingAddressIdLe adds the condition shipping_address_id <= v
+func (q *UserORMQueryBuilder) ShippingAddressIdLe(v int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnShippingAddressId+" <= ?", v)
+}
+
+// ShippingAddressIdIn adds the condition shipping_address_id IN (vs)
+func (q *UserORMQueryBuilder) ShippingAddressIdIn(vs ...int64) *UserORMQueryBuilder {
+	return q.where(UserORMColumnShippingAddressId+" IN (?)", vs)
+}
+
+// ShippingAddressIdIsNull adds the condition shipping_address_id IS NULL
+func (q *UserORMQueryBuilder) ShippingAddressIdIsNull() *UserORMQueryBuilder {
+	return q.where(UserORMColumnShippingAddressId + " IS NULL")
+}
+
+// ShippingAddressIdIsNotNull adds the condition shipping_address_id IS NOT NULL
+func (q *UserORMQueryBuilder) ShippingAddressIdIsNotNull() *UserORMQueryBuilder {
+	return q.where(UserORMColumnShippingAddressId + " IS NOT NULL")
+}
+
+// OrderByShippingAddressId adds the order by shipping_address_id, descending when desc
+func (q *UserORMQueryBuilder) OrderByShippingAddressId(desc bool) *UserORMQueryBuilder {
+	order := UserORMColumnShippingAddressId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// UpdatedAtEq adds the condition updated_at = v
+func (q *UserORMQueryBuilder) UpdatedAtEq(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnUpdatedAt+" = ?", v)
+}
+
+// UpdatedAtNe adds the condition updated_at <> v
+func (q *UserORMQueryBuilder) UpdatedAtNe(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnUpdatedAt+" <> ?", v)
+}
+
+// UpdatedAtGt adds the condition updated_at > v
+func (q *UserORMQueryBuilder) UpdatedAtGt(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnUpdatedAt+" > ?", v)
+}
+
+// UpdatedAtGe adds the condition updated_at >= v
+func (q *UserORMQueryBuilder) UpdatedAtGe(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnUpdatedAt+" >= ?", v)
+}
+
+// UpdatedAtLt adds the condition updated_at < v
+func (q *UserORMQueryBuilder) UpdatedAtLt(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnUpdatedAt+" < ?", v)
+}
+
+// UpdatedAtLe adds the condition updated_at <= v
+func (q *UserORMQueryBuilder) UpdatedAtLe(v time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnUpdatedAt+" <= ?", v)
+}
+
+// UpdatedAtIn adds the condition updated_at IN (vs)
+func (q *UserORMQueryBuilder) UpdatedAtIn(vs ...time.Time) *UserORMQueryBuilder {
+	return q.where(UserORMColumnUpdatedAt+" IN (?)", vs)
+}
+
+// UpdatedAtIsNull adds the condition updated_at IS NULL
+func (q *UserORMQueryBuilder) UpdatedAtIsNull() *UserORMQueryBuilder {
+	return q.where(UserORMColumnUpdatedAt + " IS NULL")
+}
+
+// UpdatedAtIsNotNull adds the condition updated_at IS NOT NULL
+func (q *UserORMQueryBuilder) UpdatedAtIsNotNull() *UserORMQueryBuilder {
+	return q.where(UserORMColumnUpdatedAt + " IS NOT NULL")
+}
+
+// OrderByUpdatedAt adds the order by updated_at, descending when desc
+func (q *UserORMQueryBuilder) OrderByUpdatedAt(desc bool) *UserORMQueryBuilder {
+	order := UserORMColumnUpdatedAt
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PreloadBillingAddress adds the preload of the BillingAddress association
+func (q *UserORMQueryBuilder) PreloadBillingAddress() *UserORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("BillingAddress")
+	})
+}
+
+// PreloadCreditCard adds the preload of the CreditCard association
+func (q *UserORMQueryBuilder) PreloadCreditCard() *UserORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("CreditCard")
+	})
+}
+
+// PreloadEmails adds the preload of the Emails association
+func (q *UserORMQueryBuilder) PreloadEmails() *UserORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Emails")
+	})
+}
+
+// PreloadFriends adds the preload of the Friends association
+func (q *UserORMQueryBuilder) PreloadFriends() *UserORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Friends")
+	})
+}
+
+// PreloadLanguages adds the preload of the Languages association
+func (q *UserORMQueryBuilder) PreloadLanguages() *UserORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Languages")
+	})
+}
+
+// PreloadShippingAddress adds the preload of the ShippingAddress association
+func (q *UserORMQueryBuilder) PreloadShippingAddress() *UserORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("ShippingAddress")
+	})
+}
+
+// PreloadTasks adds the preload of the Tasks association
+func (q *UserORMQueryBuilder) PreloadTasks() *UserORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Tasks")
+	})
+}
+
+// DefaultListUser executes a gorm list call
+func DefaultListUser(ctx context.Context, db *gorm.DB) ([]*User, error) {
+	in := User{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &EmailORM{}, &Email{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &UserORM{}, &User{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []EmailORM{}
+	ormResponse := []UserORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Email{}
+	pbResponse := []*User{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -1700,18 +2225,36 @@ func DefaultListEmail(ctx context.Context, db *gorm.DB) ([]*Email, error) {
 	return pbResponse, nil
 }
 
-type EmailORMWithBeforeListApplyQuery interface {
+type UserORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EmailORMWithBeforeListFind interface {
+type UserORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EmailORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]EmailORM) error
+type UserORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]UserORM) error
 }
 
-// DefaultCreateAddress executes a basic gorm create call
-func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+// withEmailTransaction runs fn in a new transaction of db, so that the
+// changes of EmailORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withEmailTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
+// DefaultCreateEmail executes a basic gorm create call
+func DefaultCreateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1719,15 +2262,15 @@ func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Addre
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1736,15 +2279,80 @@ func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Addre
 	return &pbResponse, err
 }
 
-type AddressORMWithBeforeCreate_ interface {
+type EmailORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithAfterCreate_ interface {
+type EmailORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-// DefaultReadAddress executes a basic gorm read call
-func DefaultReadAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+// DefaultCreateEmailSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateEmailSet(ctx context.Context, in []*Email, batchSize int, db *gorm.DB) ([]*Email, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*Email
+	if ok, err := withEmailTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateEmailSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*EmailORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&EmailORM{}).(EmailORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&EmailORM{}).(EmailORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*Email, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// EmailORMWithBeforeCreateSet called before DefaultCreateEmailSet inserts the objects
+type EmailORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*EmailORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// EmailORMWithAfterCreateSet called after DefaultCreateEmailSet inserts the objects
+type EmailORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*EmailORM, *gorm.DB) error
+}
+
+// DefaultReadEmail executes a basic gorm read call
+func DefaultReadEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1752,27 +2360,27 @@ func DefaultReadAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &AddressORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &EmailORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := AddressORM{}
+	ormResponse := EmailORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
-	if hook, ok := interface{}(&ormResponse).(AddressORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(EmailORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1781,17 +2389,17 @@ func DefaultReadAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address
 	return &pbResponse, err
 }
 
-type AddressORMWithBeforeReadApplyQuery interface {
+type EmailORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithBeforeReadFind interface {
+type EmailORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithAfterReadFind interface {
+type EmailORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteAddress(ctx context.Context, in *Address, db *gorm.DB) error {
+func DefaultDeleteEmail(ctx context.Context, in *Email, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -1799,48 +2407,68 @@ func DefaultDeleteAddress(ctx context.Context, in *Address, db *gorm.DB) error {
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&AddressORM{}).Error
+	err = db.Where(&ormObj).Delete(&EmailORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type AddressORMWithBeforeDelete_ interface {
+type EmailORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithAfterDelete_ interface {
+type EmailORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteAddressSet(ctx context.Context, in []*Address, db *gorm.DB) error {
+// DefaultDeleteEmailSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
+func DefaultDeleteEmailSet(ctx context.Context, in []*Email, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withEmailTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteEmailSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
-	keys := []int64{}
-	for _, obj := range in {
+	errs := &errors.MultiError{}
+	ormObjs := make([]*EmailORM, len(in))
+	keys := []string{}
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
-		if ormObj.Id == 0 {
-			return errors.EmptyIdError
+		if ormObj.Id == "" {
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithBeforeDeleteSet); ok {
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
@@ -1849,27 +2477,42 @@ func DefaultDeleteAddressSet(ctx context.Context, in []*Address, db *gorm.DB) er
 	if err != nil {
 		return err
 	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&AddressORM{}).Error
-	if err != nil {
+	before := []*EmailORM{}
+	if err = db.Where("account_id = ? AND id IN (?)", acctId, keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[string]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithAfterDeleteSet); ok {
+	if err = db.Where("account_id = ? AND id IN (?)", acctId, keys).Delete(&EmailORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type AddressORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Address, *gorm.DB) (*gorm.DB, error)
+type EmailORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Email, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Address, *gorm.DB) error
+type EmailORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Email, *gorm.DB) error
 }
 
-// DefaultStrictUpdateAddress clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+// DefaultStrictUpdateEmail clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateAddress")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -1880,22 +2523,22 @@ func DefaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (
 		return nil, err
 	}
 	db = db.Where(map[string]interface{}{"account_id": accountID})
-	lockedRow := &AddressORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateCleanup); ok {
+	lockedRow := &EmailORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1907,51 +2550,51 @@ func DefaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (
 	return &pbResponse, err
 }
 
-type AddressORMWithBeforeStrictUpdateCleanup interface {
+type EmailORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithBeforeStrictUpdateSave interface {
+type EmailORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithAfterStrictUpdateSave interface {
+type EmailORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchAddress executes a basic gorm update call with patch behavior
-func DefaultPatchAddress(ctx context.Context, in *Address, updateMask *field_mask.FieldMask, db *gorm.DB) (*Address, error) {
+// DefaultPatchEmail executes a basic gorm update call with patch behavior
+func DefaultPatchEmail(ctx context.Context, in *Email, updateMask *field_mask.FieldMask, db *gorm.DB) (*Email, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Address
+	var pbObj Email
 	var err error
-	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadAddress(ctx, &Address{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadEmail(ctx, &Email{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskAddress(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskEmail(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateAddress(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateEmail(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(AddressWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(EmailWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -1959,532 +2602,704 @@ func DefaultPatchAddress(ctx context.Context, in *Address, updateMask *field_mas
 	return pbResponse, nil
 }
 
-type AddressWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type EmailWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type AddressWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type EmailWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type AddressWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type EmailWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type AddressWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) error
+type EmailWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetAddress executes a bulk gorm update call with patch behavior
-func DefaultPatchSetAddress(ctx context.Context, objects []*Address, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Address, error) {
+// DefaultPatchSetEmail executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
+func DefaultPatchSetEmail(ctx context.Context, objects []*Email, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Email, error) {
 	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
 	}
-
-	results := make([]*Address, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchAddress(ctx, patcher, updateMasks[i], db)
+	var out []*Email
+	if ok, err := withEmailTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetEmail(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
-	}
-
-	return results, nil
-}
-
-// DefaultApplyFieldMaskAddress patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher *Address, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Address, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
+		return out, nil
 	}
 	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	errs := &errors.MultiError{}
+	ormObjs := make([]*EmailORM, len(objects))
+	hookedRows := make([]*EmailORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]string, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
 			continue
 		}
-		if f == prefix+"Address_1" {
-			patchee.Address_1 = patcher.Address_1
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
 			continue
 		}
-		if f == prefix+"Address_2" {
-			patchee.Address_2 = patcher.Address_2
+		if ormObj.Id == "" {
+			errs.Add(i, errors.EmptyIdError)
 			continue
 		}
-		if f == prefix+"Post" {
-			patchee.Post = patcher.Post
+		hook, ok := interface{}(in).(EmailWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
 			continue
 		}
-		if f == prefix+"External" {
-			patchee.External = patcher.External
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
 			continue
 		}
-		if f == prefix+"ImplicitFk" {
-			patchee.ImplicitFk = patcher.ImplicitFk
+		row := &EmailORM{}
+		if err = odb.Where(map[string]interface{}{"account_id": accountID}).Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
 			continue
 		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
 	}
-	if err != nil {
-		return nil, err
-	}
-	return patchee, nil
-}
-
-// DefaultListAddress executes a gorm list call
-func DefaultListAddress(ctx context.Context, db *gorm.DB) ([]*Address, error) {
-	in := Address{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+	if hook, ok := interface{}(&EmailORM{}).(EmailORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &AddressORM{}, &Address{}, nil, nil, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
+	rows := []*EmailORM{}
+	if len(keys) > 0 {
+		if err = db.Where(map[string]interface{}{"account_id": accountID}).Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 		}
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []AddressORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+	stored := make(map[string]*EmailORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	patch := func(in *Email, updateMask *field_mask.FieldMask, row *EmailORM, db *gorm.DB) (*Email, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
 			return nil, err
 		}
-	}
-	pbResponse := []*Address{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
+		if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskEmail(ctx, &pbObj, in, updateMask, "", db); err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		return &pbObj, nil
 	}
-	return pbResponse, nil
-}
-
-type AddressORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type AddressORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type AddressORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]AddressORM) error
-}
-
-// DefaultCreateLanguage executes a basic gorm create call
-func DefaultCreateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
+	patched := make([]*Email, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
+	if err = errs.ErrOrNil(); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+	save := func(pbObj *Email, in *Email, updateMask *field_mask.FieldMask, db *gorm.DB) (*Email, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(EmailWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateEmail(ctx, pbObj, db)
+		if err != nil {
 			return nil, err
 		}
+		if hook, ok := interface{}(pbResponse).(EmailWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	results := make([]*Email, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
 		}
+		results = append(results, pbResponse)
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return results, nil
 }
 
-type LanguageORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type LanguageORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+// EmailORMWithBeforePatchSetRead called before DefaultPatchSetEmail reads the rows
+type EmailORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*Email, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
 
-// DefaultReadLanguage executes a basic gorm read call
-func DefaultReadLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
-	if in == nil {
+// DefaultApplyFieldMaskEmail patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskEmail(ctx context.Context, patchee *Email, patcher *Email, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Email, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
-			return nil, err
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
 		}
-	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &LanguageORM{}); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
-			return nil, err
+		if f == prefix+"Email" {
+			patchee.Email = patcher.Email
+			continue
+		}
+		if f == prefix+"Subscribed" {
+			patchee.Subscribed = patcher.Subscribed
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+		if f == prefix+"ExternalNotNull" {
+			patchee.ExternalNotNull = patcher.ExternalNotNull
+			continue
 		}
 	}
-	ormResponse := LanguageORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(LanguageORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
+	return patchee, nil
 }
 
-type LanguageORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+// The columns of EmailORM
+const (
+	EmailORMColumnAccountID       = "account_id"
+	EmailORMColumnEmail           = "email"
+	EmailORMColumnExternalNotNull = "external_not_null"
+	EmailORMColumnId              = "id"
+	EmailORMColumnSubscribed      = "subscribed"
+	EmailORMColumnUserId          = "user_id"
+)
+
+// EmailORMQueryBuilder builds a query of EmailORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type EmailORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
 }
-type LanguageORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+
+// EmailORMQuery returns an empty query builder of EmailORM
+func EmailORMQuery() *EmailORMQueryBuilder {
+	return &EmailORMQueryBuilder{}
 }
-type LanguageORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+
+func (q *EmailORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *EmailORMQueryBuilder {
+	return &EmailORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
 }
 
-func DefaultDeleteLanguage(ctx context.Context, in *Language, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
-	}
-	if ormObj.Id == 0 {
-		return errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
-		}
-	}
-	err = db.Where(&ormObj).Delete(&LanguageORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
+func (q *EmailORMQueryBuilder) where(query string, args ...interface{}) *EmailORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *EmailORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
 	}
-	return err
+	return db
 }
 
-type LanguageORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+// AccountIDEq adds the condition account_id = v
+func (q *EmailORMQueryBuilder) AccountIDEq(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnAccountID+" = ?", v)
 }
-type LanguageORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+
+// AccountIDNe adds the condition account_id <> v
+func (q *EmailORMQueryBuilder) AccountIDNe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnAccountID+" <> ?", v)
 }
 
-func DefaultDeleteLanguageSet(ctx context.Context, in []*Language, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	var err error
-	keys := []int64{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == 0 {
-			return errors.EmptyIdError
-		}
-		keys = append(keys, ormObj.Id)
+// AccountIDGt adds the condition account_id > v
+func (q *EmailORMQueryBuilder) AccountIDGt(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnAccountID+" > ?", v)
+}
+
+// AccountIDGe adds the condition account_id >= v
+func (q *EmailORMQueryBuilder) AccountIDGe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnAccountID+" >= ?", v)
+}
+
+// AccountIDLt adds the condition account_id < v
+func (q *EmailORMQueryBuilder) AccountIDLt(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnAccountID+" < ?", v)
+}
+
+// AccountIDLe adds the condition account_id <= v
+func (q *EmailORMQueryBuilder) AccountIDLe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnAccountID+" <= ?", v)
+}
+
+// AccountIDIn adds the condition account_id IN (vs)
+func (q *EmailORMQueryBuilder) AccountIDIn(vs ...string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnAccountID+" IN (?)", vs)
+}
+
+// AccountIDLike adds the condition account_id LIKE pattern
+func (q *EmailORMQueryBuilder) AccountIDLike(pattern string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnAccountID+" LIKE ?", pattern)
+}
+
+// OrderByAccountID adds the order by account_id, descending when desc
+func (q *EmailORMQueryBuilder) OrderByAccountID(desc bool) *EmailORMQueryBuilder {
+	order := EmailORMColumnAccountID
+	if desc {
+		order += " DESC"
 	}
-	if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
-		}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// EmailEq adds the condition email = v
+func (q *EmailORMQueryBuilder) EmailEq(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnEmail+" = ?", v)
+}
+
+// EmailNe adds the condition email <> v
+func (q *EmailORMQueryBuilder) EmailNe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnEmail+" <> ?", v)
+}
+
+// EmailGt adds the condition email > v
+func (q *EmailORMQueryBuilder) EmailGt(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnEmail+" > ?", v)
+}
+
+// EmailGe adds the condition email >= v
+func (q *EmailORMQueryBuilder) EmailGe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnEmail+" >= ?", v)
+}
+
+// EmailLt adds the condition email < v
+func (q *EmailORMQueryBuilder) EmailLt(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnEmail+" < ?", v)
+}
+
+// EmailLe adds the condition email <= v
+func (q *EmailORMQueryBuilder) EmailLe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnEmail+" <= ?", v)
+}
+
+// EmailIn adds the condition email IN (vs)
+func (q *EmailORMQueryBuilder) EmailIn(vs ...string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnEmail+" IN (?)", vs)
+}
+
+// EmailLike adds the condition email LIKE pattern
+func (q *EmailORMQueryBuilder) EmailLike(pattern string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnEmail+" LIKE ?", pattern)
+}
+
+// OrderByEmail adds the order by email, descending when desc
+func (q *EmailORMQueryBuilder) OrderByEmail(desc bool) *EmailORMQueryBuilder {
+	order := EmailORMColumnEmail
+	if desc {
+		order += " DESC"
 	}
-	acctId, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return err
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// ExternalNotNullEq adds the condition external_not_null = v
+func (q *EmailORMQueryBuilder) ExternalNotNullEq(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnExternalNotNull+" = ?", v)
+}
+
+// ExternalNotNullNe adds the condition external_not_null <> v
+func (q *EmailORMQueryBuilder) ExternalNotNullNe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnExternalNotNull+" <> ?", v)
+}
+
+// ExternalNotNullGt adds the condition external_not_null > v
+func (q *EmailORMQueryBuilder) ExternalNotNullGt(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnExternalNotNull+" > ?", v)
+}
+
+// ExternalNotNullGe adds the condition external_not_null >= v
+func (q *EmailORMQueryBuilder) ExternalNotNullGe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnExternalNotNull+" >= ?", v)
+}
+
+// ExternalNotNullLt adds the condition external_not_null < v
+func (q *EmailORMQueryBuilder) ExternalNotNullLt(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnExternalNotNull+" < ?", v)
+}
+
+// ExternalNotNullLe adds the condition external_not_null <= v
+func (q *EmailORMQueryBuilder) ExternalNotNullLe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnExternalNotNull+" <= ?", v)
+}
+
+// ExternalNotNullIn adds the condition external_not_null IN (vs)
+func (q *EmailORMQueryBuilder) ExternalNotNullIn(vs ...string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnExternalNotNull+" IN (?)", vs)
+}
+
+// ExternalNotNullLike adds the condition external_not_null LIKE pattern
+func (q *EmailORMQueryBuilder) ExternalNotNullLike(pattern string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnExternalNotNull+" LIKE ?", pattern)
+}
+
+// OrderByExternalNotNull adds the order by external_not_null, descending when desc
+func (q *EmailORMQueryBuilder) OrderByExternalNotNull(desc bool) *EmailORMQueryBuilder {
+	order := EmailORMColumnExternalNotNull
+	if desc {
+		order += " DESC"
 	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&LanguageORM{}).Error
-	if err != nil {
-		return err
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// IdEq adds the condition id = v
+func (q *EmailORMQueryBuilder) IdEq(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *EmailORMQueryBuilder) IdNe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *EmailORMQueryBuilder) IdGt(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *EmailORMQueryBuilder) IdGe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *EmailORMQueryBuilder) IdLt(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *EmailORMQueryBuilder) IdLe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *EmailORMQueryBuilder) IdIn(vs ...string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnId+" IN (?)", vs)
+}
+
+// IdLike adds the condition id LIKE pattern
+func (q *EmailORMQueryBuilder) IdLike(pattern string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnId+" LIKE ?", pattern)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *EmailORMQueryBuilder) OrderById(desc bool) *EmailORMQueryBuilder {
+	order := EmailORMColumnId
+	if desc {
+		order += " DESC"
 	}
-	if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// SubscribedEq adds the condition subscribed = v
+func (q *EmailORMQueryBuilder) SubscribedEq(v bool) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnSubscribed+" = ?", v)
+}
+
+// SubscribedNe adds the condition subscribed <> v
+func (q *EmailORMQueryBuilder) SubscribedNe(v bool) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnSubscribed+" <> ?", v)
+}
+
+// SubscribedIn adds the condition subscribed IN (vs)
+func (q *EmailORMQueryBuilder) SubscribedIn(vs ...bool) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnSubscribed+" IN (?)", vs)
+}
+
+// OrderBySubscribed adds the order by subscribed, descending when desc
+func (q *EmailORMQueryBuilder) OrderBySubscribed(desc bool) *EmailORMQueryBuilder {
+	order := EmailORMColumnSubscribed
+	if desc {
+		order += " DESC"
 	}
-	return err
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
 }
 
-type LanguageORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Language, *gorm.DB) (*gorm.DB, error)
+// UserIdEq adds the condition user_id = v
+func (q *EmailORMQueryBuilder) UserIdEq(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnUserId+" = ?", v)
 }
-type LanguageORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Language, *gorm.DB) error
+
+// UserIdNe adds the condition user_id <> v
+func (q *EmailORMQueryBuilder) UserIdNe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnUserId+" <> ?", v)
 }
 
-// DefaultStrictUpdateLanguage clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
-	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateLanguage")
+// UserIdGt adds the condition user_id > v
+func (q *EmailORMQueryBuilder) UserIdGt(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnUserId+" > ?", v)
+}
+
+// UserIdGe adds the condition user_id >= v
+func (q *EmailORMQueryBuilder) UserIdGe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnUserId+" >= ?", v)
+}
+
+// UserIdLt adds the condition user_id < v
+func (q *EmailORMQueryBuilder) UserIdLt(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnUserId+" < ?", v)
+}
+
+// UserIdLe adds the condition user_id <= v
+func (q *EmailORMQueryBuilder) UserIdLe(v string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnUserId+" <= ?", v)
+}
+
+// UserIdIn adds the condition user_id IN (vs)
+func (q *EmailORMQueryBuilder) UserIdIn(vs ...string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnUserId+" IN (?)", vs)
+}
+
+// UserIdLike adds the condition user_id LIKE pattern
+func (q *EmailORMQueryBuilder) UserIdLike(pattern string) *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnUserId+" LIKE ?", pattern)
+}
+
+// UserIdIsNull adds the condition user_id IS NULL
+func (q *EmailORMQueryBuilder) UserIdIsNull() *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnUserId + " IS NULL")
+}
+
+// UserIdIsNotNull adds the condition user_id IS NOT NULL
+func (q *EmailORMQueryBuilder) UserIdIsNotNull() *EmailORMQueryBuilder {
+	return q.where(EmailORMColumnUserId + " IS NOT NULL")
+}
+
+// OrderByUserId adds the order by user_id, descending when desc
+func (q *EmailORMQueryBuilder) OrderByUserId(desc bool) *EmailORMQueryBuilder {
+	order := EmailORMColumnUserId
+	if desc {
+		order += " DESC"
 	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// DefaultListEmail executes a gorm list call
+func DefaultListEmail(ctx context.Context, db *gorm.DB) ([]*Email, error) {
+	in := Email{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &EmailORM{}, &Email{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	db = db.Where(map[string]interface{}{"account_id": accountID})
-	lockedRow := &LanguageORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []EmailORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+	pbResponse := []*Email{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
 			return nil, err
 		}
+		pbResponse = append(pbResponse, &temp)
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return &pbResponse, err
+	return pbResponse, nil
 }
 
-type LanguageORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+type EmailORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+type EmailORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+type EmailORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]EmailORM) error
 }
 
-// DefaultPatchLanguage executes a basic gorm update call with patch behavior
-func DefaultPatchLanguage(ctx context.Context, in *Language, updateMask *field_mask.FieldMask, db *gorm.DB) (*Language, error) {
+// withAddressTransaction runs fn in a new transaction of db, so that the
+// changes of AddressORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withAddressTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
+// DefaultCreateAddress executes a basic gorm create call
+func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Language
-	var err error
-	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	pbReadRes, err := DefaultReadLanguage(ctx, &Language{Id: in.GetId()}, db)
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskLanguage(ctx, &pbObj, in, updateMask, "", db); err != nil {
-		return nil, err
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
-	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateLanguage(ctx, &pbObj, db)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(pbResponse).(LanguageWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	return pbResponse, nil
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
 }
 
-type LanguageWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type LanguageWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type LanguageWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type AddressORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) error
+type AddressORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchSetLanguage executes a bulk gorm update call with patch behavior
-func DefaultPatchSetLanguage(ctx context.Context, objects []*Language, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Language, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+// DefaultCreateAddressSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateAddressSet(ctx context.Context, in []*Address, batchSize int, db *gorm.DB) ([]*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-
-	results := make([]*Language, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchLanguage(ctx, patcher, updateMasks[i], db)
+	var out []*Address
+	if ok, err := withAddressTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateAddressSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
-	}
-
-	return results, nil
-}
-
-// DefaultApplyFieldMaskLanguage patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskLanguage(ctx context.Context, patchee *Language, patcher *Language, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Language, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
+		return out, nil
 	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"Name" {
-			patchee.Name = patcher.Name
-			continue
-		}
-		if f == prefix+"Code" {
-			patchee.Code = patcher.Code
-			continue
-		}
-		if f == prefix+"ExternalInt" {
-			patchee.ExternalInt = patcher.ExternalInt
-			continue
+	ormObjs := make([]*AddressORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
 		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return patchee, nil
-}
-
-// DefaultListLanguage executes a gorm list call
-func DefaultListLanguage(ctx context.Context, db *gorm.DB) ([]*Language, error) {
-	in := Language{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
 			return nil, err
 		}
+		ormObjs = append(ormObjs, &ormObj)
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &LanguageORM{}, &Language{}, nil, nil, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+	var err error
+	if hook, ok := interface{}(&AddressORM{}).(AddressORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []LanguageORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	if hook, ok := interface{}(&AddressORM{}).(AddressORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Language{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	pbResponse := make([]*Address, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		pbResponse = append(pbResponse, &pbObj)
 	}
 	return pbResponse, nil
 }
 
-type LanguageORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type LanguageORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type LanguageORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]LanguageORM) error
-}
-
-// DefaultCreateCreditCard executes a basic gorm create call
-func DefaultCreateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+// AddressORMWithBeforeCreateSet called before DefaultCreateAddressSet inserts the objects
+type AddressORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*AddressORM, *gorm.DB) (*gorm.DB, error)
 }
 
-type CreditCardORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type CreditCardORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+// AddressORMWithAfterCreateSet called after DefaultCreateAddressSet inserts the objects
+type AddressORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*AddressORM, *gorm.DB) error
 }
 
-// DefaultReadCreditCard executes a basic gorm read call
-func DefaultReadCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
+// DefaultReadAddress executes a basic gorm read call
+func DefaultReadAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2495,24 +3310,24 @@ func DefaultReadCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*C
 	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &CreditCardORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &AddressORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := CreditCardORM{}
+	ormResponse := AddressORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
-	if hook, ok := interface{}(&ormResponse).(CreditCardORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(AddressORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2521,17 +3336,17 @@ func DefaultReadCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*C
 	return &pbResponse, err
 }
 
-type CreditCardORMWithBeforeReadApplyQuery interface {
+type AddressORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithBeforeReadFind interface {
+type AddressORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithAfterReadFind interface {
+type AddressORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) error {
+func DefaultDeleteAddress(ctx context.Context, in *Address, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2542,45 +3357,65 @@ func DefaultDeleteCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) e
 	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&CreditCardORM{}).Error
+	err = db.Where(&ormObj).Delete(&AddressORM{}).Error
 	if err != nil {
-		return err
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type CreditCardORMWithBeforeDelete_ interface {
+type AddressORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithAfterDelete_ interface {
+type AddressORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteCreditCardSet(ctx context.Context, in []*CreditCard, db *gorm.DB) error {
+// DefaultDeleteAddressSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
+func DefaultDeleteAddressSet(ctx context.Context, in []*Address, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if ok, err := withAddressTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteAddressSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
 	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*AddressORM, len(in))
 	keys := []int64{}
-	for _, obj := range in {
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
-			return err
+			errs.Add(i, err)
+			continue
 		}
 		if ormObj.Id == 0 {
-			return errors.EmptyIdError
+			errs.Add(i, errors.EmptyIdError)
+			continue
 		}
+		ormObjs[i] = &ormObj
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&CreditCardORM{})).(CreditCardORMWithBeforeDeleteSet); ok {
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
@@ -2589,27 +3424,42 @@ func DefaultDeleteCreditCardSet(ctx context.Context, in []*CreditCard, db *gorm.
 	if err != nil {
 		return err
 	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&CreditCardORM{}).Error
-	if err != nil {
+	before := []*AddressORM{}
+	if err = db.Where("account_id = ? AND id IN (?)", acctId, keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[int64]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&CreditCardORM{})).(CreditCardORMWithAfterDeleteSet); ok {
+	if err = db.Where("account_id = ? AND id IN (?)", acctId, keys).Delete(&AddressORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type CreditCardORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*CreditCard, *gorm.DB) (*gorm.DB, error)
+type AddressORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Address, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*CreditCard, *gorm.DB) error
+type AddressORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Address, *gorm.DB) error
 }
 
-// DefaultStrictUpdateCreditCard clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
+// DefaultStrictUpdateAddress clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateCreditCard")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -2620,22 +3470,22 @@ func DefaultStrictUpdateCreditCard(ctx context.Context, in *CreditCard, db *gorm
 		return nil, err
 	}
 	db = db.Where(map[string]interface{}{"account_id": accountID})
-	lockedRow := &CreditCardORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeStrictUpdateCleanup); ok {
+	lockedRow := &AddressORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2647,124 +3497,2546 @@ func DefaultStrictUpdateCreditCard(ctx context.Context, in *CreditCard, db *gorm
 	return &pbResponse, err
 }
 
-type CreditCardORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+type AddressORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchAddress executes a basic gorm update call with patch behavior
+func DefaultPatchAddress(ctx context.Context, in *Address, updateMask *field_mask.FieldMask, db *gorm.DB) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Address
+	var err error
+	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadAddress(ctx, &Address{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskAddress(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateAddress(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(AddressWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type AddressWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AddressWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AddressWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AddressWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetAddress executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
+func DefaultPatchSetAddress(ctx context.Context, objects []*Address, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Address, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
+	}
+	var out []*Address
+	if ok, err := withAddressTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetAddress(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	var err error
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	errs := &errors.MultiError{}
+	ormObjs := make([]*AddressORM, len(objects))
+	hookedRows := make([]*AddressORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]int64, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(AddressWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &AddressORM{}
+		if err = odb.Where(map[string]interface{}{"account_id": accountID}).Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&AddressORM{}).(AddressORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*AddressORM{}
+	if len(keys) > 0 {
+		if err = db.Where(map[string]interface{}{"account_id": accountID}).Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[int64]*AddressORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *Address, updateMask *field_mask.FieldMask, row *AddressORM, db *gorm.DB) (*Address, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskAddress(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*Address, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *Address, in *Address, updateMask *field_mask.FieldMask, db *gorm.DB) (*Address, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(AddressWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateAddress(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(AddressWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*Address, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
+		results = append(results, pbResponse)
+	}
+	return results, nil
+}
+
+// AddressORMWithBeforePatchSetRead called before DefaultPatchSetAddress reads the rows
+type AddressORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*Address, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
+// DefaultApplyFieldMaskAddress patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher *Address, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Address, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Address_1" {
+			patchee.Address_1 = patcher.Address_1
+			continue
+		}
+		if f == prefix+"Address_2" {
+			patchee.Address_2 = patcher.Address_2
+			continue
+		}
+		if f == prefix+"Post" {
+			patchee.Post = patcher.Post
+			continue
+		}
+		if f == prefix+"External" {
+			patchee.External = patcher.External
+			continue
+		}
+		if f == prefix+"ImplicitFk" {
+			patchee.ImplicitFk = patcher.ImplicitFk
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// The columns of AddressORM
+const (
+	AddressORMColumnAccountID  = "account_id"
+	AddressORMColumnAddress_1  = "address_1"
+	AddressORMColumnAddress_2  = "address_2"
+	AddressORMColumnExternal   = "external"
+	AddressORMColumnId         = "id"
+	AddressORMColumnImplicitFk = "implicit_fk"
+	AddressORMColumnPost       = "post"
+)
+
+// AddressORMQueryBuilder builds a query of AddressORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type AddressORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// AddressORMQuery returns an empty query builder of AddressORM
+func AddressORMQuery() *AddressORMQueryBuilder {
+	return &AddressORMQueryBuilder{}
+}
+
+func (q *AddressORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *AddressORMQueryBuilder {
+	return &AddressORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *AddressORMQueryBuilder) where(query string, args ...interface{}) *AddressORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *AddressORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// AccountIDEq adds the condition account_id = v
+func (q *AddressORMQueryBuilder) AccountIDEq(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAccountID+" = ?", v)
+}
+
+// AccountIDNe adds the condition account_id <> v
+func (q *AddressORMQueryBuilder) AccountIDNe(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAccountID+" <> ?", v)
+}
+
+// AccountIDGt adds the condition account_id > v
+func (q *AddressORMQueryBuilder) AccountIDGt(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAccountID+" > ?", v)
+}
+
+// AccountIDGe adds the condition account_id >= v
+func (q *AddressORMQueryBuilder) AccountIDGe(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAccountID+" >= ?", v)
+}
+
+// AccountIDLt adds the condition account_id < v
+func (q *AddressORMQueryBuilder) AccountIDLt(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAccountID+" < ?", v)
+}
+
+// AccountIDLe adds the condition account_id <= v
+func (q *AddressORMQueryBuilder) AccountIDLe(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAccountID+" <= ?", v)
+}
+
+// AccountIDIn adds the condition account_id IN (vs)
+func (q *AddressORMQueryBuilder) AccountIDIn(vs ...string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAccountID+" IN (?)", vs)
+}
+
+// AccountIDLike adds the condition account_id LIKE pattern
+func (q *AddressORMQueryBuilder) AccountIDLike(pattern string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAccountID+" LIKE ?", pattern)
+}
+
+// OrderByAccountID adds the order by account_id, descending when desc
+func (q *AddressORMQueryBuilder) OrderByAccountID(desc bool) *AddressORMQueryBuilder {
+	order := AddressORMColumnAccountID
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// Address_1Eq adds the condition address_1 = v
+func (q *AddressORMQueryBuilder) Address_1Eq(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_1+" = ?", v)
+}
+
+// Address_1Ne adds the condition address_1 <> v
+func (q *AddressORMQueryBuilder) Address_1Ne(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_1+" <> ?", v)
+}
+
+// Address_1Gt adds the condition address_1 > v
+func (q *AddressORMQueryBuilder) Address_1Gt(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_1+" > ?", v)
+}
+
+// Address_1Ge adds the condition address_1 >= v
+func (q *AddressORMQueryBuilder) Address_1Ge(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_1+" >= ?", v)
+}
+
+// Address_1Lt adds the condition address_1 < v
+func (q *AddressORMQueryBuilder) Address_1Lt(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_1+" < ?", v)
+}
+
+// Address_1Le adds the condition address_1 <= v
+func (q *AddressORMQueryBuilder) Address_1Le(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_1+" <= ?", v)
+}
+
+// Address_1In adds the condition address_1 IN (vs)
+func (q *AddressORMQueryBuilder) Address_1In(vs ...string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_1+" IN (?)", vs)
+}
+
+// Address_1Like adds the condition address_1 LIKE pattern
+func (q *AddressORMQueryBuilder) Address_1Like(pattern string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_1+" LIKE ?", pattern)
+}
+
+// OrderByAddress_1 adds the order by address_1, descending when desc
+func (q *AddressORMQueryBuilder) OrderByAddress_1(desc bool) *AddressORMQueryBuilder {
+	order := AddressORMColumnAddress_1
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// Address_2Eq adds the condition address_2 = v
+func (q *AddressORMQueryBuilder) Address_2Eq(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_2+" = ?", v)
+}
+
+// Address_2Ne adds the condition address_2 <> v
+func (q *AddressORMQueryBuilder) Address_2Ne(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_2+" <> ?", v)
+}
+
+// Address_2Gt adds the condition address_2 > v
+func (q *AddressORMQueryBuilder) Address_2Gt(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_2+" > ?", v)
+}
+
+// Address_2Ge adds the condition address_2 >= v
+func (q *AddressORMQueryBuilder) Address_2Ge(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_2+" >= ?", v)
+}
+
+// Address_2Lt adds the condition address_2 < v
+func (q *AddressORMQueryBuilder) Address_2Lt(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_2+" < ?", v)
+}
+
+// Address_2Le adds the condition address_2 <= v
+func (q *AddressORMQueryBuilder) Address_2Le(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_2+" <= ?", v)
+}
+
+// Address_2In adds the condition address_2 IN (vs)
+func (q *AddressORMQueryBuilder) Address_2In(vs ...string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_2+" IN (?)", vs)
+}
+
+// Address_2Like adds the condition address_2 LIKE pattern
+func (q *AddressORMQueryBuilder) Address_2Like(pattern string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnAddress_2+" LIKE ?", pattern)
+}
+
+// OrderByAddress_2 adds the order by address_2, descending when desc
+func (q *AddressORMQueryBuilder) OrderByAddress_2(desc bool) *AddressORMQueryBuilder {
+	order := AddressORMColumnAddress_2
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// OrderByExternal adds the order by external, descending when desc
+func (q *AddressORMQueryBuilder) OrderByExternal(desc bool) *AddressORMQueryBuilder {
+	order := AddressORMColumnExternal
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// IdEq adds the condition id = v
+func (q *AddressORMQueryBuilder) IdEq(v int64) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *AddressORMQueryBuilder) IdNe(v int64) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *AddressORMQueryBuilder) IdGt(v int64) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *AddressORMQueryBuilder) IdGe(v int64) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *AddressORMQueryBuilder) IdLt(v int64) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *AddressORMQueryBuilder) IdLe(v int64) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *AddressORMQueryBuilder) IdIn(vs ...int64) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnId+" IN (?)", vs)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *AddressORMQueryBuilder) OrderById(desc bool) *AddressORMQueryBuilder {
+	order := AddressORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// ImplicitFkEq adds the condition implicit_fk = v
+func (q *AddressORMQueryBuilder) ImplicitFkEq(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnImplicitFk+" = ?", v)
+}
+
+// ImplicitFkNe adds the condition implicit_fk <> v
+func (q *AddressORMQueryBuilder) ImplicitFkNe(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnImplicitFk+" <> ?", v)
+}
+
+// ImplicitFkGt adds the condition implicit_fk > v
+func (q *AddressORMQueryBuilder) ImplicitFkGt(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnImplicitFk+" > ?", v)
+}
+
+// ImplicitFkGe adds the condition implicit_fk >= v
+func (q *AddressORMQueryBuilder) ImplicitFkGe(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnImplicitFk+" >= ?", v)
+}
+
+// ImplicitFkLt adds the condition implicit_fk < v
+func (q *AddressORMQueryBuilder) ImplicitFkLt(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnImplicitFk+" < ?", v)
+}
+
+// ImplicitFkLe adds the condition implicit_fk <= v
+func (q *AddressORMQueryBuilder) ImplicitFkLe(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnImplicitFk+" <= ?", v)
+}
+
+// ImplicitFkIn adds the condition implicit_fk IN (vs)
+func (q *AddressORMQueryBuilder) ImplicitFkIn(vs ...string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnImplicitFk+" IN (?)", vs)
+}
+
+// ImplicitFkLike adds the condition implicit_fk LIKE pattern
+func (q *AddressORMQueryBuilder) ImplicitFkLike(pattern string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnImplicitFk+" LIKE ?", pattern)
+}
+
+// ImplicitFkIsNull adds the condition implicit_fk IS NULL
+func (q *AddressORMQueryBuilder) ImplicitFkIsNull() *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnImplicitFk + " IS NULL")
+}
+
+// ImplicitFkIsNotNull adds the condition implicit_fk IS NOT NULL
+func (q *AddressORMQueryBuilder) ImplicitFkIsNotNull() *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnImplicitFk + " IS NOT NULL")
+}
+
+// OrderByImplicitFk adds the order by implicit_fk, descending when desc
+func (q *AddressORMQueryBuilder) OrderByImplicitFk(desc bool) *AddressORMQueryBuilder {
+	order := AddressORMColumnImplicitFk
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PostEq adds the condition post = v
+func (q *AddressORMQueryBuilder) PostEq(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnPost+" = ?", v)
+}
+
+// PostNe adds the condition post <> v
+func (q *AddressORMQueryBuilder) PostNe(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnPost+" <> ?", v)
+}
+
+// PostGt adds the condition post > v
+func (q *AddressORMQueryBuilder) PostGt(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnPost+" > ?", v)
+}
+
+// PostGe adds the condition post >= v
+func (q *AddressORMQueryBuilder) PostGe(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnPost+" >= ?", v)
+}
+
+// PostLt adds the condition post < v
+func (q *AddressORMQueryBuilder) PostLt(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnPost+" < ?", v)
+}
+
+// PostLe adds the condition post <= v
+func (q *AddressORMQueryBuilder) PostLe(v string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnPost+" <= ?", v)
+}
+
+// PostIn adds the condition post IN (vs)
+func (q *AddressORMQueryBuilder) PostIn(vs ...string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnPost+" IN (?)", vs)
+}
+
+// PostLike adds the condition post LIKE pattern
+func (q *AddressORMQueryBuilder) PostLike(pattern string) *AddressORMQueryBuilder {
+	return q.where(AddressORMColumnPost+" LIKE ?", pattern)
+}
+
+// OrderByPost adds the order by post, descending when desc
+func (q *AddressORMQueryBuilder) OrderByPost(desc bool) *AddressORMQueryBuilder {
+	order := AddressORMColumnPost
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// DefaultListAddress executes a gorm list call
+func DefaultListAddress(ctx context.Context, db *gorm.DB) ([]*Address, error) {
+	in := Address{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &AddressORM{}, &Address{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []AddressORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Address{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type AddressORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]AddressORM) error
+}
+
+// withLanguageTransaction runs fn in a new transaction of db, so that the
+// changes of LanguageORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withLanguageTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
+// DefaultCreateLanguage executes a basic gorm create call
+func DefaultCreateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LanguageORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateLanguageSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateLanguageSet(ctx context.Context, in []*Language, batchSize int, db *gorm.DB) ([]*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*Language
+	if ok, err := withLanguageTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateLanguageSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*LanguageORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&LanguageORM{}).(LanguageORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&LanguageORM{}).(LanguageORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*Language, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// LanguageORMWithBeforeCreateSet called before DefaultCreateLanguageSet inserts the objects
+type LanguageORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*LanguageORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// LanguageORMWithAfterCreateSet called after DefaultCreateLanguageSet inserts the objects
+type LanguageORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*LanguageORM, *gorm.DB) error
+}
+
+// DefaultReadLanguage executes a basic gorm read call
+func DefaultReadLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &LanguageORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := LanguageORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormResponse).(LanguageORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LanguageORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteLanguage(ctx context.Context, in *Language, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&LanguageORM{}).Error
+	if err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type LanguageORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+// DefaultDeleteLanguageSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
+func DefaultDeleteLanguageSet(ctx context.Context, in []*Language, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	if ok, err := withLanguageTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteLanguageSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*LanguageORM, len(in))
+	keys := []int64{}
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		ormObjs[i] = &ormObj
+		keys = append(keys, ormObj.Id)
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	before := []*LanguageORM{}
+	if err = db.Where("account_id = ? AND id IN (?)", acctId, keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[int64]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
+	if err = db.Where("account_id = ? AND id IN (?)", acctId, keys).Delete(&LanguageORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type LanguageORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Language, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Language, *gorm.DB) error
+}
+
+// DefaultStrictUpdateLanguage clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(map[string]interface{}{"account_id": accountID})
+	lockedRow := &LanguageORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type LanguageORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchLanguage executes a basic gorm update call with patch behavior
+func DefaultPatchLanguage(ctx context.Context, in *Language, updateMask *field_mask.FieldMask, db *gorm.DB) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Language
+	var err error
+	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadLanguage(ctx, &Language{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskLanguage(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateLanguage(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(LanguageWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type LanguageWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetLanguage executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
+func DefaultPatchSetLanguage(ctx context.Context, objects []*Language, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Language, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
+	}
+	var out []*Language
+	if ok, err := withLanguageTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetLanguage(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	var err error
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	errs := &errors.MultiError{}
+	ormObjs := make([]*LanguageORM, len(objects))
+	hookedRows := make([]*LanguageORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]int64, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(LanguageWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &LanguageORM{}
+		if err = odb.Where(map[string]interface{}{"account_id": accountID}).Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&LanguageORM{}).(LanguageORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*LanguageORM{}
+	if len(keys) > 0 {
+		if err = db.Where(map[string]interface{}{"account_id": accountID}).Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[int64]*LanguageORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *Language, updateMask *field_mask.FieldMask, row *LanguageORM, db *gorm.DB) (*Language, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskLanguage(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*Language, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *Language, in *Language, updateMask *field_mask.FieldMask, db *gorm.DB) (*Language, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(LanguageWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateLanguage(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(LanguageWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*Language, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
+		results = append(results, pbResponse)
+	}
+	return results, nil
+}
+
+// LanguageORMWithBeforePatchSetRead called before DefaultPatchSetLanguage reads the rows
+type LanguageORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*Language, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
+// DefaultApplyFieldMaskLanguage patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskLanguage(ctx context.Context, patchee *Language, patcher *Language, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Language, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Code" {
+			patchee.Code = patcher.Code
+			continue
+		}
+		if f == prefix+"ExternalInt" {
+			patchee.ExternalInt = patcher.ExternalInt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// The columns of LanguageORM
+const (
+	LanguageORMColumnAccountID   = "account_id"
+	LanguageORMColumnCode        = "code"
+	LanguageORMColumnExternalInt = "external_int"
+	LanguageORMColumnId          = "id"
+	LanguageORMColumnName        = "name"
+)
+
+// LanguageORMQueryBuilder builds a query of LanguageORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type LanguageORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// LanguageORMQuery returns an empty query builder of LanguageORM
+func LanguageORMQuery() *LanguageORMQueryBuilder {
+	return &LanguageORMQueryBuilder{}
+}
+
+func (q *LanguageORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *LanguageORMQueryBuilder {
+	return &LanguageORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *LanguageORMQueryBuilder) where(query string, args ...interface{}) *LanguageORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *LanguageORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// AccountIDEq adds the condition account_id = v
+func (q *LanguageORMQueryBuilder) AccountIDEq(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnAccountID+" = ?", v)
+}
+
+// AccountIDNe adds the condition account_id <> v
+func (q *LanguageORMQueryBuilder) AccountIDNe(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnAccountID+" <> ?", v)
+}
+
+// AccountIDGt adds the condition account_id > v
+func (q *LanguageORMQueryBuilder) AccountIDGt(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnAccountID+" > ?", v)
+}
+
+// AccountIDGe adds the condition account_id >= v
+func (q *LanguageORMQueryBuilder) AccountIDGe(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnAccountID+" >= ?", v)
+}
+
+// AccountIDLt adds the condition account_id < v
+func (q *LanguageORMQueryBuilder) AccountIDLt(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnAccountID+" < ?", v)
+}
+
+// AccountIDLe adds the condition account_id <= v
+func (q *LanguageORMQueryBuilder) AccountIDLe(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnAccountID+" <= ?", v)
+}
+
+// AccountIDIn adds the condition account_id IN (vs)
+func (q *LanguageORMQueryBuilder) AccountIDIn(vs ...string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnAccountID+" IN (?)", vs)
+}
+
+// AccountIDLike adds the condition account_id LIKE pattern
+func (q *LanguageORMQueryBuilder) AccountIDLike(pattern string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnAccountID+" LIKE ?", pattern)
+}
+
+// OrderByAccountID adds the order by account_id, descending when desc
+func (q *LanguageORMQueryBuilder) OrderByAccountID(desc bool) *LanguageORMQueryBuilder {
+	order := LanguageORMColumnAccountID
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// CodeEq adds the condition code = v
+func (q *LanguageORMQueryBuilder) CodeEq(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnCode+" = ?", v)
+}
+
+// CodeNe adds the condition code <> v
+func (q *LanguageORMQueryBuilder) CodeNe(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnCode+" <> ?", v)
+}
+
+// CodeGt adds the condition code > v
+func (q *LanguageORMQueryBuilder) CodeGt(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnCode+" > ?", v)
+}
+
+// CodeGe adds the condition code >= v
+func (q *LanguageORMQueryBuilder) CodeGe(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnCode+" >= ?", v)
+}
+
+// CodeLt adds the condition code < v
+func (q *LanguageORMQueryBuilder) CodeLt(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnCode+" < ?", v)
+}
+
+// CodeLe adds the condition code <= v
+func (q *LanguageORMQueryBuilder) CodeLe(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnCode+" <= ?", v)
+}
+
+// CodeIn adds the condition code IN (vs)
+func (q *LanguageORMQueryBuilder) CodeIn(vs ...string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnCode+" IN (?)", vs)
+}
+
+// CodeLike adds the condition code LIKE pattern
+func (q *LanguageORMQueryBuilder) CodeLike(pattern string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnCode+" LIKE ?", pattern)
+}
+
+// OrderByCode adds the order by code, descending when desc
+func (q *LanguageORMQueryBuilder) OrderByCode(desc bool) *LanguageORMQueryBuilder {
+	order := LanguageORMColumnCode
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// ExternalIntEq adds the condition external_int = v
+func (q *LanguageORMQueryBuilder) ExternalIntEq(v int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnExternalInt+" = ?", v)
+}
+
+// ExternalIntNe adds the condition external_int <> v
+func (q *LanguageORMQueryBuilder) ExternalIntNe(v int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnExternalInt+" <> ?", v)
+}
+
+// ExternalIntGt adds the condition external_int > v
+func (q *LanguageORMQueryBuilder) ExternalIntGt(v int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnExternalInt+" > ?", v)
+}
+
+// ExternalIntGe adds the condition external_int >= v
+func (q *LanguageORMQueryBuilder) ExternalIntGe(v int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnExternalInt+" >= ?", v)
+}
+
+// ExternalIntLt adds the condition external_int < v
+func (q *LanguageORMQueryBuilder) ExternalIntLt(v int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnExternalInt+" < ?", v)
+}
+
+// ExternalIntLe adds the condition external_int <= v
+func (q *LanguageORMQueryBuilder) ExternalIntLe(v int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnExternalInt+" <= ?", v)
+}
+
+// ExternalIntIn adds the condition external_int IN (vs)
+func (q *LanguageORMQueryBuilder) ExternalIntIn(vs ...int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnExternalInt+" IN (?)", vs)
+}
+
+// ExternalIntIsNull adds the condition external_int IS NULL
+func (q *LanguageORMQueryBuilder) ExternalIntIsNull() *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnExternalInt + " IS NULL")
+}
+
+// ExternalIntIsNotNull adds the condition external_int IS NOT NULL
+func (q *LanguageORMQueryBuilder) ExternalIntIsNotNull() *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnExternalInt + " IS NOT NULL")
+}
+
+// OrderByExternalInt adds the order by external_int, descending when desc
+func (q *LanguageORMQueryBuilder) OrderByExternalInt(desc bool) *LanguageORMQueryBuilder {
+	order := LanguageORMColumnExternalInt
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// IdEq adds the condition id = v
+func (q *LanguageORMQueryBuilder) IdEq(v int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *LanguageORMQueryBuilder) IdNe(v int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *LanguageORMQueryBuilder) IdGt(v int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *LanguageORMQueryBuilder) IdGe(v int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *LanguageORMQueryBuilder) IdLt(v int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *LanguageORMQueryBuilder) IdLe(v int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *LanguageORMQueryBuilder) IdIn(vs ...int64) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnId+" IN (?)", vs)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *LanguageORMQueryBuilder) OrderById(desc bool) *LanguageORMQueryBuilder {
+	order := LanguageORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// NameEq adds the condition name = v
+func (q *LanguageORMQueryBuilder) NameEq(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnName+" = ?", v)
+}
+
+// NameNe adds the condition name <> v
+func (q *LanguageORMQueryBuilder) NameNe(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnName+" <> ?", v)
+}
+
+// NameGt adds the condition name > v
+func (q *LanguageORMQueryBuilder) NameGt(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnName+" > ?", v)
+}
+
+// NameGe adds the condition name >= v
+func (q *LanguageORMQueryBuilder) NameGe(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnName+" >= ?", v)
+}
+
+// NameLt adds the condition name < v
+func (q *LanguageORMQueryBuilder) NameLt(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnName+" < ?", v)
+}
+
+// NameLe adds the condition name <= v
+func (q *LanguageORMQueryBuilder) NameLe(v string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnName+" <= ?", v)
+}
+
+// NameIn adds the condition name IN (vs)
+func (q *LanguageORMQueryBuilder) NameIn(vs ...string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnName+" IN (?)", vs)
+}
+
+// NameLike adds the condition name LIKE pattern
+func (q *LanguageORMQueryBuilder) NameLike(pattern string) *LanguageORMQueryBuilder {
+	return q.where(LanguageORMColumnName+" LIKE ?", pattern)
+}
+
+// OrderByName adds the order by name, descending when desc
+func (q *LanguageORMQueryBuilder) OrderByName(desc bool) *LanguageORMQueryBuilder {
+	order := LanguageORMColumnName
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// DefaultListLanguage executes a gorm list call
+func DefaultListLanguage(ctx context.Context, db *gorm.DB) ([]*Language, error) {
+	in := Language{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &LanguageORM{}, &Language{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []LanguageORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Language{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type LanguageORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]LanguageORM) error
+}
+
+// withCreditCardTransaction runs fn in a new transaction of db, so that the
+// changes of CreditCardORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withCreditCardTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
+// DefaultCreateCreditCard executes a basic gorm create call
+func DefaultCreateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CreditCardORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateCreditCardSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateCreditCardSet(ctx context.Context, in []*CreditCard, batchSize int, db *gorm.DB) ([]*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*CreditCard
+	if ok, err := withCreditCardTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateCreditCardSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*CreditCardORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&CreditCardORM{}).(CreditCardORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&CreditCardORM{}).(CreditCardORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*CreditCard, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// CreditCardORMWithBeforeCreateSet called before DefaultCreateCreditCardSet inserts the objects
+type CreditCardORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*CreditCardORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// CreditCardORMWithAfterCreateSet called after DefaultCreateCreditCardSet inserts the objects
+type CreditCardORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*CreditCardORM, *gorm.DB) error
+}
+
+// DefaultReadCreditCard executes a basic gorm read call
+func DefaultReadCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &CreditCardORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := CreditCardORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormResponse).(CreditCardORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CreditCardORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&CreditCardORM{}).Error
+	if err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type CreditCardORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+// DefaultDeleteCreditCardSet executes a bulk gorm delete call in a transaction, the
+// failures of the objects, missing rows included, are returned together in an
+// errors.MultiError, no row being deleted
+func DefaultDeleteCreditCardSet(ctx context.Context, in []*CreditCard, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	if ok, err := withCreditCardTransaction(db, func(tx *gorm.DB) error {
+		return DefaultDeleteCreditCardSet(ctx, in, tx)
+	}); ok {
+		return err
+	}
+	var err error
+	errs := &errors.MultiError{}
+	ormObjs := make([]*CreditCardORM, len(in))
+	keys := []int64{}
+	for i, obj := range in {
+		if obj == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		ormObjs[i] = &ormObj
+		keys = append(keys, ormObj.Id)
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&CreditCardORM{})).(CreditCardORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	before := []*CreditCardORM{}
+	if err = db.Where("account_id = ? AND id IN (?)", acctId, keys).Find(&before).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	found := make(map[int64]bool, len(before))
+	for _, row := range before {
+		found[row.Id] = true
+	}
+	for i, ormObj := range ormObjs {
+		if ormObj != nil && !found[ormObj.Id] {
+			errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return err
+	}
+	if err = db.Where("account_id = ? AND id IN (?)", acctId, keys).Delete(&CreditCardORM{}).Error; err != nil {
+		return errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := (interface{}(&CreditCardORM{})).(CreditCardORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type CreditCardORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*CreditCard, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*CreditCard, *gorm.DB) error
+}
+
+// DefaultStrictUpdateCreditCard clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(map[string]interface{}{"account_id": accountID})
+	lockedRow := &CreditCardORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id = ?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type CreditCardORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchCreditCard executes a basic gorm update call with patch behavior
+func DefaultPatchCreditCard(ctx context.Context, in *CreditCard, updateMask *field_mask.FieldMask, db *gorm.DB) (*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj CreditCard
+	var err error
+	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadCreditCard(ctx, &CreditCard{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskCreditCard(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateCreditCard(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(CreditCardWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type CreditCardWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetCreditCard executes a bulk gorm update call with patch behavior in a
+// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead
+// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched
+// and saved with the db returned by the hook of their object. The rows are patched in
+// memory, the failures of the objects are returned together in an errors.MultiError, no
+// row being changed
+func DefaultPatchSetCreditCard(ctx context.Context, objects []*CreditCard, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*CreditCard, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, errors.InvalidArgument(fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects)))
+	}
+	var out []*CreditCard
+	if ok, err := withCreditCardTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultPatchSetCreditCard(ctx, objects, updateMasks, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	var err error
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	errs := &errors.MultiError{}
+	ormObjs := make([]*CreditCardORM, len(objects))
+	hookedRows := make([]*CreditCardORM, len(objects))
+	dbs := make([]*gorm.DB, len(objects))
+	keys := make([]int64, 0, len(objects))
+	for i, in := range objects {
+		if in == nil {
+			errs.Add(i, errors.NilArgumentError)
+			continue
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ormObj.Id == 0 {
+			errs.Add(i, errors.EmptyIdError)
+			continue
+		}
+		hook, ok := interface{}(in).(CreditCardWithBeforePatchRead)
+		if !ok {
+			ormObjs[i], dbs[i] = &ormObj, db
+			keys = append(keys, ormObj.Id)
+			continue
+		}
+		// the conditions added by the hook only apply to the row of its object
+		odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		row := &CreditCardORM{}
+		if err = odb.Where(map[string]interface{}{"account_id": accountID}).Where("id = ?", ormObj.Id).First(row).Error; err != nil {
+			errs.Add(i, errors.FromDB(err, gorm.ErrRecordNotFound))
+			continue
+		}
+		ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row
+	}
+	if hook, ok := interface{}(&CreditCardORM{}).(CreditCardORMWithBeforePatchSetRead); ok {
+		if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*CreditCardORM{}
+	if len(keys) > 0 {
+		if err = db.Where(map[string]interface{}{"account_id": accountID}).Where("id IN (?)", keys).Find(&rows).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	stored := make(map[int64]*CreditCardORM, len(rows))
+	for _, row := range rows {
+		stored[row.Id] = row
+	}
+	patch := func(in *CreditCard, updateMask *field_mask.FieldMask, row *CreditCardORM, db *gorm.DB) (*CreditCard, error) {
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchApplyFieldMask); ok {
+			if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := DefaultApplyFieldMaskCreditCard(ctx, &pbObj, in, updateMask, "", db); err != nil {
+			return nil, err
+		}
+		return &pbObj, nil
+	}
+	patched := make([]*CreditCard, len(objects))
+	for i, ormObj := range ormObjs {
+		if ormObj == nil {
+			continue
+		}
+		row := hookedRows[i]
+		if row == nil {
+			var ok bool
+			if row, ok = stored[ormObj.Id]; !ok {
+				errs.Add(i, errors.NotFound(gorm.ErrRecordNotFound))
+				continue
+			}
+		}
+		if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {
+			errs.Add(i, err)
+		}
+	}
+	if err = errs.ErrOrNil(); err != nil {
+		return nil, err
+	}
+	save := func(pbObj *CreditCard, in *CreditCard, updateMask *field_mask.FieldMask, db *gorm.DB) (*CreditCard, error) {
+		var err error
+		if hook, ok := interface{}(pbObj).(CreditCardWithBeforePatchSave); ok {
+			if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		pbResponse, err := DefaultStrictUpdateCreditCard(ctx, pbObj, db)
+		if err != nil {
+			return nil, err
+		}
+		if hook, ok := interface{}(pbResponse).(CreditCardWithAfterPatchSave); ok {
+			if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+				return nil, err
+			}
+		}
+		return pbResponse, nil
+	}
+	results := make([]*CreditCard, 0, len(objects))
+	for i, pbObj := range patched {
+		pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])
+		if err != nil {
+			errs.Add(i, err)
+			return nil, errs
+		}
+		results = append(results, pbResponse)
+	}
+	return results, nil
+}
+
+// CreditCardORMWithBeforePatchSetRead called before DefaultPatchSetCreditCard reads the rows
+type CreditCardORMWithBeforePatchSetRead interface {
+	BeforePatchSetRead(context.Context, []*CreditCard, []*field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+
+// DefaultApplyFieldMaskCreditCard patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, patcher *CreditCard, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*CreditCard, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			updatedCreatedAt = true
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			updatedUpdatedAt = true
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"Number" {
+			patchee.Number = patcher.Number
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// The columns of CreditCardORM
+const (
+	CreditCardORMColumnAccountID = "account_id"
+	CreditCardORMColumnCreatedAt = "created_at"
+	CreditCardORMColumnId        = "id"
+	CreditCardORMColumnNumber    = "number"
+	CreditCardORMColumnUpdatedAt = "updated_at"
+	CreditCardORMColumnUserId    = "user_id"
+)
+
+// CreditCardORMQueryBuilder builds a query of CreditCardORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type CreditCardORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// CreditCardORMQuery returns an empty query builder of CreditCardORM
+func CreditCardORMQuery() *CreditCardORMQueryBuilder {
+	return &CreditCardORMQueryBuilder{}
+}
+
+func (q *CreditCardORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *CreditCardORMQueryBuilder {
+	return &CreditCardORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *CreditCardORMQueryBuilder) where(query string, args ...interface{}) *CreditCardORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *CreditCardORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// AccountIDEq adds the condition account_id = v
+func (q *CreditCardORMQueryBuilder) AccountIDEq(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnAccountID+" = ?", v)
+}
+
+// AccountIDNe adds the condition account_id <> v
+func (q *CreditCardORMQueryBuilder) AccountIDNe(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnAccountID+" <> ?", v)
+}
+
+// AccountIDGt adds the condition account_id > v
+func (q *CreditCardORMQueryBuilder) AccountIDGt(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnAccountID+" > ?", v)
+}
+
+// AccountIDGe adds the condition account_id >= v
+func (q *CreditCardORMQueryBuilder) AccountIDGe(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnAccountID+" >= ?", v)
+}
+
+// AccountIDLt adds the condition account_id < v
+func (q *CreditCardORMQueryBuilder) AccountIDLt(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnAccountID+" < ?", v)
+}
+
+// AccountIDLe adds the condition account_id <= v
+func (q *CreditCardORMQueryBuilder) AccountIDLe(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnAccountID+" <= ?", v)
+}
+
+// AccountIDIn adds the condition account_id IN (vs)
+func (q *CreditCardORMQueryBuilder) AccountIDIn(vs ...string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnAccountID+" IN (?)", vs)
+}
+
+// AccountIDLike adds the condition account_id LIKE pattern
+func (q *CreditCardORMQueryBuilder) AccountIDLike(pattern string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnAccountID+" LIKE ?", pattern)
+}
+
+// OrderByAccountID adds the order by account_id, descending when desc
+func (q *CreditCardORMQueryBuilder) OrderByAccountID(desc bool) *CreditCardORMQueryBuilder {
+	order := CreditCardORMColumnAccountID
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// CreatedAtEq adds the condition created_at = v
+func (q *CreditCardORMQueryBuilder) CreatedAtEq(v time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnCreatedAt+" = ?", v)
+}
+
+// CreatedAtNe adds the condition created_at <> v
+func (q *CreditCardORMQueryBuilder) CreatedAtNe(v time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnCreatedAt+" <> ?", v)
+}
+
+// CreatedAtGt adds the condition created_at > v
+func (q *CreditCardORMQueryBuilder) CreatedAtGt(v time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnCreatedAt+" > ?", v)
+}
+
+// CreatedAtGe adds the condition created_at >= v
+func (q *CreditCardORMQueryBuilder) CreatedAtGe(v time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnCreatedAt+" >= ?", v)
+}
+
+// CreatedAtLt adds the condition created_at < v
+func (q *CreditCardORMQueryBuilder) CreatedAtLt(v time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnCreatedAt+" < ?", v)
+}
+
+// CreatedAtLe adds the condition created_at <= v
+func (q *CreditCardORMQueryBuilder) CreatedAtLe(v time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnCreatedAt+" <= ?", v)
+}
+
+// CreatedAtIn adds the condition created_at IN (vs)
+func (q *CreditCardORMQueryBuilder) CreatedAtIn(vs ...time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnCreatedAt+" IN (?)", vs)
+}
+
+// CreatedAtIsNull adds the condition created_at IS NULL
+func (q *CreditCardORMQueryBuilder) CreatedAtIsNull() *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnCreatedAt + " IS NULL")
+}
+
+// CreatedAtIsNotNull adds the condition created_at IS NOT NULL
+func (q *CreditCardORMQueryBuilder) CreatedAtIsNotNull() *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnCreatedAt + " IS NOT NULL")
+}
+
+// OrderByCreatedAt adds the order by created_at, descending when desc
+func (q *CreditCardORMQueryBuilder) OrderByCreatedAt(desc bool) *CreditCardORMQueryBuilder {
+	order := CreditCardORMColumnCreatedAt
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// IdEq adds the condition id = v
+func (q *CreditCardORMQueryBuilder) IdEq(v int64) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnId+" = ?", v)
+}
+
+// IdNe adds the condition id <> v
+func (q *CreditCardORMQueryBuilder) IdNe(v int64) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnId+" <> ?", v)
+}
+
+// IdGt adds the condition id > v
+func (q *CreditCardORMQueryBuilder) IdGt(v int64) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnId+" > ?", v)
+}
+
+// IdGe adds the condition id >= v
+func (q *CreditCardORMQueryBuilder) IdGe(v int64) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnId+" >= ?", v)
+}
+
+// IdLt adds the condition id < v
+func (q *CreditCardORMQueryBuilder) IdLt(v int64) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnId+" < ?", v)
+}
+
+// IdLe adds the condition id <= v
+func (q *CreditCardORMQueryBuilder) IdLe(v int64) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnId+" <= ?", v)
+}
+
+// IdIn adds the condition id IN (vs)
+func (q *CreditCardORMQueryBuilder) IdIn(vs ...int64) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnId+" IN (?)", vs)
+}
+
+// OrderById adds the order by id, descending when desc
+func (q *CreditCardORMQueryBuilder) OrderById(desc bool) *CreditCardORMQueryBuilder {
+	order := CreditCardORMColumnId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// NumberEq adds the condition number = v
+func (q *CreditCardORMQueryBuilder) NumberEq(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnNumber+" = ?", v)
+}
+
+// NumberNe adds the condition number <> v
+func (q *CreditCardORMQueryBuilder) NumberNe(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnNumber+" <> ?", v)
+}
+
+// NumberGt adds the condition number > v
+func (q *CreditCardORMQueryBuilder) NumberGt(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnNumber+" > ?", v)
+}
+
+// NumberGe adds the condition number >= v
+func (q *CreditCardORMQueryBuilder) NumberGe(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnNumber+" >= ?", v)
+}
+
+// NumberLt adds the condition number < v
+func (q *CreditCardORMQueryBuilder) NumberLt(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnNumber+" < ?", v)
+}
+
+// NumberLe adds the condition number <= v
+func (q *CreditCardORMQueryBuilder) NumberLe(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnNumber+" <= ?", v)
+}
+
+// NumberIn adds the condition number IN (vs)
+func (q *CreditCardORMQueryBuilder) NumberIn(vs ...string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnNumber+" IN (?)", vs)
+}
+
+// NumberLike adds the condition number LIKE pattern
+func (q *CreditCardORMQueryBuilder) NumberLike(pattern string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnNumber+" LIKE ?", pattern)
+}
+
+// OrderByNumber adds the order by number, descending when desc
+func (q *CreditCardORMQueryBuilder) OrderByNumber(desc bool) *CreditCardORMQueryBuilder {
+	order := CreditCardORMColumnNumber
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// UpdatedAtEq adds the condition updated_at = v
+func (q *CreditCardORMQueryBuilder) UpdatedAtEq(v time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUpdatedAt+" = ?", v)
+}
+
+// UpdatedAtNe adds the condition updated_at <> v
+func (q *CreditCardORMQueryBuilder) UpdatedAtNe(v time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUpdatedAt+" <> ?", v)
+}
+
+// UpdatedAtGt adds the condition updated_at > v
+func (q *CreditCardORMQueryBuilder) UpdatedAtGt(v time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUpdatedAt+" > ?", v)
+}
+
+// UpdatedAtGe adds the condition updated_at >= v
+func (q *CreditCardORMQueryBuilder) UpdatedAtGe(v time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUpdatedAt+" >= ?", v)
+}
+
+// UpdatedAtLt adds the condition updated_at < v
+func (q *CreditCardORMQueryBuilder) UpdatedAtLt(v time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUpdatedAt+" < ?", v)
+}
+
+// UpdatedAtLe adds the condition updated_at <= v
+func (q *CreditCardORMQueryBuilder) UpdatedAtLe(v time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUpdatedAt+" <= ?", v)
+}
+
+// UpdatedAtIn adds the condition updated_at IN (vs)
+func (q *CreditCardORMQueryBuilder) UpdatedAtIn(vs ...time.Time) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUpdatedAt+" IN (?)", vs)
+}
+
+// UpdatedAtIsNull adds the condition updated_at IS NULL
+func (q *CreditCardORMQueryBuilder) UpdatedAtIsNull() *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUpdatedAt + " IS NULL")
+}
+
+// UpdatedAtIsNotNull adds the condition updated_at IS NOT NULL
+func (q *CreditCardORMQueryBuilder) UpdatedAtIsNotNull() *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUpdatedAt + " IS NOT NULL")
 }
-type CreditCardORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+
+// OrderByUpdatedAt adds the order by updated_at, descending when desc
+func (q *CreditCardORMQueryBuilder) OrderByUpdatedAt(desc bool) *CreditCardORMQueryBuilder {
+	order := CreditCardORMColumnUpdatedAt
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
 }
-type CreditCardORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+
+// UserIdEq adds the condition user_id = v
+func (q *CreditCardORMQueryBuilder) UserIdEq(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUserId+" = ?", v)
 }
 
-// DefaultPatchCreditCard executes a basic gorm update call with patch behavior
-func DefaultPatchCreditCard(ctx context.Context, in *CreditCard, updateMask *field_mask.FieldMask, db *gorm.DB) (*CreditCard, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	var pbObj CreditCard
-	var err error
-	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	pbReadRes, err := DefaultReadCreditCard(ctx, &CreditCard{Id: in.GetId()}, db)
-	if err != nil {
-		return nil, err
-	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	if _, err := DefaultApplyFieldMaskCreditCard(ctx, &pbObj, in, updateMask, "", db); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := DefaultStrictUpdateCreditCard(ctx, &pbObj, db)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(pbResponse).(CreditCardWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	return pbResponse, nil
+// UserIdNe adds the condition user_id <> v
+func (q *CreditCardORMQueryBuilder) UserIdNe(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUserId+" <> ?", v)
 }
 
-type CreditCardWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+// UserIdGt adds the condition user_id > v
+func (q *CreditCardORMQueryBuilder) UserIdGt(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUserId+" > ?", v)
 }
-type CreditCardWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+
+// UserIdGe adds the condition user_id >= v
+func (q *CreditCardORMQueryBuilder) UserIdGe(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUserId+" >= ?", v)
 }
-type CreditCardWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+
+// UserIdLt adds the condition user_id < v
+func (q *CreditCardORMQueryBuilder) UserIdLt(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUserId+" < ?", v)
 }
-type CreditCardWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) error
+
+// UserIdLe adds the condition user_id <= v
+func (q *CreditCardORMQueryBuilder) UserIdLe(v string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUserId+" <= ?", v)
 }
 
-// DefaultPatchSetCreditCard executes a bulk gorm update call with patch behavior
-func DefaultPatchSetCreditCard(ctx context.Context, objects []*CreditCard, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*CreditCard, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
-	}
+// UserIdIn adds the condition user_id IN (vs)
+func (q *CreditCardORMQueryBuilder) UserIdIn(vs ...string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUserId+" IN (?)", vs)
+}
 
-	results := make([]*CreditCard, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchCreditCard(ctx, patcher, updateMasks[i], db)
-		if err != nil {
-			return nil, err
-		}
+// UserIdLike adds the condition user_id LIKE pattern
+func (q *CreditCardORMQueryBuilder) UserIdLike(pattern string) *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUserId+" LIKE ?", pattern)
+}
 
-		results = append(results, pbResponse)
-	}
+// UserIdIsNull adds the condition user_id IS NULL
+func (q *CreditCardORMQueryBuilder) UserIdIsNull() *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUserId + " IS NULL")
+}
 
-	return results, nil
+// UserIdIsNotNull adds the condition user_id IS NOT NULL
+func (q *CreditCardORMQueryBuilder) UserIdIsNotNull() *CreditCardORMQueryBuilder {
+	return q.where(CreditCardORMColumnUserId + " IS NOT NULL")
 }
 
-// DefaultApplyFieldMaskCreditCard patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, patcher *CreditCard, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*CreditCard, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
-	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"CreatedAt" {
-			patchee.CreatedAt = patcher.CreatedAt
-			continue
-		}
-		if f == prefix+"UpdatedAt" {
-			patchee.UpdatedAt = patcher.UpdatedAt
-			continue
-		}
-		if f == prefix+"Number" {
-			patchee.Number = patcher.Number
-			continue
-		}
-		if f == prefix+"UserId" {
-			patchee.UserId = patcher.UserId
-			continue
-		}
-	}
-	if err != nil {
-		return nil, err
+// OrderByUserId adds the order by user_id, descending when desc
+func (q *CreditCardORMQueryBuilder) OrderByUserId(desc bool) *CreditCardORMQueryBuilder {
+	order := CreditCardORMColumnUserId
+	if desc {
+		order += " DESC"
 	}
-	return patchee, nil
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
 }
 
 // DefaultListCreditCard executes a gorm list call
@@ -2792,7 +6064,7 @@ func DefaultListCreditCard(ctx context.Context, db *gorm.DB) ([]*CreditCard, err
 	db = db.Order("id")
 	ormResponse := []CreditCardORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -2820,6 +6092,24 @@ type CreditCardORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]CreditCardORM) error
 }
 
+// withTaskTransaction runs fn in a new transaction of db, so that the
+// changes of TaskORM are committed together. It reports false
+// without running fn when db is a transaction already.
+func withTaskTransaction(db *gorm.DB, fn func(*gorm.DB) error) (bool, error) {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return false, nil
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+	return true, tx.Commit().Error
+}
+
 // DefaultCreateTask executes a basic gorm create call
 func DefaultCreateTask(ctx context.Context, in *Task, db *gorm.DB) (*Task, error) {
 	if in == nil {
@@ -2835,7 +6125,7 @@ func DefaultCreateTask(ctx context.Context, in *Task, db *gorm.DB) (*Task, error
 		}
 	}
 	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
@@ -2853,6 +6143,71 @@ type TaskORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateTaskSet executes a bulk gorm create call in a transaction,
+// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize
+// is kept for compatibility with gorm v2
+func DefaultCreateTaskSet(ctx context.Context, in []*Task, batchSize int, db *gorm.DB) ([]*Task, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var out []*Task
+	if ok, err := withTaskTransaction(db, func(tx *gorm.DB) (err error) {
+		out, err = DefaultCreateTaskSet(ctx, in, batchSize, tx)
+		return err
+	}); ok {
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	ormObjs := make([]*TaskORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	var err error
+	if hook, ok := interface{}(&TaskORM{}).(TaskORMWithBeforeCreateSet); ok {
+		if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	for _, ormObj := range ormObjs {
+		if err = db.Create(ormObj).Error; err != nil {
+			return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
+		}
+	}
+	if hook, ok := interface{}(&TaskORM{}).(TaskORMWithAfterCreateSet); ok {
+		if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := make([]*Task, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+// TaskORMWithBeforeCreateSet called before DefaultCreateTaskSet inserts the objects
+type TaskORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TaskORM, *gorm.DB) (*gorm.DB, error)
+}
+
+// TaskORMWithAfterCreateSet called after DefaultCreateTaskSet inserts the objects
+type TaskORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TaskORM, *gorm.DB) error
+}
+
 // DefaultApplyFieldMaskTask patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskTask(ctx context.Context, patchee *Task, patcher *Task, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Task, error) {
 	if patcher == nil {
@@ -2881,6 +6236,294 @@ func DefaultApplyFieldMaskTask(ctx context.Context, patchee *Task, patcher *Task
 	return patchee, nil
 }
 
+// The columns of TaskORM
+const (
+	TaskORMColumnAccountID   = "account_id"
+	TaskORMColumnDescription = "description"
+	TaskORMColumnName        = "name"
+	TaskORMColumnPriority    = "priority"
+	TaskORMColumnUserId      = "user_id"
+)
+
+// TaskORMQueryBuilder builds a query of TaskORM from typed conditions, orders and
+// preloads, applied to a *gorm.DB with Apply. The builder is immutable.
+type TaskORMQueryBuilder struct {
+	scopes []func(*gorm.DB) *gorm.DB
+}
+
+// TaskORMQuery returns an empty query builder of TaskORM
+func TaskORMQuery() *TaskORMQueryBuilder {
+	return &TaskORMQueryBuilder{}
+}
+
+func (q *TaskORMQueryBuilder) scope(scope func(*gorm.DB) *gorm.DB) *TaskORMQueryBuilder {
+	return &TaskORMQueryBuilder{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}
+}
+
+func (q *TaskORMQueryBuilder) where(query string, args ...interface{}) *TaskORMQueryBuilder {
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Where(query, args...)
+	})
+}
+
+// Apply returns db with the conditions, orders and preloads of q
+func (q *TaskORMQueryBuilder) Apply(db *gorm.DB) *gorm.DB {
+	for _, scope := range q.scopes {
+		db = scope(db)
+	}
+	return db
+}
+
+// AccountIDEq adds the condition account_id = v
+func (q *TaskORMQueryBuilder) AccountIDEq(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnAccountID+" = ?", v)
+}
+
+// AccountIDNe adds the condition account_id <> v
+func (q *TaskORMQueryBuilder) AccountIDNe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnAccountID+" <> ?", v)
+}
+
+// AccountIDGt adds the condition account_id > v
+func (q *TaskORMQueryBuilder) AccountIDGt(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnAccountID+" > ?", v)
+}
+
+// AccountIDGe adds the condition account_id >= v
+func (q *TaskORMQueryBuilder) AccountIDGe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnAccountID+" >= ?", v)
+}
+
+// AccountIDLt adds the condition account_id < v
+func (q *TaskORMQueryBuilder) AccountIDLt(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnAccountID+" < ?", v)
+}
+
+// AccountIDLe adds the condition account_id <= v
+func (q *TaskORMQueryBuilder) AccountIDLe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnAccountID+" <= ?", v)
+}
+
+// AccountIDIn adds the condition account_id IN (vs)
+func (q *TaskORMQueryBuilder) AccountIDIn(vs ...string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnAccountID+" IN (?)", vs)
+}
+
+// AccountIDLike adds the condition account_id LIKE pattern
+func (q *TaskORMQueryBuilder) AccountIDLike(pattern string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnAccountID+" LIKE ?", pattern)
+}
+
+// OrderByAccountID adds the order by account_id, descending when desc
+func (q *TaskORMQueryBuilder) OrderByAccountID(desc bool) *TaskORMQueryBuilder {
+	order := TaskORMColumnAccountID
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// DescriptionEq adds the condition description = v
+func (q *TaskORMQueryBuilder) DescriptionEq(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDescription+" = ?", v)
+}
+
+// DescriptionNe adds the condition description <> v
+func (q *TaskORMQueryBuilder) DescriptionNe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDescription+" <> ?", v)
+}
+
+// DescriptionGt adds the condition description > v
+func (q *TaskORMQueryBuilder) DescriptionGt(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDescription+" > ?", v)
+}
+
+// DescriptionGe adds the condition description >= v
+func (q *TaskORMQueryBuilder) DescriptionGe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDescription+" >= ?", v)
+}
+
+// DescriptionLt adds the condition description < v
+func (q *TaskORMQueryBuilder) DescriptionLt(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDescription+" < ?", v)
+}
+
+// DescriptionLe adds the condition description <= v
+func (q *TaskORMQueryBuilder) DescriptionLe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDescription+" <= ?", v)
+}
+
+// DescriptionIn adds the condition description IN (vs)
+func (q *TaskORMQueryBuilder) DescriptionIn(vs ...string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDescription+" IN (?)", vs)
+}
+
+// DescriptionLike adds the condition description LIKE pattern
+func (q *TaskORMQueryBuilder) DescriptionLike(pattern string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnDescription+" LIKE ?", pattern)
+}
+
+// OrderByDescription adds the order by description, descending when desc
+func (q *TaskORMQueryBuilder) OrderByDescription(desc bool) *TaskORMQueryBuilder {
+	order := TaskORMColumnDescription
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// NameEq adds the condition name = v
+func (q *TaskORMQueryBuilder) NameEq(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnName+" = ?", v)
+}
+
+// NameNe adds the condition name <> v
+func (q *TaskORMQueryBuilder) NameNe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnName+" <> ?", v)
+}
+
+// NameGt adds the condition name > v
+func (q *TaskORMQueryBuilder) NameGt(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnName+" > ?", v)
+}
+
+// NameGe adds the condition name >= v
+func (q *TaskORMQueryBuilder) NameGe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnName+" >= ?", v)
+}
+
+// NameLt adds the condition name < v
+func (q *TaskORMQueryBuilder) NameLt(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnName+" < ?", v)
+}
+
+// NameLe adds the condition name <= v
+func (q *TaskORMQueryBuilder) NameLe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnName+" <= ?", v)
+}
+
+// NameIn adds the condition name IN (vs)
+func (q *TaskORMQueryBuilder) NameIn(vs ...string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnName+" IN (?)", vs)
+}
+
+// NameLike adds the condition name LIKE pattern
+func (q *TaskORMQueryBuilder) NameLike(pattern string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnName+" LIKE ?", pattern)
+}
+
+// OrderByName adds the order by name, descending when desc
+func (q *TaskORMQueryBuilder) OrderByName(desc bool) *TaskORMQueryBuilder {
+	order := TaskORMColumnName
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// PriorityEq adds the condition priority = v
+func (q *TaskORMQueryBuilder) PriorityEq(v int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnPriority+" = ?", v)
+}
+
+// PriorityNe adds the condition priority <> v
+func (q *TaskORMQueryBuilder) PriorityNe(v int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnPriority+" <> ?", v)
+}
+
+// PriorityGt adds the condition priority > v
+func (q *TaskORMQueryBuilder) PriorityGt(v int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnPriority+" > ?", v)
+}
+
+// PriorityGe adds the condition priority >= v
+func (q *TaskORMQueryBuilder) PriorityGe(v int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnPriority+" >= ?", v)
+}
+
+// PriorityLt adds the condition priority < v
+func (q *TaskORMQueryBuilder) PriorityLt(v int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnPriority+" < ?", v)
+}
+
+// PriorityLe adds the condition priority <= v
+func (q *TaskORMQueryBuilder) PriorityLe(v int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnPriority+" <= ?", v)
+}
+
+// PriorityIn adds the condition priority IN (vs)
+func (q *TaskORMQueryBuilder) PriorityIn(vs ...int64) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnPriority+" IN (?)", vs)
+}
+
+// OrderByPriority adds the order by priority, descending when desc
+func (q *TaskORMQueryBuilder) OrderByPriority(desc bool) *TaskORMQueryBuilder {
+	order := TaskORMColumnPriority
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
+// UserIdEq adds the condition user_id = v
+func (q *TaskORMQueryBuilder) UserIdEq(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnUserId+" = ?", v)
+}
+
+// UserIdNe adds the condition user_id <> v
+func (q *TaskORMQueryBuilder) UserIdNe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnUserId+" <> ?", v)
+}
+
+// UserIdGt adds the condition user_id > v
+func (q *TaskORMQueryBuilder) UserIdGt(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnUserId+" > ?", v)
+}
+
+// UserIdGe adds the condition user_id >= v
+func (q *TaskORMQueryBuilder) UserIdGe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnUserId+" >= ?", v)
+}
+
+// UserIdLt adds the condition user_id < v
+func (q *TaskORMQueryBuilder) UserIdLt(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnUserId+" < ?", v)
+}
+
+// UserIdLe adds the condition user_id <= v
+func (q *TaskORMQueryBuilder) UserIdLe(v string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnUserId+" <= ?", v)
+}
+
+// UserIdIn adds the condition user_id IN (vs)
+func (q *TaskORMQueryBuilder) UserIdIn(vs ...string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnUserId+" IN (?)", vs)
+}
+
+// UserIdLike adds the condition user_id LIKE pattern
+func (q *TaskORMQueryBuilder) UserIdLike(pattern string) *TaskORMQueryBuilder {
+	return q.where(TaskORMColumnUserId+" LIKE ?", pattern)
+}
+
+// OrderByUserId adds the order by user_id, descending when desc
+func (q *TaskORMQueryBuilder) OrderByUserId(desc bool) *TaskORMQueryBuilder {
+	order := TaskORMColumnUserId
+	if desc {
+		order += " DESC"
+	}
+	return q.scope(func(db *gorm.DB) *gorm.DB {
+		return db.Order(order)
+	})
+}
+
 // DefaultListTask executes a gorm list call
 func DefaultListTask(ctx context.Context, db *gorm.DB) ([]*Task, error) {
 	in := Task{}
@@ -2905,7 +6548,7 @@ func DefaultListTask(ctx context.Context, db *gorm.DB) ([]*Task, error) {
 	db = db.Where(&ormObj)
 	ormResponse := []TaskORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+		return nil, errors.FromDB(err, gorm.ErrRecordNotFound)
 	}
 	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
@@ -15,7 +15,7 @@ require (
 	github.com/infobloxopen/atlas-app-toolkit v0.20.0
 	github.com/jinzhu/gorm v1.9.2
 	github.com/jinzhu/inflection v1.0.0
-	github.com/jinzhu/now v1.0.1 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
 	github.com/konsorten/go-windows-terminal-sequences v1.0.2 // indirect
 	github.com/lib/pq v1.9.0
 	github.com/mattn/go-sqlite3 v2.0.1+incompatible // indirect
@@ -25,6 +25,7 @@ require (
 	google.golang.org/genproto v0.0.0-20200527145253-8367513e4ece
 	google.golang.org/grpc v1.33.2
 	google.golang.org/protobuf v1.25.0
+	gorm.io/gorm v1.25.12
 )
 
 go 1.13
@@ -0,0 +1,26 @@
+// Package identity carries the identity of the caller, which the generated
+// handlers record in the CreatedBy and UpdatedBy audit columns.
+package identity
+
+import (
+	"context"
+
+	"github.com/edhaight/protoc-gen-gorm/errors"
+)
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx holding the identity id.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the identity held by ctx, or the MissingIdentityError.
+// It is the default identity function of the generated handlers, another one
+// with the same signature is set with the identity plugin parameter.
+func FromContext(ctx context.Context) (string, error) {
+	if id, ok := ctx.Value(contextKey{}).(string); ok && id != "" {
+		return id, nil
+	}
+	return "", errors.MissingIdentityError
+}
@@ -0,0 +1,34 @@
+package identity
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFromContext(t *testing.T) {
+	cases := []struct {
+		name        string
+		ctx         context.Context
+		id          string
+		expectError bool
+	}{
+		{"identity set", NewContext(context.Background(), "alice"), "alice", false},
+		{"empty identity", NewContext(context.Background(), ""), "", true},
+		{"no identity", context.Background(), "", true},
+	}
+
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			id, err := FromContext(v.ctx)
+			if err != nil && !v.expectError {
+				t.Errorf("Got unexpected error: %s", err)
+			}
+			if v.expectError && err == nil {
+				t.Errorf("Expected error but didn't get any")
+			}
+			if id != v.id {
+				t.Errorf("Expected value: %s, got %s", v.id, id)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Package keyset implements the page tokens of the keyset paginated List
+// handlers. A token holds the sort keys of the last row of a page, the next
+// page holds the rows following it in the sort order.
+package keyset
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/edhaight/protoc-gen-gorm/errors"
+)
+
+// Key is a sort key of a paginated list, the primary key is the last one so
+// that the order is stable.
+type Key struct {
+	// Column is the column of the key
+	Column string
+	// Desc reports whether the rows are sorted by descending Column
+	Desc bool
+	// Value points to the field of the row holding the key
+	Value interface{}
+}
+
+type tokenKey struct {
+	Column string          `json:"c"`
+	Desc   bool            `json:"d,omitempty"`
+	Value  json.RawMessage `json:"v"`
+}
+
+// Encode returns the page token of the row pointed by the values of keys.
+func Encode(keys []Key) (string, error) {
+	tokenKeys := make([]tokenKey, len(keys))
+	for i, key := range keys {
+		value, err := json.Marshal(key.Value)
+		if err != nil {
+			return "", err
+		}
+		tokenKeys[i] = tokenKey{Column: key.Column, Desc: key.Desc, Value: value}
+	}
+	data, err := json.Marshal(tokenKeys)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Decode stores the sort keys held by token in the values of keys. It returns
+// an InvalidArgumentError when token is malformed or was returned for another
+// sort order.
+func Decode(token string, keys []Key) error {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return errors.InvalidArgument(fmt.Errorf("invalid page token: %w", err))
+	}
+	var tokenKeys []tokenKey
+	if err := json.Unmarshal(data, &tokenKeys); err != nil {
+		return errors.InvalidArgument(fmt.Errorf("invalid page token: %w", err))
+	}
+	if len(tokenKeys) != len(keys) {
+		return errors.InvalidArgument(fmt.Errorf("page token doesn't match the sort order"))
+	}
+	for i, key := range keys {
+		if tokenKeys[i].Column != key.Column || tokenKeys[i].Desc != key.Desc {
+			return errors.InvalidArgument(fmt.Errorf("page token doesn't match the sort order"))
+		}
+		if err := json.Unmarshal(tokenKeys[i].Value, key.Value); err != nil {
+			return errors.InvalidArgument(fmt.Errorf("invalid page token: %w", err))
+		}
+	}
+	return nil
+}
+
+// Nullable reports whether value points to a pointer field, whose NULL values
+// can't be compared with the sort keys of a page token.
+func Nullable(value interface{}) bool {
+	return reflect.TypeOf(value).Elem().Kind() == reflect.Ptr
+}
+
+// Where returns the condition, and its arguments, selecting the rows following
+// the one pointed by the values of keys. The condition compares row values,
+// (a, b) > (?, ?), when the keys have the same direction, and is the one of
+// ExpandedWhere otherwise. The keys must not be Nullable.
+func Where(keys []Key) (string, []interface{}) {
+	for _, key := range keys {
+		if key.Desc != keys[0].Desc {
+			return ExpandedWhere(keys)
+		}
+	}
+	columns := make([]string, len(keys))
+	values := make([]interface{}, len(keys))
+	for i, key := range keys {
+		columns[i] = key.Column
+		values[i] = reflect.ValueOf(key.Value).Elem().Interface()
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(keys)), ", ")
+	return fmt.Sprintf("(%s) %s (%s)", strings.Join(columns, ", "), operator(keys[0]), placeholders), values
+}
+
+// ExpandedWhere returns the condition of Where without row values, a > ? OR
+// a = ? AND b > ?, for the databases which can't compare them, e.g. SQL Server.
+func ExpandedWhere(keys []Key) (string, []interface{}) {
+	var (
+		terms []string
+		args  []interface{}
+	)
+	for i, key := range keys {
+		var term []string
+		for _, previous := range keys[:i] {
+			term = append(term, previous.Column+" = ?")
+			args = append(args, reflect.ValueOf(previous.Value).Elem().Interface())
+		}
+		term = append(term, fmt.Sprintf("%s %s ?", key.Column, operator(key)))
+		terms = append(terms, "("+strings.Join(term, " AND ")+")")
+		args = append(args, reflect.ValueOf(key.Value).Elem().Interface())
+	}
+	return strings.Join(terms, " OR "), args
+}
+
+func operator(key Key) string {
+	if key.Desc {
+		return "<"
+	}
+	return ">"
+}
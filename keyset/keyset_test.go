@@ -0,0 +1,123 @@
+package keyset
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	gerrors "github.com/edhaight/protoc-gen-gorm/errors"
+)
+
+type row struct {
+	Name string
+	Id   uint64
+}
+
+func keysOf(r *row, desc bool) []Key {
+	return []Key{{Column: "name", Desc: desc, Value: &r.Name}, {Column: "id", Value: &r.Id}}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	last := &row{Name: "b", Id: 42}
+	token, err := Encode(keysOf(last, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := &row{}
+	if err := Decode(token, keysOf(next, false)); err != nil {
+		t.Fatal(err)
+	}
+	if *next != *last {
+		t.Errorf("Expected %+v, got %+v", *last, *next)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	token, err := Encode(keysOf(&row{Name: "b", Id: 42}, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name  string
+		token string
+		keys  []Key
+	}{
+		{"malformed", "not a token!", keysOf(&row{}, false)},
+		{"other direction", token, keysOf(&row{}, true)},
+		{"other keys", token, []Key{{Column: "id", Value: new(uint64)}}},
+		{"other type", token, []Key{{Column: "name", Value: new(int)}, {Column: "id", Value: new(uint64)}}},
+	}
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			var target *gerrors.InvalidArgumentError
+			if err := Decode(v.token, v.keys); !errors.As(err, &target) {
+				t.Errorf("Expected an InvalidArgumentError, got %#v", err)
+			}
+		})
+	}
+}
+
+func TestWhere(t *testing.T) {
+	r := &row{Name: "b", Id: 42}
+	cases := []struct {
+		name     string
+		keys     []Key
+		expected string
+		args     []interface{}
+	}{
+		{"primary key", []Key{{Column: "id", Value: &r.Id}}, "(id) > (?)", []interface{}{uint64(42)}},
+		{"ascending", keysOf(r, false), "(name, id) > (?, ?)", []interface{}{"b", uint64(42)}},
+		{"descending", []Key{{Column: "name", Desc: true, Value: &r.Name}, {Column: "id", Desc: true, Value: &r.Id}},
+			"(name, id) < (?, ?)", []interface{}{"b", uint64(42)}},
+		{"mixed", keysOf(r, true), "(name < ?) OR (name = ? AND id > ?)", []interface{}{"b", "b", uint64(42)}},
+	}
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			where, args := Where(v.keys)
+			if where != v.expected {
+				t.Errorf("Expected %q, got %q", v.expected, where)
+			}
+			if !reflect.DeepEqual(args, v.args) {
+				t.Errorf("Expected %v, got %v", v.args, args)
+			}
+		})
+	}
+}
+
+func TestExpandedWhere(t *testing.T) {
+	r := &row{Name: "b", Id: 42}
+	cases := []struct {
+		name     string
+		keys     []Key
+		expected string
+		args     []interface{}
+	}{
+		{"primary key", []Key{{Column: "id", Value: &r.Id}}, "(id > ?)", []interface{}{uint64(42)}},
+		{"ascending", keysOf(r, false), "(name > ?) OR (name = ? AND id > ?)", []interface{}{"b", "b", uint64(42)}},
+		{"mixed", keysOf(r, true), "(name < ?) OR (name = ? AND id > ?)", []interface{}{"b", "b", uint64(42)}},
+	}
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			where, args := ExpandedWhere(v.keys)
+			if where != v.expected {
+				t.Errorf("Expected %q, got %q", v.expected, where)
+			}
+			if !reflect.DeepEqual(args, v.args) {
+				t.Errorf("Expected %v, got %v", v.args, args)
+			}
+		})
+	}
+}
+
+func TestNullable(t *testing.T) {
+	var v struct {
+		Name *string
+		Id   uint64
+	}
+	if !Nullable(&v.Name) {
+		t.Error("Expected a pointer field to be nullable")
+	}
+	if Nullable(&v.Id) {
+		t.Error("Expected a value field not to be nullable")
+	}
+}
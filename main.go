@@ -1,31 +1,24 @@
 package main
 
 import (
-	"flag"
-
 	myplugin "github.com/edhaight/protoc-gen-gorm/plugin"
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
 func main() {
-	// flagSet initialization
-	var flags flag.FlagSet
-	// flag definitions
-	quiet := flags.Bool("quiet", false, "Suppresses warnings if true.")
-	stringEnums := flags.Bool("enums", false, "Use string representation of protobuf enums instead of integer value if true.")
-	gateway := flags.Bool("gateway", false, "Generates gateway if true.")
-	// protogen options, passing flagset callback.
+	// generator options, filled in from the --gorm_out parameter string.
+	config := myplugin.NewConfig()
+	// protogen options, passing config callback.
 	opts := &protogen.Options{
-		ParamFunc: flags.Set,
+		ParamFunc: config.Set,
 	}
 	// pass plugin callback to options run func. initialize
 	// internal plugin & generate.
 	opts.Run(func(p *protogen.Plugin) error {
-		plugin := &myplugin.OrmPlugin{
-			SuppressWarnings: *quiet,
-			StringEnums:      *stringEnums,
-			Gateway:          *gateway,
+		if err := config.Validate(); err != nil {
+			return err
 		}
+		plugin := &myplugin.OrmPlugin{Config: config}
 		plugin.Init(p)
 		plugin.Generate()
 		return nil
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// update rewrites the committed outputs of the examples instead of comparing them.
+var update = flag.Bool("update", false, "rewrite the committed outputs of the examples")
+
+// targets enables TestExampleTargets, which needs protoc, protoc-gen-go and
+// the network to download the gorm modules.
+var targets = flag.Bool("targets", false, "build all the examples for both orm targets")
+
+const projectRoot = "github.com/edhaight/protoc-gen-gorm"
+
+// examples lists the protoc runs of the examples, with the parameters of
+// their Makefiles. The outputs of the committed ones are kept in the tree.
+var examples = []struct {
+	param     string
+	protos    []string
+	committed bool
+}{
+	{"", []string{
+		"example/feature_demo/demo_multi_file.proto",
+		"example/feature_demo/demo_types.proto",
+		"example/feature_demo/demo_service.proto",
+		"example/feature_demo/demo_multi_file_service.proto",
+	}, true},
+	{"", []string{"example/user/user.proto"}, true},
+	{"engine=postgres", []string{"example/type_mappings/type_mappings.proto"}, true},
+	{"orm=gormv2", []string{"example/gormv2/gormv2.proto"}, true},
+	{"engine=postgres", []string{"example/aip/aip.proto"}, false},
+	{"engine=postgres", []string{"example/audit/audit.proto"}, false},
+	{"engine=postgres", []string{"example/composite/composite.proto"}, false},
+	{"engine=postgres,ddl", []string{"example/ddl/ddl.proto"}, false},
+	{"engine=postgres", []string{"example/history/history.proto"}, false},
+	{"engine=postgres", []string{"example/keyset/keyset.proto"}, false},
+	{"engine=postgres", []string{"example/maps/maps.proto"}, false},
+	{"engine=postgres", []string{"example/oneofs/oneofs.proto"}, false},
+	{"engine=postgres", []string{"example/postgres_arrays/postgres_arrays.proto"}, false},
+	{"engine=postgres", []string{"example/query/query.proto"}, false},
+	{"engine=postgres", []string{"example/serialized/serialized.proto"}, false},
+	{"engine=postgres", []string{"example/soft_delete/soft_delete.proto"}, false},
+	{"engine=postgres", []string{"example/upsert/upsert.proto"}, false},
+	{"engine=postgres", []string{"example/versioned/versioned.proto"}, false},
+	{"engine=postgres", []string{"example/well_known/well_known.proto"}, false},
+}
+
+// TestExampleOutputs regenerates the committed outputs of the examples and
+// compares them with the tree, run with -update to rewrite them.
+func TestExampleOutputs(t *testing.T) {
+	plugin, dir := setupGenerator(t)
+	defer os.RemoveAll(dir)
+
+	for _, v := range examples {
+		if !v.committed {
+			continue
+		}
+		out := filepath.Join(dir, "out")
+		generate(t, plugin, out, v.param, v.protos, false)
+		for _, proto := range v.protos {
+			file := strings.TrimSuffix(proto, ".proto") + ".pb.gorm.go"
+			generated, err := ioutil.ReadFile(filepath.Join(out, projectRoot, file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *update {
+				if err := ioutil.WriteFile(file, generated, 0644); err != nil {
+					t.Fatal(err)
+				}
+				continue
+			}
+			committed, err := ioutil.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(generated, committed) {
+				t.Errorf("%s is stale, regenerate it with go test -run TestExampleOutputs -update", file)
+			}
+		}
+	}
+}
+
+// TestExampleTargets generates all the examples for each orm target and
+// builds them, along with the packages of the project, in a module of their
+// own. It only runs with -targets, and then fails when a tool is missing.
+func TestExampleTargets(t *testing.T) {
+	if !*targets {
+		t.Skip("run with -targets to build the examples for both orm targets")
+	}
+	for _, tool := range []string{"protoc", "protoc-gen-go"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			t.Fatalf("%s is not installed", tool)
+		}
+	}
+	plugin, dir := setupGenerator(t)
+	defer os.RemoveAll(dir)
+
+	for _, target := range []string{"jinzhu", "gormv2"} {
+		t.Run(target, func(t *testing.T) {
+			module := filepath.Join(dir, target)
+			if err := copyProject(module); err != nil {
+				t.Fatal(err)
+			}
+			out := filepath.Join(dir, target+"_out")
+			for _, v := range examples {
+				param := "orm=" + target
+				if strings.Contains(v.param, "orm=") {
+					// the example pins its target
+					param = v.param
+				} else if v.param != "" {
+					param = v.param + "," + param
+				}
+				generate(t, plugin, out, param, v.protos, true)
+			}
+			if err := copyTree(filepath.Join(out, projectRoot, "example"), filepath.Join(module, "example"), isGoFile); err != nil {
+				t.Fatal(err)
+			}
+			for _, args := range [][]string{{"mod", "tidy"}, {"build", "./..."}} {
+				cmd := exec.Command("go", args...)
+				cmd.Dir = module
+				if output, err := cmd.CombinedOutput(); err != nil {
+					t.Fatalf("go %s: %v\n%s", strings.Join(args, " "), err, output)
+				}
+			}
+		})
+	}
+}
+
+// setupGenerator builds the plugin in a temporary directory, which also
+// holds the project under its import path for the imports of the protos.
+func setupGenerator(t *testing.T) (string, string) {
+	if _, err := exec.LookPath("protoc"); err != nil {
+		t.Skip("protoc is not installed")
+	}
+	dir, err := ioutil.TempDir("", "protoc-gen-gorm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(dir, "src", projectRoot)
+	if err := os.MkdirAll(filepath.Dir(link), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(root, link); err != nil {
+		t.Fatal(err)
+	}
+	plugin := filepath.Join(dir, "protoc-gen-gorm")
+	if output, err := exec.Command("go", "build", "-o", plugin, ".").CombinedOutput(); err != nil {
+		t.Fatalf("go build: %v\n%s", err, output)
+	}
+	return plugin, dir
+}
+
+// generate runs protoc with the plugin over protos, with the include paths
+// of the Makefile, and with protoc-gen-go as well when withGo is set.
+func generate(t *testing.T, plugin, out, param string, protos []string, withGo bool) {
+	if err := os.MkdirAll(out, 0755); err != nil {
+		t.Fatal(err)
+	}
+	args := []string{
+		"-I.",
+		"-I" + filepath.Join(filepath.Dir(plugin), "src"),
+		"-I" + filepath.Join(build.Default.GOPATH, "src"),
+		"-Ivendor",
+		"--plugin=protoc-gen-gorm=" + plugin,
+		"--gorm_out=" + param + ":" + out,
+	}
+	if withGo {
+		args = append(args, "--go_out="+out)
+	}
+	cmd := exec.Command("protoc", append(args, protos...)...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("protoc %s: %v\n%s", strings.Join(protos, " "), err, output)
+	}
+}
+
+// copyProject copies the go.mod and the Go sources of the project but the
+// examples into dir.
+func copyProject(dir string) error {
+	for _, file := range []string{"go.mod", "go.sum"} {
+		if err := copyFile(file, filepath.Join(dir, file)); err != nil {
+			return err
+		}
+	}
+	entries, err := ioutil.ReadDir(".")
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		switch name := entry.Name(); {
+		case !entry.IsDir():
+			if isGoFile(name) {
+				if err := copyFile(name, filepath.Join(dir, name)); err != nil {
+					return err
+				}
+			}
+		case name == "example":
+			// the hand written files of the examples
+			err = copyTree(name, filepath.Join(dir, name), func(name string) bool {
+				return isGoFile(name) && !strings.HasSuffix(name, ".pb.go") && !strings.HasSuffix(name, ".pb.gorm.go")
+			})
+		case name != "vendor" && name != "backup" && !strings.HasPrefix(name, "."):
+			err = copyTree(name, filepath.Join(dir, name), isGoFile)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyTree copies the files of the src directory tree selected by keep into
+// the dst one.
+func copyTree(src, dst string, keep func(string) bool) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !keep(info.Name()) {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		return copyFile(path, filepath.Join(dst, rel))
+	})
+}
+
+func copyFile(src, dst string) error {
+	data, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(dst, data, 0644)
+}
+
+func isGoFile(name string) bool {
+	return strings.HasSuffix(name, ".go") && !strings.HasSuffix(name, "_test.go")
+}
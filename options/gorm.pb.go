@@ -21,10 +21,75 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Serializer int32
+
+const (
+	Serializer_SERIALIZER_UNSPECIFIED Serializer = 0
+	// protojson encoded, in a jsonb column
+	Serializer_SERIALIZER_JSON Serializer = 1
+	// binary proto encoded, in a bytea column
+	Serializer_SERIALIZER_PROTO Serializer = 2
+)
+
+// Enum value maps for Serializer.
+var (
+	Serializer_name = map[int32]string{
+		0: "SERIALIZER_UNSPECIFIED",
+		1: "SERIALIZER_JSON",
+		2: "SERIALIZER_PROTO",
+	}
+	Serializer_value = map[string]int32{
+		"SERIALIZER_UNSPECIFIED": 0,
+		"SERIALIZER_JSON":        1,
+		"SERIALIZER_PROTO":       2,
+	}
+)
+
+func (x Serializer) Enum() *Serializer {
+	p := new(Serializer)
+	*p = x
+	return p
+}
+
+func (x Serializer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Serializer) Descriptor() protoreflect.EnumDescriptor {
+	return file_options_gorm_proto_enumTypes[0].Descriptor()
+}
+
+func (Serializer) Type() protoreflect.EnumType {
+	return &file_options_gorm_proto_enumTypes[0]
+}
+
+func (x Serializer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Serializer) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Serializer(num)
+	return nil
+}
+
+// Deprecated: Use Serializer.Descriptor instead.
+func (Serializer) EnumDescriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{0}
+}
+
 type GormFileOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// type_mapping declares how fields of a message type are stored, for all
+	// the files of the protoc invocation
+	TypeMapping []*TypeMapping `protobuf:"bytes,1,rep,name=type_mapping,json=typeMapping" json:"type_mapping,omitempty"`
 }
 
 func (x *GormFileOptions) Reset() {
@@ -59,6 +124,113 @@ func (*GormFileOptions) Descriptor() ([]byte, []int) {
 	return file_options_gorm_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GormFileOptions) GetTypeMapping() []*TypeMapping {
+	if x != nil {
+		return x.TypeMapping
+	}
+	return nil
+}
+
+// TypeMapping stores a message type in a single column of a custom Go type,
+// converted with user provided functions:
+//
+//	func {to_orm}(*Message) ({go_type}, error)
+//	func {to_pb}({go_type}) (*Message, error)
+//
+// to_orm is only called for non nil messages and to_pb for non nil values
+// when go_type is a pointer, slice or map.
+type TypeMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// full name of the message type, e.g. google.type.Money, defaults to the
+	// type of the field in field level mappings
+	Message *string `protobuf:"bytes,1,opt,name=message" json:"message,omitempty"`
+	// Go type of the column, e.g. "*Money" or "int64"
+	GoType *string `protobuf:"bytes,2,req,name=go_type,json=goType" json:"go_type,omitempty"`
+	// import path of go_type and of the conversion functions
+	Package *string `protobuf:"bytes,3,opt,name=package" json:"package,omitempty"`
+	// sql type of the column, e.g. "numeric"
+	SqlType *string `protobuf:"bytes,4,opt,name=sql_type,json=sqlType" json:"sql_type,omitempty"`
+	ToOrm   *string `protobuf:"bytes,5,req,name=to_orm,json=toOrm" json:"to_orm,omitempty"`
+	ToPb    *string `protobuf:"bytes,6,req,name=to_pb,json=toPb" json:"to_pb,omitempty"`
+}
+
+func (x *TypeMapping) Reset() {
+	*x = TypeMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_options_gorm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeMapping) ProtoMessage() {}
+
+func (x *TypeMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_options_gorm_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeMapping.ProtoReflect.Descriptor instead.
+func (*TypeMapping) Descriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TypeMapping) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *TypeMapping) GetGoType() string {
+	if x != nil && x.GoType != nil {
+		return *x.GoType
+	}
+	return ""
+}
+
+func (x *TypeMapping) GetPackage() string {
+	if x != nil && x.Package != nil {
+		return *x.Package
+	}
+	return ""
+}
+
+func (x *TypeMapping) GetSqlType() string {
+	if x != nil && x.SqlType != nil {
+		return *x.SqlType
+	}
+	return ""
+}
+
+func (x *TypeMapping) GetToOrm() string {
+	if x != nil && x.ToOrm != nil {
+		return *x.ToOrm
+	}
+	return ""
+}
+
+func (x *TypeMapping) GetToPb() string {
+	if x != nil && x.ToPb != nil {
+		return *x.ToPb
+	}
+	return ""
+}
+
 type GormMessageOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -68,12 +240,30 @@ type GormMessageOptions struct {
 	Include      []*ExtraField `protobuf:"bytes,2,rep,name=include" json:"include,omitempty"`
 	Table        *string       `protobuf:"bytes,3,opt,name=table" json:"table,omitempty"`
 	MultiAccount *bool         `protobuf:"varint,4,opt,name=multi_account,json=multiAccount" json:"multi_account,omitempty"`
+	// soft_delete adds a DeletedAt column, deleting then only sets it and the
+	// deleted rows are left out of the queries
+	SoftDelete *bool `protobuf:"varint,5,opt,name=soft_delete,json=softDelete" json:"soft_delete,omitempty"`
+	// version includes an unexposed Version column checked and incremented by
+	// the updates, unless a field has the version field option
+	Version *bool `protobuf:"varint,6,opt,name=version" json:"version,omitempty"`
+	// audit adds CreatedAt and UpdatedAt columns set by the create and update
+	// handlers, the fields of the message with the same names are mapped
+	Audit *bool `protobuf:"varint,7,opt,name=audit" json:"audit,omitempty"`
+	// audit_identity adds CreatedBy and UpdatedBy columns on top of the audit
+	// ones, set to the identity returned by the identity function
+	AuditIdentity *bool `protobuf:"varint,8,opt,name=audit_identity,json=auditIdentity" json:"audit_identity,omitempty"`
+	// history adds a table of the history rows written by the create, update
+	// and delete handlers, in the transaction of the change
+	History *bool `protobuf:"varint,9,opt,name=history" json:"history,omitempty"`
+	// upsert_index names the unique_index of the conflict target of the
+	// upserts, the primary key when unset
+	UpsertIndex *string `protobuf:"bytes,10,opt,name=upsert_index,json=upsertIndex" json:"upsert_index,omitempty"`
 }
 
 func (x *GormMessageOptions) Reset() {
 	*x = GormMessageOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[1]
+		mi := &file_options_gorm_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -86,7 +276,7 @@ func (x *GormMessageOptions) String() string {
 func (*GormMessageOptions) ProtoMessage() {}
 
 func (x *GormMessageOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[1]
+	mi := &file_options_gorm_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -99,7 +289,7 @@ func (x *GormMessageOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GormMessageOptions.ProtoReflect.Descriptor instead.
 func (*GormMessageOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{1}
+	return file_options_gorm_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GormMessageOptions) GetOrmable() bool {
@@ -130,6 +320,48 @@ func (x *GormMessageOptions) GetMultiAccount() bool {
 	return false
 }
 
+func (x *GormMessageOptions) GetSoftDelete() bool {
+	if x != nil && x.SoftDelete != nil {
+		return *x.SoftDelete
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetVersion() bool {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetAudit() bool {
+	if x != nil && x.Audit != nil {
+		return *x.Audit
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetAuditIdentity() bool {
+	if x != nil && x.AuditIdentity != nil {
+		return *x.AuditIdentity
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetHistory() bool {
+	if x != nil && x.History != nil {
+		return *x.History
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetUpsertIndex() string {
+	if x != nil && x.UpsertIndex != nil {
+		return *x.UpsertIndex
+	}
+	return ""
+}
+
 type ExtraField struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -144,7 +376,7 @@ type ExtraField struct {
 func (x *ExtraField) Reset() {
 	*x = ExtraField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[2]
+		mi := &file_options_gorm_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -157,7 +389,7 @@ func (x *ExtraField) String() string {
 func (*ExtraField) ProtoMessage() {}
 
 func (x *ExtraField) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[2]
+	mi := &file_options_gorm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -170,7 +402,7 @@ func (x *ExtraField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtraField.ProtoReflect.Descriptor instead.
 func (*ExtraField) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{2}
+	return file_options_gorm_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ExtraField) GetType() string {
@@ -215,12 +447,22 @@ type GormFieldOptions struct {
 	//	*GormFieldOptions_ManyToMany
 	Association isGormFieldOptions_Association `protobuf_oneof:"association"`
 	ReferenceOf *string                        `protobuf:"bytes,7,opt,name=reference_of,json=referenceOf" json:"reference_of,omitempty"`
+	Map         *MapOptions                    `protobuf:"bytes,8,opt,name=map" json:"map,omitempty"`
+	// serializer stores a message field that is neither ormable nor a
+	// supported type in a single column, such fields are dropped otherwise
+	Serializer *Serializer `protobuf:"varint,9,opt,name=serializer,enum=gorm.Serializer" json:"serializer,omitempty"`
+	// type_mapping stores the field with a custom Go type, it takes
+	// precedence over the file level mappings of the field's type
+	TypeMapping *TypeMapping `protobuf:"bytes,10,opt,name=type_mapping,json=typeMapping" json:"type_mapping,omitempty"`
+	// version marks the integer field holding the version of the rows, the
+	// updates fail when it doesn't match the stored one and increment it
+	Version *bool `protobuf:"varint,11,opt,name=version" json:"version,omitempty"`
 }
 
 func (x *GormFieldOptions) Reset() {
 	*x = GormFieldOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[3]
+		mi := &file_options_gorm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -233,7 +475,7 @@ func (x *GormFieldOptions) String() string {
 func (*GormFieldOptions) ProtoMessage() {}
 
 func (x *GormFieldOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[3]
+	mi := &file_options_gorm_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +488,7 @@ func (x *GormFieldOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GormFieldOptions.ProtoReflect.Descriptor instead.
 func (*GormFieldOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{3}
+	return file_options_gorm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GormFieldOptions) GetTag() *GormTag {
@@ -305,6 +547,34 @@ func (x *GormFieldOptions) GetReferenceOf() string {
 	return ""
 }
 
+func (x *GormFieldOptions) GetMap() *MapOptions {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *GormFieldOptions) GetSerializer() Serializer {
+	if x != nil && x.Serializer != nil {
+		return *x.Serializer
+	}
+	return Serializer_SERIALIZER_UNSPECIFIED
+}
+
+func (x *GormFieldOptions) GetTypeMapping() *TypeMapping {
+	if x != nil {
+		return x.TypeMapping
+	}
+	return nil
+}
+
+func (x *GormFieldOptions) GetVersion() bool {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return false
+}
+
 type isGormFieldOptions_Association interface {
 	isGormFieldOptions_Association()
 }
@@ -333,6 +603,150 @@ func (*GormFieldOptions_HasMany) isGormFieldOptions_Association() {}
 
 func (*GormFieldOptions_ManyToMany) isGormFieldOptions_Association() {}
 
+// Storage of proto map fields. Maps are stored in a single jsonb column
+// unless child_table is set.
+type MapOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// child_table stores the entries in a generated key/value table with
+	// has-many semantics instead
+	ChildTable *bool `protobuf:"varint,1,opt,name=child_table,json=childTable" json:"child_table,omitempty"`
+	// table overrides the {parent}_{field} name of the generated table
+	Table    *string  `protobuf:"bytes,2,opt,name=table" json:"table,omitempty"`
+	KeyTag   *GormTag `protobuf:"bytes,3,opt,name=key_tag,json=keyTag" json:"key_tag,omitempty"`
+	ValueTag *GormTag `protobuf:"bytes,4,opt,name=value_tag,json=valueTag" json:"value_tag,omitempty"`
+}
+
+func (x *MapOptions) Reset() {
+	*x = MapOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_options_gorm_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapOptions) ProtoMessage() {}
+
+func (x *MapOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_options_gorm_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapOptions.ProtoReflect.Descriptor instead.
+func (*MapOptions) Descriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MapOptions) GetChildTable() bool {
+	if x != nil && x.ChildTable != nil {
+		return *x.ChildTable
+	}
+	return false
+}
+
+func (x *MapOptions) GetTable() string {
+	if x != nil && x.Table != nil {
+		return *x.Table
+	}
+	return ""
+}
+
+func (x *MapOptions) GetKeyTag() *GormTag {
+	if x != nil {
+		return x.KeyTag
+	}
+	return nil
+}
+
+func (x *MapOptions) GetValueTag() *GormTag {
+	if x != nil {
+		return x.ValueTag
+	}
+	return nil
+}
+
+// Storage of oneofs. Every member of a oneof is stored in its own nullable
+// column unless discriminator is set.
+type GormOneofOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// discriminator stores the oneof in a {oneof}_case column holding the name
+	// of the member that is set, and a {oneof}_value jsonb column holding its
+	// JSON (protojson for messages) encoded value
+	Discriminator *bool    `protobuf:"varint,1,opt,name=discriminator" json:"discriminator,omitempty"`
+	CaseTag       *GormTag `protobuf:"bytes,2,opt,name=case_tag,json=caseTag" json:"case_tag,omitempty"`
+	ValueTag      *GormTag `protobuf:"bytes,3,opt,name=value_tag,json=valueTag" json:"value_tag,omitempty"`
+}
+
+func (x *GormOneofOptions) Reset() {
+	*x = GormOneofOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_options_gorm_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GormOneofOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GormOneofOptions) ProtoMessage() {}
+
+func (x *GormOneofOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_options_gorm_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GormOneofOptions.ProtoReflect.Descriptor instead.
+func (*GormOneofOptions) Descriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GormOneofOptions) GetDiscriminator() bool {
+	if x != nil && x.Discriminator != nil {
+		return *x.Discriminator
+	}
+	return false
+}
+
+func (x *GormOneofOptions) GetCaseTag() *GormTag {
+	if x != nil {
+		return x.CaseTag
+	}
+	return nil
+}
+
+func (x *GormOneofOptions) GetValueTag() *GormTag {
+	if x != nil {
+		return x.ValueTag
+	}
+	return nil
+}
+
 type GormTag struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -366,7 +780,7 @@ type GormTag struct {
 func (x *GormTag) Reset() {
 	*x = GormTag{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[4]
+		mi := &file_options_gorm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -379,7 +793,7 @@ func (x *GormTag) String() string {
 func (*GormTag) ProtoMessage() {}
 
 func (x *GormTag) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[4]
+	mi := &file_options_gorm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,7 +806,7 @@ func (x *GormTag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GormTag.ProtoReflect.Descriptor instead.
 func (*GormTag) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{4}
+	return file_options_gorm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GormTag) GetColumn() string {
@@ -576,7 +990,7 @@ type HasOneOptions struct {
 func (x *HasOneOptions) Reset() {
 	*x = HasOneOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[5]
+		mi := &file_options_gorm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -589,7 +1003,7 @@ func (x *HasOneOptions) String() string {
 func (*HasOneOptions) ProtoMessage() {}
 
 func (x *HasOneOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[5]
+	mi := &file_options_gorm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +1016,7 @@ func (x *HasOneOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HasOneOptions.ProtoReflect.Descriptor instead.
 func (*HasOneOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{5}
+	return file_options_gorm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *HasOneOptions) GetForeignkey() string {
@@ -692,7 +1106,7 @@ type BelongsToOptions struct {
 func (x *BelongsToOptions) Reset() {
 	*x = BelongsToOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[6]
+		mi := &file_options_gorm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -705,7 +1119,7 @@ func (x *BelongsToOptions) String() string {
 func (*BelongsToOptions) ProtoMessage() {}
 
 func (x *BelongsToOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[6]
+	mi := &file_options_gorm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +1132,7 @@ func (x *BelongsToOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BelongsToOptions.ProtoReflect.Descriptor instead.
 func (*BelongsToOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{6}
+	return file_options_gorm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BelongsToOptions) GetForeignkey() string {
@@ -792,7 +1206,7 @@ type HasManyOptions struct {
 func (x *HasManyOptions) Reset() {
 	*x = HasManyOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[7]
+		mi := &file_options_gorm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -805,7 +1219,7 @@ func (x *HasManyOptions) String() string {
 func (*HasManyOptions) ProtoMessage() {}
 
 func (x *HasManyOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[7]
+	mi := &file_options_gorm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -818,7 +1232,7 @@ func (x *HasManyOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HasManyOptions.ProtoReflect.Descriptor instead.
 func (*HasManyOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{7}
+	return file_options_gorm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *HasManyOptions) GetForeignkey() string {
@@ -927,7 +1341,7 @@ type ManyToManyOptions struct {
 func (x *ManyToManyOptions) Reset() {
 	*x = ManyToManyOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[8]
+		mi := &file_options_gorm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -940,7 +1354,7 @@ func (x *ManyToManyOptions) String() string {
 func (*ManyToManyOptions) ProtoMessage() {}
 
 func (x *ManyToManyOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[8]
+	mi := &file_options_gorm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -953,7 +1367,7 @@ func (x *ManyToManyOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManyToManyOptions.ProtoReflect.Descriptor instead.
 func (*ManyToManyOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{8}
+	return file_options_gorm_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ManyToManyOptions) GetJointable() string {
@@ -1053,7 +1467,7 @@ type AutoServerOptions struct {
 func (x *AutoServerOptions) Reset() {
 	*x = AutoServerOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[9]
+		mi := &file_options_gorm_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1066,7 +1480,7 @@ func (x *AutoServerOptions) String() string {
 func (*AutoServerOptions) ProtoMessage() {}
 
 func (x *AutoServerOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[9]
+	mi := &file_options_gorm_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1079,7 +1493,7 @@ func (x *AutoServerOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AutoServerOptions.ProtoReflect.Descriptor instead.
 func (*AutoServerOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{9}
+	return file_options_gorm_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AutoServerOptions) GetAutogen() bool {
@@ -1114,7 +1528,7 @@ type MethodOptions struct {
 func (x *MethodOptions) Reset() {
 	*x = MethodOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[10]
+		mi := &file_options_gorm_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1127,7 +1541,7 @@ func (x *MethodOptions) String() string {
 func (*MethodOptions) ProtoMessage() {}
 
 func (x *MethodOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[10]
+	mi := &file_options_gorm_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,7 +1554,7 @@ func (x *MethodOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MethodOptions.ProtoReflect.Descriptor instead.
 func (*MethodOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{10}
+	return file_options_gorm_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *MethodOptions) GetObjectType() string {
@@ -1175,6 +1589,14 @@ var file_options_gorm_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,52119,opt,name=field",
 		Filename:      "options/gorm.proto",
 	},
+	{
+		ExtendedType:  (*descriptor.OneofOptions)(nil),
+		ExtensionType: (*GormOneofOptions)(nil),
+		Field:         52119,
+		Name:          "gorm.oneof",
+		Tag:           "bytes,52119,opt,name=oneof",
+		Filename:      "options/gorm.proto",
+	},
 	{
 		ExtendedType:  (*descriptor.ServiceOptions)(nil),
 		ExtensionType: (*AutoServerOptions)(nil),
@@ -1213,16 +1635,22 @@ var (
 	E_Field = &file_options_gorm_proto_extTypes[2]
 )
 
+// Extension fields to descriptor.OneofOptions.
+var (
+	// optional gorm.GormOneofOptions oneof = 52119;
+	E_Oneof = &file_options_gorm_proto_extTypes[3]
+)
+
 // Extension fields to descriptor.ServiceOptions.
 var (
 	// optional gorm.AutoServerOptions server = 52119;
-	E_Server = &file_options_gorm_proto_extTypes[3]
+	E_Server = &file_options_gorm_proto_extTypes[4]
 )
 
 // Extension fields to descriptor.MethodOptions.
 var (
 	// optional gorm.MethodOptions method = 52119;
-	E_Method = &file_options_gorm_proto_extTypes[4]
+	E_Method = &file_options_gorm_proto_extTypes[5]
 )
 
 var File_options_gorm_proto protoreflect.FileDescriptor
@@ -1231,46 +1659,100 @@ var file_options_gorm_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x67, 0x6f, 0x72, 0x6d, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x11, 0x0a, 0x0f,
-	0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x95, 0x01, 0x0a, 0x12, 0x47, 0x6f, 0x72, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x6d, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x72, 0x6d, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x2a, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6f, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x02, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
-	0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72,
-	0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0xd2, 0x02, 0x0a, 0x10, 0x47, 0x6f, 0x72,
-	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a,
-	0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72,
-	0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x72,
-	0x6f, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x5f, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x48, 0x61, 0x73, 0x4f, 0x6e,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x06, 0x68, 0x61, 0x73, 0x4f,
-	0x6e, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x42, 0x65,
-	0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00,
-	0x52, 0x09, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x12, 0x31, 0x0a, 0x08, 0x68,
-	0x61, 0x73, 0x5f, 0x6d, 0x61, 0x6e, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x48, 0x61, 0x73, 0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x3b,
-	0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x79, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x61, 0x6e, 0x79,
-	0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52,
-	0x0a, 0x6d, 0x61, 0x6e, 0x79, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x66, 0x42, 0x0d,
-	0x0a, 0x0b, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xce, 0x06,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x47, 0x0a, 0x0f,
+	0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x34, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x22, 0xa1, 0x01, 0x0a, 0x0b, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x71, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x71, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x74, 0x6f, 0x5f, 0x6f, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x4f, 0x72, 0x6d, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x5f, 0x70, 0x62, 0x18, 0x06, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x50, 0x62, 0x22, 0xca, 0x02, 0x0a, 0x12, 0x47, 0x6f,
+	0x72, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x08, 0x52, 0x07, 0x6f, 0x72, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f,
+	0x72, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x07, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x64, 0x69,
+	0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x6f, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x03,
+	0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
+	0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0xf8, 0x03, 0x0a, 0x10, 0x47, 0x6f, 0x72, 0x6d,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x03,
+	0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
+	0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x72, 0x6f,
+	0x70, 0x12, 0x2e, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x5f, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x48, 0x61, 0x73, 0x4f, 0x6e, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x06, 0x68, 0x61, 0x73, 0x4f, 0x6e,
+	0x65, 0x12, 0x37, 0x0a, 0x0a, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x42, 0x65, 0x6c,
+	0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52,
+	0x09, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x12, 0x31, 0x0a, 0x08, 0x68, 0x61,
+	0x73, 0x5f, 0x6d, 0x61, 0x6e, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x72, 0x6d, 0x2e, 0x48, 0x61, 0x73, 0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x3b, 0x0a,
+	0x0c, 0x6d, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x61, 0x6e, 0x79, 0x54,
+	0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0a,
+	0x6d, 0x61, 0x6e, 0x79, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x66, 0x12, 0x22, 0x0a,
+	0x03, 0x6d, 0x61, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x72,
+	0x6d, 0x2e, 0x4d, 0x61, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x03, 0x6d, 0x61,
+	0x70, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x53, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x74, 0x79,
+	0x70, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x5f,
+	0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
+	0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x06, 0x6b, 0x65, 0x79, 0x54, 0x61, 0x67,
+	0x12, 0x2a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54,
+	0x61, 0x67, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x61, 0x67, 0x22, 0x8e, 0x01, 0x0a,
+	0x10, 0x47, 0x6f, 0x72, 0x6d, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x69,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x65, 0x5f,
+	0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
+	0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x07, 0x63, 0x61, 0x73, 0x65, 0x54, 0x61,
+	0x67, 0x12, 0x2a, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d,
+	0x54, 0x61, 0x67, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x61, 0x67, 0x22, 0xce, 0x06,
 	0x0a, 0x07, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c,
 	0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
 	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -1450,36 +1932,46 @@ var file_options_gorm_proto_rawDesc = []byte{
 	0x63, 0x69, 0x6e, 0x67, 0x22, 0x30, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70,
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
 	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x52, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6f,
-	0x70, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
-	0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x3a, 0x4f, 0x0a, 0x04, 0x6f, 0x70,
-	0x74, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f,
-	0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x3a, 0x4d, 0x0a, 0x05, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
-	0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x52, 0x0a, 0x06, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x3a, 0x4d,
-	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2a, 0x53, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a,
+	0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x13, 0x0a, 0x0f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x52, 0x5f, 0x4a,
+	0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x49,
+	0x5a, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x02, 0x3a, 0x52, 0x0a, 0x09, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x3a,
+	0x4f, 0x0a, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x6f, 0x70, 0x74, 0x73,
+	0x3a, 0x4d, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c,
 	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x32, 0x5a,
-	0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x64, 0x68, 0x61,
-	0x69, 0x67, 0x68, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
-	0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3b, 0x67, 0x6f, 0x72,
-	0x6d,
+	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a,
+	0x4d, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x4f, 0x6e, 0x65, 0x6f, 0x66,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3a, 0x52,
+	0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x3a, 0x4d, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x65, 0x64, 0x68, 0x61, 0x69, 0x67, 0x68, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x3b, 0x67, 0x6f, 0x72, 0x6d,
 }
 
 var (
@@ -1494,52 +1986,68 @@ func file_options_gorm_proto_rawDescGZIP() []byte {
 	return file_options_gorm_proto_rawDescData
 }
 
-var file_options_gorm_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_options_gorm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_options_gorm_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_options_gorm_proto_goTypes = []interface{}{
-	(*GormFileOptions)(nil),           // 0: gorm.GormFileOptions
-	(*GormMessageOptions)(nil),        // 1: gorm.GormMessageOptions
-	(*ExtraField)(nil),                // 2: gorm.ExtraField
-	(*GormFieldOptions)(nil),          // 3: gorm.GormFieldOptions
-	(*GormTag)(nil),                   // 4: gorm.GormTag
-	(*HasOneOptions)(nil),             // 5: gorm.HasOneOptions
-	(*BelongsToOptions)(nil),          // 6: gorm.BelongsToOptions
-	(*HasManyOptions)(nil),            // 7: gorm.HasManyOptions
-	(*ManyToManyOptions)(nil),         // 8: gorm.ManyToManyOptions
-	(*AutoServerOptions)(nil),         // 9: gorm.AutoServerOptions
-	(*MethodOptions)(nil),             // 10: gorm.MethodOptions
-	(*descriptor.FileOptions)(nil),    // 11: google.protobuf.FileOptions
-	(*descriptor.MessageOptions)(nil), // 12: google.protobuf.MessageOptions
-	(*descriptor.FieldOptions)(nil),   // 13: google.protobuf.FieldOptions
-	(*descriptor.ServiceOptions)(nil), // 14: google.protobuf.ServiceOptions
-	(*descriptor.MethodOptions)(nil),  // 15: google.protobuf.MethodOptions
+	(Serializer)(0),                   // 0: gorm.Serializer
+	(*GormFileOptions)(nil),           // 1: gorm.GormFileOptions
+	(*TypeMapping)(nil),               // 2: gorm.TypeMapping
+	(*GormMessageOptions)(nil),        // 3: gorm.GormMessageOptions
+	(*ExtraField)(nil),                // 4: gorm.ExtraField
+	(*GormFieldOptions)(nil),          // 5: gorm.GormFieldOptions
+	(*MapOptions)(nil),                // 6: gorm.MapOptions
+	(*GormOneofOptions)(nil),          // 7: gorm.GormOneofOptions
+	(*GormTag)(nil),                   // 8: gorm.GormTag
+	(*HasOneOptions)(nil),             // 9: gorm.HasOneOptions
+	(*BelongsToOptions)(nil),          // 10: gorm.BelongsToOptions
+	(*HasManyOptions)(nil),            // 11: gorm.HasManyOptions
+	(*ManyToManyOptions)(nil),         // 12: gorm.ManyToManyOptions
+	(*AutoServerOptions)(nil),         // 13: gorm.AutoServerOptions
+	(*MethodOptions)(nil),             // 14: gorm.MethodOptions
+	(*descriptor.FileOptions)(nil),    // 15: google.protobuf.FileOptions
+	(*descriptor.MessageOptions)(nil), // 16: google.protobuf.MessageOptions
+	(*descriptor.FieldOptions)(nil),   // 17: google.protobuf.FieldOptions
+	(*descriptor.OneofOptions)(nil),   // 18: google.protobuf.OneofOptions
+	(*descriptor.ServiceOptions)(nil), // 19: google.protobuf.ServiceOptions
+	(*descriptor.MethodOptions)(nil),  // 20: google.protobuf.MethodOptions
 }
 var file_options_gorm_proto_depIdxs = []int32{
-	2,  // 0: gorm.GormMessageOptions.include:type_name -> gorm.ExtraField
-	4,  // 1: gorm.ExtraField.tag:type_name -> gorm.GormTag
-	4,  // 2: gorm.GormFieldOptions.tag:type_name -> gorm.GormTag
-	5,  // 3: gorm.GormFieldOptions.has_one:type_name -> gorm.HasOneOptions
-	6,  // 4: gorm.GormFieldOptions.belongs_to:type_name -> gorm.BelongsToOptions
-	7,  // 5: gorm.GormFieldOptions.has_many:type_name -> gorm.HasManyOptions
-	8,  // 6: gorm.GormFieldOptions.many_to_many:type_name -> gorm.ManyToManyOptions
-	4,  // 7: gorm.HasOneOptions.foreignkey_tag:type_name -> gorm.GormTag
-	4,  // 8: gorm.BelongsToOptions.foreignkey_tag:type_name -> gorm.GormTag
-	4,  // 9: gorm.HasManyOptions.foreignkey_tag:type_name -> gorm.GormTag
-	4,  // 10: gorm.HasManyOptions.position_field_tag:type_name -> gorm.GormTag
-	11, // 11: gorm.file_opts:extendee -> google.protobuf.FileOptions
-	12, // 12: gorm.opts:extendee -> google.protobuf.MessageOptions
-	13, // 13: gorm.field:extendee -> google.protobuf.FieldOptions
-	14, // 14: gorm.server:extendee -> google.protobuf.ServiceOptions
-	15, // 15: gorm.method:extendee -> google.protobuf.MethodOptions
-	0,  // 16: gorm.file_opts:type_name -> gorm.GormFileOptions
-	1,  // 17: gorm.opts:type_name -> gorm.GormMessageOptions
-	3,  // 18: gorm.field:type_name -> gorm.GormFieldOptions
-	9,  // 19: gorm.server:type_name -> gorm.AutoServerOptions
-	10, // 20: gorm.method:type_name -> gorm.MethodOptions
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	16, // [16:21] is the sub-list for extension type_name
-	11, // [11:16] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	2,  // 0: gorm.GormFileOptions.type_mapping:type_name -> gorm.TypeMapping
+	4,  // 1: gorm.GormMessageOptions.include:type_name -> gorm.ExtraField
+	8,  // 2: gorm.ExtraField.tag:type_name -> gorm.GormTag
+	8,  // 3: gorm.GormFieldOptions.tag:type_name -> gorm.GormTag
+	9,  // 4: gorm.GormFieldOptions.has_one:type_name -> gorm.HasOneOptions
+	10, // 5: gorm.GormFieldOptions.belongs_to:type_name -> gorm.BelongsToOptions
+	11, // 6: gorm.GormFieldOptions.has_many:type_name -> gorm.HasManyOptions
+	12, // 7: gorm.GormFieldOptions.many_to_many:type_name -> gorm.ManyToManyOptions
+	6,  // 8: gorm.GormFieldOptions.map:type_name -> gorm.MapOptions
+	0,  // 9: gorm.GormFieldOptions.serializer:type_name -> gorm.Serializer
+	2,  // 10: gorm.GormFieldOptions.type_mapping:type_name -> gorm.TypeMapping
+	8,  // 11: gorm.MapOptions.key_tag:type_name -> gorm.GormTag
+	8,  // 12: gorm.MapOptions.value_tag:type_name -> gorm.GormTag
+	8,  // 13: gorm.GormOneofOptions.case_tag:type_name -> gorm.GormTag
+	8,  // 14: gorm.GormOneofOptions.value_tag:type_name -> gorm.GormTag
+	8,  // 15: gorm.HasOneOptions.foreignkey_tag:type_name -> gorm.GormTag
+	8,  // 16: gorm.BelongsToOptions.foreignkey_tag:type_name -> gorm.GormTag
+	8,  // 17: gorm.HasManyOptions.foreignkey_tag:type_name -> gorm.GormTag
+	8,  // 18: gorm.HasManyOptions.position_field_tag:type_name -> gorm.GormTag
+	15, // 19: gorm.file_opts:extendee -> google.protobuf.FileOptions
+	16, // 20: gorm.opts:extendee -> google.protobuf.MessageOptions
+	17, // 21: gorm.field:extendee -> google.protobuf.FieldOptions
+	18, // 22: gorm.oneof:extendee -> google.protobuf.OneofOptions
+	19, // 23: gorm.server:extendee -> google.protobuf.ServiceOptions
+	20, // 24: gorm.method:extendee -> google.protobuf.MethodOptions
+	1,  // 25: gorm.file_opts:type_name -> gorm.GormFileOptions
+	3,  // 26: gorm.opts:type_name -> gorm.GormMessageOptions
+	5,  // 27: gorm.field:type_name -> gorm.GormFieldOptions
+	7,  // 28: gorm.oneof:type_name -> gorm.GormOneofOptions
+	13, // 29: gorm.server:type_name -> gorm.AutoServerOptions
+	14, // 30: gorm.method:type_name -> gorm.MethodOptions
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	25, // [25:31] is the sub-list for extension type_name
+	19, // [19:25] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_options_gorm_proto_init() }
@@ -1561,7 +2069,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GormMessageOptions); i {
+			switch v := v.(*TypeMapping); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1573,7 +2081,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtraField); i {
+			switch v := v.(*GormMessageOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1585,7 +2093,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GormFieldOptions); i {
+			switch v := v.(*ExtraField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1597,7 +2105,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GormTag); i {
+			switch v := v.(*GormFieldOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1609,7 +2117,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HasOneOptions); i {
+			switch v := v.(*MapOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1621,7 +2129,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BelongsToOptions); i {
+			switch v := v.(*GormOneofOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1633,7 +2141,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HasManyOptions); i {
+			switch v := v.(*GormTag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1645,7 +2153,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ManyToManyOptions); i {
+			switch v := v.(*HasOneOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1657,7 +2165,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AutoServerOptions); i {
+			switch v := v.(*BelongsToOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1669,6 +2177,42 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasManyOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_options_gorm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManyToManyOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_options_gorm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoServerOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_options_gorm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MethodOptions); i {
 			case 0:
 				return &v.state
@@ -1681,7 +2225,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 	}
-	file_options_gorm_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_options_gorm_proto_msgTypes[4].OneofWrappers = []interface{}{
 		(*GormFieldOptions_HasOne)(nil),
 		(*GormFieldOptions_BelongsTo)(nil),
 		(*GormFieldOptions_HasMany)(nil),
@@ -1692,13 +2236,14 @@ func file_options_gorm_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_options_gorm_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
-			NumExtensions: 5,
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 6,
 			NumServices:   0,
 		},
 		GoTypes:           file_options_gorm_proto_goTypes,
 		DependencyIndexes: file_options_gorm_proto_depIdxs,
+		EnumInfos:         file_options_gorm_proto_enumTypes,
 		MessageInfos:      file_options_gorm_proto_msgTypes,
 		ExtensionInfos:    file_options_gorm_proto_extTypes,
 	}.Build()
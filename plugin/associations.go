@@ -8,6 +8,7 @@ import (
 	jgorm "github.com/jinzhu/gorm"
 	"github.com/jinzhu/inflection"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
 
 	gorm "github.com/edhaight/protoc-gen-gorm/options"
 )
@@ -18,10 +19,16 @@ func (p *OrmPlugin) parseAssociations(msg *protogen.Message) {
 
 	for _, field := range msg.Fields {
 		fieldOpts := getFieldOptions(field)
-		if fieldOpts.GetDrop() {
+		if fieldOpts.GetDrop() || (isOneofMember(field) && isDiscriminated(field.Oneof)) || p.getTypeMapping(field) != nil {
 			continue
 		}
 		fieldName := fieldName(field)
+		if field.Desc.IsMap() {
+			if fieldOpts.GetMap().GetChildTable() {
+				p.parseMapTableAssociation(msg, ormable, field, fieldOpts)
+			}
+			continue
+		}
 		// tmp := protodesc.ToFieldDescriptorProto(field)
 		var fieldType string
 		if field.Desc.Message() != nil {
@@ -70,23 +77,23 @@ func (p *OrmPlugin) parseHasMany(msg *protogen.Message, parent *OrmableType, fie
 		hasMany = &gorm.HasManyOptions{}
 		opts.Association = &gorm.GormFieldOptions_HasMany{HasMany: hasMany}
 	}
-	assocKeyName, assocKey := p.getAssocKeyName(hasMany, parent)
-	hasMany.AssociationForeignkey = &assocKeyName
-	foreignKey := p.getForeignKey(hasMany, assocKey, child, field)
-	var foreignKeyName string
-	if foreignKeyName = hasMany.GetForeignkey(); foreignKeyName == "" {
+	assocKeyNames, assocKeys := p.getAssocKeyNames(hasMany, parent)
+	hasMany.AssociationForeignkey = proto.String(strings.Join(assocKeyNames, ","))
+	foreignKeyNames := p.getForeignKeyNames(splitKeyNames(hasMany.GetForeignkey(), false), assocKeyNames, parent, func(assocKeyName string) string {
 		if p.countHasAssociationDimension(msg, fieldType) == 1 {
-			foreignKeyName = fmt.Sprintf(typeName + assocKeyName)
-		} else {
-			foreignKeyName = fmt.Sprintf(fieldName + typeName + assocKeyName)
+			return typeName + assocKeyName
 		}
+		return fieldName + typeName + assocKeyName
+	})
+	for i, foreignKeyName := range foreignKeyNames {
+		if _, ok := child.Fields[foreignKeyName]; child.Package != parent.Package && !ok {
+			p.Fail(`Object`, child.Name, `from package`, child.Package, `cannot be used for has-many in`, parent.Name, `since it`,
+				`does not have FK`, foreignKeyName, `defined. Manually define the key, or switch to many-to-many`)
+		}
+		foreignKey := p.getForeignKey(hasMany, assocKeys[i], child, field)
+		p.setChildForeignKeyFieldExternal(child, parent, foreignKey, foreignKeyName)
 	}
-	if _, ok := child.Fields[foreignKeyName]; child.Package != parent.Package && !ok {
-		p.Fail(`Object`, child.Name, `from package`, child.Package, `cannot be used for has-many in`, parent.Name, `since it`,
-			`does not have FK`, foreignKeyName, `defined. Manually define the key, or switch to many-to-many`)
-	}
-	hasMany.Foreignkey = &foreignKeyName
-	p.setChildForeignKeyFieldExternal(child, parent, foreignKey, foreignKeyName)
+	hasMany.Foreignkey = proto.String(strings.Join(foreignKeyNames, ","))
 
 	var posField string
 	if posField = generator.CamelCase(hasMany.GetPositionField()); posField != "" {
@@ -106,23 +113,23 @@ func (p *OrmPlugin) parseHasOne(msg *protogen.Message, parent *OrmableType, fiel
 		hasOne = &gorm.HasOneOptions{}
 		opts.Association = &gorm.GormFieldOptions_HasOne{HasOne: hasOne}
 	}
-	assocKeyName, assocKey := p.getAssocKeyName(hasOne, parent)
-	hasOne.AssociationForeignkey = &assocKeyName
-	foreignKey := p.getForeignKey(hasOne, assocKey, child, field)
-	foreignKeyName := generator.CamelCase(hasOne.GetForeignkey())
-	if foreignKeyName == "" {
+	assocKeyNames, assocKeys := p.getAssocKeyNames(hasOne, parent)
+	hasOne.AssociationForeignkey = proto.String(strings.Join(assocKeyNames, ","))
+	foreignKeyNames := p.getForeignKeyNames(splitKeyNames(hasOne.GetForeignkey(), true), assocKeyNames, parent, func(assocKeyName string) string {
 		if p.countHasAssociationDimension(msg, fieldType) == 1 {
-			foreignKeyName = fmt.Sprintf(typeName + assocKeyName)
-		} else {
-			foreignKeyName = fmt.Sprintf(fieldName + typeName + assocKeyName)
+			return typeName + assocKeyName
 		}
+		return fieldName + typeName + assocKeyName
+	})
+	for i, foreignKeyName := range foreignKeyNames {
+		if _, ok := child.Fields[foreignKeyName]; child.Package != parent.Package && !ok {
+			p.Fail(`Object`, child.Name, `from package`, child.Package, `cannot be used for has-one in`, parent.Name, `since it`,
+				`does not have FK field`, foreignKeyName, `defined. Manually define the key, or switch to belongs-to`)
+		}
+		foreignKey := p.getForeignKey(hasOne, assocKeys[i], child, field)
+		p.setChildForeignKeyFieldExternal(child, parent, foreignKey, foreignKeyName)
 	}
-	if _, ok := child.Fields[foreignKeyName]; child.Package != parent.Package && !ok {
-		p.Fail(`Object`, child.Name, `from package`, child.Package, `cannot be used for has-one in`, parent.Name, `since it`,
-			`does not have FK field`, foreignKeyName, `defined. Manually define the key, or switch to belongs-to`)
-	}
-	hasOne.Foreignkey = &foreignKeyName
-	p.setChildForeignKeyFieldExternal(child, parent, foreignKey, foreignKeyName)
+	hasOne.Foreignkey = proto.String(strings.Join(foreignKeyNames, ","))
 }
 
 func (p *OrmPlugin) parseBelongsTo(msg *protogen.Message, child *OrmableType, fieldName string, fieldType string, field *protogen.Field, parent *OrmableType, opts *gorm.GormFieldOptions) {
@@ -131,19 +138,19 @@ func (p *OrmPlugin) parseBelongsTo(msg *protogen.Message, child *OrmableType, fi
 		belongsTo = &gorm.BelongsToOptions{}
 		opts.Association = &gorm.GormFieldOptions_BelongsTo{BelongsTo: belongsTo}
 	}
-	assocKeyName, assocKey := p.getAssocKeyName(belongsTo, parent)
-	belongsTo.AssociationForeignkey = &assocKeyName
-	foreignKey := p.getForeignKey(belongsTo, assocKey, child, field)
-	foreignKeyName := generator.CamelCase(belongsTo.GetForeignkey())
-	if foreignKeyName == "" {
+	assocKeyNames, assocKeys := p.getAssocKeyNames(belongsTo, parent)
+	belongsTo.AssociationForeignkey = proto.String(strings.Join(assocKeyNames, ","))
+	foreignKeyNames := p.getForeignKeyNames(splitKeyNames(belongsTo.GetForeignkey(), true), assocKeyNames, parent, func(assocKeyName string) string {
 		if p.countBelongsToAssociationDimension(msg, fieldType) == 1 {
-			foreignKeyName = fmt.Sprintf(fieldType + assocKeyName)
-		} else {
-			foreignKeyName = fmt.Sprintf(fieldName + assocKeyName)
+			return fieldType + assocKeyName
 		}
+		return fieldName + assocKeyName
+	})
+	for i, foreignKeyName := range foreignKeyNames {
+		foreignKey := p.getForeignKey(belongsTo, assocKeys[i], child, field)
+		p.setChildForeignKeyFieldExternal(child, parent, foreignKey, foreignKeyName)
 	}
-	belongsTo.Foreignkey = &foreignKeyName
-	p.setChildForeignKeyFieldExternal(child, parent, foreignKey, foreignKeyName)
+	belongsTo.Foreignkey = proto.String(strings.Join(foreignKeyNames, ","))
 }
 
 func (p *OrmPlugin) parseManyToMany(msg *protogen.Message, ormable *OrmableType, fieldName string, fieldType string, field *protogen.Field, assoc *OrmableType, opts *gorm.GormFieldOptions) {
@@ -155,6 +162,7 @@ func (p *OrmPlugin) parseManyToMany(msg *protogen.Message, ormable *OrmableType,
 	}
 	var foreignKeyName string
 	if foreignKeyName = generator.CamelCase(mtm.GetForeignkey()); foreignKeyName == "" {
+		p.checkSingleKey(ormable)
 		foreignKeyName, _ = p.findPrimaryKey(ormable)
 	} else {
 		var ok bool
@@ -198,6 +206,7 @@ type assocForeignKeyGetter interface {
 func (p *OrmPlugin) getAssocKeyName(i assocForeignKeyGetter, parent *OrmableType) (string, *Field) {
 	assocKeyName := generator.CamelCase(i.GetAssociationForeignkey())
 	if assocKeyName == "" {
+		p.checkSingleKey(parent)
 		return p.findPrimaryKey(parent)
 	}
 	assocKey, ok := parent.Fields[assocKeyName]
@@ -207,6 +216,50 @@ func (p *OrmPlugin) getAssocKeyName(i assocForeignKeyGetter, parent *OrmableType
 	return assocKeyName, assocKey
 }
 
+// checkSingleKey fails when the primary key of ormable, referenced by a
+// many-to-many association or a map child table, is a composite one.
+func (p *OrmPlugin) checkSingleKey(ormable *OrmableType) {
+	if p.hasCompositeKey(ormable) {
+		p.Fail("The composite primary key of", ormable.Name, "can only be referenced by has-one, has-many and belongs-to associations.")
+	}
+}
+
+// getAssocKeyNames returns the names and the fields of the key of parent an
+// association references, its primary key unless set, which spans several
+// fields for a composite key.
+func (p *OrmPlugin) getAssocKeyNames(i assocForeignKeyGetter, parent *OrmableType) ([]string, []*Field) {
+	assocKeyNames := splitKeyNames(i.GetAssociationForeignkey(), true)
+	if len(assocKeyNames) == 0 {
+		assocKeyNames = p.primaryKeyNames(parent)
+		if len(assocKeyNames) == 0 {
+			p.Fail("Primary key cannot be found in", parent.Name, ".")
+		}
+	}
+	assocKeys := make([]*Field, 0, len(assocKeyNames))
+	for _, assocKeyName := range assocKeyNames {
+		assocKey, ok := parent.Fields[assocKeyName]
+		if !ok {
+			p.Fail("Missing", assocKeyName, "field in", parent.Name, ".")
+		}
+		assocKeys = append(assocKeys, assocKey)
+	}
+	return assocKeyNames, assocKeys
+}
+
+// getForeignKeyNames returns the names of the foreign key fields of an
+// association referencing the assocKeyNames key, the names set comma separated
+// or else the ones named by name.
+func (p *OrmPlugin) getForeignKeyNames(names []string, assocKeyNames []string, parent *OrmableType, name func(assocKeyName string) string) []string {
+	if len(names) == 0 {
+		for _, assocKeyName := range assocKeyNames {
+			names = append(names, name(assocKeyName))
+		}
+	} else if len(names) != len(assocKeyNames) {
+		p.Fail("Foreign key", strings.Join(names, ","), "doesn't match the key", strings.Join(assocKeyNames, ","), "of", parent.Name, ".")
+	}
+	return names
+}
+
 type foreignKeyTagGetter interface {
 	GetForeignkeyTag() *gorm.GormTag
 }
@@ -244,8 +297,8 @@ func (p *OrmPlugin) setChildForeignKeyFieldExternal(child *OrmableType, parent *
 }
 
 func (p *OrmPlugin) findPrimaryKeyHelper(ormable *OrmableType) (bool, string, *Field) {
-	for fieldName, field := range ormable.Fields {
-		if field.GetTag().GetPrimaryKey() {
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		if field := ormable.Fields[fieldName]; field.GetTag().GetPrimaryKey() {
 			return true, fieldName, field
 		}
 	}
@@ -271,6 +324,28 @@ func (p *OrmPlugin) hasPrimaryKey(ormable *OrmableType) bool {
 	return found
 }
 
+// hasCompositeKey reports whether the primary key of ormable spans several
+// fields.
+func (p *OrmPlugin) hasCompositeKey(ormable *OrmableType) bool {
+	return len(p.primaryKeyNames(ormable)) > 1
+}
+
+// splitKeyNames returns the names of the fields of a key of several columns,
+// listed comma separated in the foreign key options.
+func splitKeyNames(names string, camelCase bool) []string {
+	var keyNames []string
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		if camelCase {
+			name = generator.CamelCase(name)
+		}
+		keyNames = append(keyNames, name)
+	}
+	return keyNames
+}
+
 func (p *OrmPlugin) countDimensionGeneric(msg *protogen.Message, typeName string, conditional func(fieldOpts *gorm.GormFieldOptions) bool) int {
 	dim := 0
 	for _, field := range msg.Fields {
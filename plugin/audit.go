@@ -0,0 +1,108 @@
+package plugin
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// auditFields are the audit columns added by the audit message options, along
+// with the ident of their type.
+var auditFields = []struct {
+	name     string
+	identity bool
+	ident    protogen.GoIdent
+}{
+	{name: "CreatedAt", ident: ptrIdent(identTime)},
+	{name: "UpdatedAt", ident: ptrIdent(identTime)},
+	{name: "CreatedBy", identity: true, ident: protogen.GoIdent{GoName: "string"}},
+	{name: "UpdatedBy", identity: true, ident: protogen.GoIdent{GoName: "string"}},
+}
+
+// hasAudit reports whether the handlers of ormable maintain the CreatedAt and
+// UpdatedAt columns.
+func hasAudit(ormable *OrmableType) bool {
+	opts := getMessageOptions(ormable.Message)
+	return opts.GetAudit() || opts.GetAuditIdentity()
+}
+
+// hasAuditIdentity reports whether the handlers of ormable maintain the
+// CreatedBy and UpdatedBy columns.
+func hasAuditIdentity(ormable *OrmableType) bool {
+	return getMessageOptions(ormable.Message).GetAuditIdentity()
+}
+
+// parseAuditFields adds the audit columns of ormable. The fields of the
+// message with the same name and type are kept, and mapped to the columns.
+func (p *OrmPlugin) parseAuditFields(ormable *OrmableType) {
+	if !hasAudit(ormable) {
+		return
+	}
+	for _, audit := range auditFields {
+		if audit.identity && !hasAuditIdentity(ormable) {
+			continue
+		}
+		added := &Field{F: &protogen.Field{GoIdent: audit.ident}, Type: audit.ident.GoName}
+		field, ok := ormable.Fields[audit.name]
+		if !ok {
+			ormable.Fields[audit.name] = added
+		} else if field.Type != added.Type || fieldGoType(field) != fieldGoType(added) {
+			p.Fail("Cannot include", audit.name, "field into", ormable.Name, "as it already exists there with a different type.")
+		}
+	}
+}
+
+// identityIdent returns the ident of the identity function.
+func (p *OrmPlugin) identityIdent() protogen.GoIdent {
+	importPath, name, _ := splitQualifiedName(p.Identity)
+	return protogen.GoIdent{GoName: name, GoImportPath: protogen.GoImportPath(importPath)}
+}
+
+// generateAuditIdentity outputs the call of the identity function, which sets
+// the actor variable, returning its error after ret.
+func (p *OrmPlugin) generateAuditIdentity(ret string) {
+	p.P(`actor, err := `, p.identityIdent(), `(ctx)`)
+	p.P(`if err != nil {`)
+	p.P(ret, `err`)
+	p.P(`}`)
+}
+
+// generateCreateAudit outputs the assignment of the audit columns of the
+// ormObj variable being created.
+func (p *OrmPlugin) generateCreateAudit(ormable *OrmableType) {
+	if !hasAudit(ormable) {
+		return
+	}
+	p.P(`now := `, identTimeNow, `()`)
+	p.P(`ormObj.CreatedAt = &now`)
+	p.P(`ormObj.UpdatedAt = &now`)
+	if hasAuditIdentity(ormable) {
+		p.generateAuditIdentity(`return nil, `)
+		p.P(`ormObj.CreatedBy = actor`)
+		p.P(`ormObj.UpdatedBy = actor`)
+	}
+}
+
+// generateUpdateAudit outputs the assignment of the audit columns of the
+// ormObj variable being updated, the creation ones are kept from the stored
+// row held by the lockedRow variable, unless it is missing.
+func (p *OrmPlugin) generateUpdateAudit(ormable *OrmableType) {
+	if !hasAudit(ormable) {
+		return
+	}
+	p.P(`now := `, identTimeNow, `()`)
+	p.P(`ormObj.UpdatedAt = &now`)
+	if hasAuditIdentity(ormable) {
+		p.generateAuditIdentity(`return nil, `)
+		p.P(`ormObj.UpdatedBy = actor`)
+	}
+	p.P(`if lockedRow.CreatedAt != nil {`)
+	p.P(`ormObj.CreatedAt = lockedRow.CreatedAt`)
+	if hasAuditIdentity(ormable) {
+		p.P(`ormObj.CreatedBy = lockedRow.CreatedBy`)
+	}
+	p.P(`} else {`)
+	p.P(`ormObj.CreatedAt = &now`)
+	if hasAuditIdentity(ormable) {
+		p.P(`ormObj.CreatedBy = actor`)
+	}
+	p.P(`}`)
+}
@@ -0,0 +1,245 @@
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Enum storage modes.
+const (
+	// EnumsInt stores enum fields as their int32 value.
+	EnumsInt = "int"
+	// EnumsString stores enum fields as their string name.
+	EnumsString = "string"
+)
+
+// Database engines with dialect specific support.
+const (
+	EnginePostgres  = "postgres"
+	EngineMySQL     = "mysql"
+	EngineSQLite    = "sqlite"
+	EngineSQLServer = "sqlserver"
+)
+
+const (
+	defaultSuffix   = ".pb.gorm.go"
+	defaultIdentity = "github.com/edhaight/protoc-gen-gorm/identity.FromContext"
+)
+
+// Config holds the generator options passed in the --gorm_out parameter,
+// e.g. --gorm_out="engine=postgres,enums=string,gateway:{path}".
+type Config struct {
+	// Quiet suppresses warnings.
+	Quiet bool
+	// Enums is the storage mode of enum fields, EnumsInt or EnumsString.
+	Enums string
+	// Gateway generates grpc-gateway aware code (e.g. field mask handling).
+	Gateway bool
+	// Engine is the database engine the generated code is used with.
+	Engine string
+	// Target is the ORM library the generated code is written for.
+	Target string
+	// Suffix is appended to the proto file name to build the output file name.
+	Suffix string
+	// DDL generates a .sql file holding the CREATE statements of the tables
+	// of the ormable types, next to the generated code.
+	DDL bool
+	// Previous is the path of a FileDescriptorSet of the previous version of
+	// the proto files, the schema changes since then are generated as up and
+	// down migrations.
+	Previous string
+	// Identity is the function returning the identity of the caller recorded
+	// in the CreatedBy and UpdatedBy audit columns, as import/path.Func.
+	Identity string
+}
+
+// NewConfig returns a Config holding the default options.
+func NewConfig() *Config {
+	return &Config{
+		Enums:    EnumsString,
+		Target:   TargetJinzhu,
+		Suffix:   defaultSuffix,
+		Identity: defaultIdentity,
+	}
+}
+
+type configParam struct {
+	usage string
+	set   func(c *Config, value string) error
+}
+
+var configParams = map[string]configParam{
+	"quiet": {
+		usage: "suppress warnings",
+		set: func(c *Config, value string) (err error) {
+			c.Quiet, err = parseBoolParam(value)
+			return
+		},
+	},
+	"enums": {
+		usage: "enum storage mode: int or string",
+		set: func(c *Config, value string) error {
+			switch strings.ToLower(value) {
+			case EnumsInt, "false":
+				c.Enums = EnumsInt
+			case EnumsString, "", "true":
+				c.Enums = EnumsString
+			default:
+				return fmt.Errorf("must be %q or %q", EnumsInt, EnumsString)
+			}
+			return nil
+		},
+	},
+	"gateway": {
+		usage: "generate grpc-gateway aware code",
+		set: func(c *Config, value string) (err error) {
+			c.Gateway, err = parseBoolParam(value)
+			return
+		},
+	},
+	"engine": {
+		usage: "database engine: postgres, mysql, sqlite or sqlserver",
+		set: func(c *Config, value string) error {
+			switch strings.ToLower(value) {
+			case EnginePostgres, "postgresql":
+				c.Engine = EnginePostgres
+			case EngineMySQL:
+				c.Engine = EngineMySQL
+			case EngineSQLite, "sqlite3":
+				c.Engine = EngineSQLite
+			case EngineSQLServer, "mssql":
+				c.Engine = EngineSQLServer
+			default:
+				return fmt.Errorf("unsupported engine %q", value)
+			}
+			return nil
+		},
+	},
+	"orm": {
+		usage: "ORM library targeted by the generated code: jinzhu or gormv2",
+		set: func(c *Config, value string) error {
+			switch value {
+			case TargetJinzhu, TargetGormV2:
+				c.Target = value
+			default:
+				return fmt.Errorf("must be %q or %q", TargetJinzhu, TargetGormV2)
+			}
+			return nil
+		},
+	},
+	"suffix": {
+		usage: "output file suffix, .pb.gorm.go by default",
+		set: func(c *Config, value string) error {
+			if !strings.HasSuffix(value, ".go") || value == ".go" {
+				return fmt.Errorf("%q is not a go file suffix", value)
+			}
+			c.Suffix = value
+			return nil
+		},
+	},
+	"ddl": {
+		usage: "generate the DDL of the ormable types for the engine",
+		set: func(c *Config, value string) (err error) {
+			c.DDL, err = parseBoolParam(value)
+			return
+		},
+	},
+	"previous": {
+		usage: "path of the FileDescriptorSet of the previous version of the proto files to generate migrations from",
+		set: func(c *Config, value string) error {
+			if value == "" {
+				return fmt.Errorf("empty path")
+			}
+			c.Previous = value
+			return nil
+		},
+	},
+	"identity": {
+		usage: "function returning the identity recorded in the audit columns, as import/path.Func",
+		set: func(c *Config, value string) error {
+			if _, _, ok := splitQualifiedName(value); !ok {
+				return fmt.Errorf("%q is not a qualified function name", value)
+			}
+			c.Identity = value
+			return nil
+		},
+	},
+}
+
+// Set applies a single key=value plugin parameter. It is meant to be used as
+// protogen.Options.ParamFunc.
+func (c *Config) Set(name, value string) error {
+	param, ok := configParams[name]
+	if !ok {
+		return fmt.Errorf("unknown parameter %q, known parameters are: %s", name, knownConfigParams())
+	}
+	if err := param.set(c, value); err != nil {
+		return fmt.Errorf("invalid value %q for parameter %q (%s): %s", value, name, param.usage, err)
+	}
+	return nil
+}
+
+// Validate checks that the combination of options is consistent.
+func (c *Config) Validate() error {
+	if c.Enums != EnumsInt && c.Enums != EnumsString {
+		return fmt.Errorf("invalid enum storage mode %q", c.Enums)
+	}
+	if c.Target != TargetJinzhu && c.Target != TargetGormV2 {
+		return fmt.Errorf("unknown orm target %q", c.Target)
+	}
+	if c.Suffix == "" {
+		return fmt.Errorf("empty output file suffix")
+	}
+	if c.DDL && c.Engine == "" {
+		return fmt.Errorf("the ddl parameter needs the engine parameter to be set")
+	}
+	if c.Previous != "" && c.Engine == "" {
+		return fmt.Errorf("the previous parameter needs the engine parameter to be set")
+	}
+	return nil
+}
+
+// StringEnums reports whether enum fields are stored as strings.
+func (c *Config) StringEnums() bool {
+	return c.Enums == EnumsString
+}
+
+// IsPostgres reports whether the generated code targets Postgres.
+func (c *Config) IsPostgres() bool {
+	return c.Engine == EnginePostgres
+}
+
+// DDLSuffix is appended to the proto file name to build the DDL file name,
+// e.g. .pb.gorm.sql for the default suffix.
+func (c *Config) DDLSuffix() string {
+	return strings.TrimSuffix(c.Suffix, ".go") + ".sql"
+}
+
+// splitQualifiedName splits a qualified Go name, e.g. example.com/pkg.Func,
+// into its import path and name.
+func splitQualifiedName(value string) (importPath, name string, ok bool) {
+	i := strings.LastIndex(value, ".")
+	if i <= 0 || i < strings.LastIndex(value, "/") || i == len(value)-1 {
+		return "", "", false
+	}
+	return value[:i], value[i+1:], true
+}
+
+func parseBoolParam(value string) (bool, error) {
+	// a bare key, e.g. "gateway", switches the option on
+	if value == "" {
+		return true, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+func knownConfigParams() string {
+	names := make([]string, 0, len(configParams))
+	for name := range configParams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
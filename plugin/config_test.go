@@ -0,0 +1,119 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConfigSet(t *testing.T) {
+	cases := []struct {
+		name, value string
+		err         string
+		expected    func(c *Config) bool
+	}{
+		{"quiet", "", "", func(c *Config) bool { return c.Quiet }},
+		{"quiet", "false", "", func(c *Config) bool { return !c.Quiet }},
+		{"quiet", "maybe", `invalid value "maybe" for parameter "quiet"`, nil},
+		{"enums", "INT", "", func(c *Config) bool { return c.Enums == EnumsInt }},
+		{"enums", "", "", func(c *Config) bool { return c.Enums == EnumsString }},
+		{"enums", "bytes", `invalid value "bytes" for parameter "enums"`, nil},
+		{"engine", "postgresql", "", func(c *Config) bool { return c.Engine == EnginePostgres }},
+		{"engine", "sqlite3", "", func(c *Config) bool { return c.Engine == EngineSQLite }},
+		{"engine", "mssql", "", func(c *Config) bool { return c.Engine == EngineSQLServer }},
+		{"engine", "oracle", `unsupported engine "oracle"`, nil},
+		{"engine", "", `unsupported engine ""`, nil},
+		{"orm", TargetGormV2, "", func(c *Config) bool { return c.Target == TargetGormV2 }},
+		{"orm", "GORMV2", `invalid value "GORMV2" for parameter "orm"`, nil},
+		{"orm", "", `invalid value "" for parameter "orm"`, nil},
+		{"suffix", ".gorm.go", "", func(c *Config) bool { return c.Suffix == ".gorm.go" }},
+		{"suffix", ".go", `".go" is not a go file suffix`, nil},
+		{"suffix", ".pb.gorm", `".pb.gorm" is not a go file suffix`, nil},
+		{"previous", "", "empty path", nil},
+		{"identity", "example.com/auth.Caller", "", func(c *Config) bool { return c.Identity == "example.com/auth.Caller" }},
+		{"identity", "example.com/auth", `"example.com/auth" is not a qualified function name`, nil},
+		{"engines", "postgres", `unknown parameter "engines", known parameters are: ddl, engine, enums,`, nil},
+		{"", "", `unknown parameter ""`, nil},
+	}
+	for _, v := range cases {
+		t.Run(v.name+"="+v.value, func(t *testing.T) {
+			c := NewConfig()
+			err := c.Set(v.name, v.value)
+			switch {
+			case v.err != "":
+				if err == nil || !strings.Contains(err.Error(), v.err) {
+					t.Errorf("Expected an error containing %q, got %v", v.err, err)
+				}
+			case err != nil:
+				t.Errorf("Unexpected error %v", err)
+			case !v.expected(c):
+				t.Errorf("Unexpected config %+v", *c)
+			}
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	cases := []struct {
+		name   string
+		params [][2]string
+		err    string
+	}{
+		{"defaults", nil, ""},
+		{"ddl with engine", [][2]string{{"ddl", ""}, {"engine", "postgres"}}, ""},
+		{"ddl without engine", [][2]string{{"ddl", ""}}, "the ddl parameter needs the engine parameter to be set"},
+		{"ddl switched off", [][2]string{{"ddl", "false"}}, ""},
+		{"previous with engine", [][2]string{{"previous", "old.pb"}, {"engine", "mysql"}}, ""},
+		{"previous without engine", [][2]string{{"previous", "old.pb"}}, "the previous parameter needs the engine parameter to be set"},
+	}
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			c := NewConfig()
+			for _, param := range v.params {
+				if err := c.Set(param[0], param[1]); err != nil {
+					t.Fatal(err)
+				}
+			}
+			err := c.Validate()
+			switch {
+			case v.err == "" && err != nil:
+				t.Errorf("Unexpected error %v", err)
+			case v.err != "" && (err == nil || err.Error() != v.err):
+				t.Errorf("Expected the error %q, got %v", v.err, err)
+			}
+		})
+	}
+
+	// options set without Set, e.g. by a caller building the Config
+	for _, c := range []*Config{
+		{Enums: "bytes", Target: TargetJinzhu, Suffix: defaultSuffix},
+		{Enums: EnumsInt, Target: "gorm", Suffix: defaultSuffix},
+		{Enums: EnumsInt, Target: TargetGormV2},
+	} {
+		if err := c.Validate(); err == nil {
+			t.Errorf("Expected an error for %+v", *c)
+		}
+	}
+}
+
+func TestSplitQualifiedName(t *testing.T) {
+	cases := []struct {
+		value            string
+		importPath, name string
+		ok               bool
+	}{
+		{"example.com/auth.Caller", "example.com/auth", "Caller", true},
+		{"example.com/v2/auth.Caller", "example.com/v2/auth", "Caller", true},
+		{"auth.Caller", "auth", "Caller", true},
+		{"example.com/auth", "", "", false},
+		{"example.com/auth.", "", "", false},
+		{".Caller", "", "", false},
+		{"Caller", "", "", false},
+		{"", "", "", false},
+	}
+	for _, v := range cases {
+		importPath, name, ok := splitQualifiedName(v.value)
+		if importPath != v.importPath || name != v.name || ok != v.ok {
+			t.Errorf("Expected (%q, %q, %v) for %q, got (%q, %q, %v)", v.importPath, v.name, v.ok, v.value, importPath, name, ok)
+		}
+	}
+}
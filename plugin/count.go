@@ -0,0 +1,113 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// totalSizeNames are the names of the fields of the list responses holding the
+// total count of the rows.
+var totalSizeNames = map[protoreflect.Name]bool{"total_size": true, "total_count": true}
+
+// getTotalSize returns the name and the type of the integer field of a list
+// response holding the total count of the rows.
+func (p *OrmPlugin) getTotalSize(object *protogen.Message) (string, string) {
+	for _, field := range object.Fields {
+		if !totalSizeNames[field.Desc.Name()] || field.Desc.IsList() {
+			continue
+		}
+		switch field.Desc.Kind() {
+		case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Uint32Kind, protoreflect.Uint64Kind,
+			protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+			return field.GoName, p.fieldType(field)
+		}
+	}
+	return "", ""
+}
+
+// listHasCount reports whether the list verb of ormable counts the rows, i.e.
+// its response has a total_size field, or the PageInfo of a limit/offset
+// paginated list.
+func (p *OrmPlugin) listHasCount(ormable *OrmableType, verb string) bool {
+	method, ok := ormable.Methods[verb]
+	if !ok {
+		return false
+	}
+	if name, _ := p.getTotalSize(method.outType); name != "" {
+		return true
+	}
+	return p.listHasPagination(ormable, verb) && p.getPageInfo(method.outType) != ""
+}
+
+// countHandlerName returns the name of the handler counting the rows listed
+// by the list verb, e.g. DefaultCountDeletedUser for ListDeleted.
+func countHandlerName(verb, typeName string) string {
+	return "DefaultCount" + strings.TrimPrefix(verb, listService) + typeName
+}
+
+// generateCountHandler outputs the handler counting the rows listed by the
+// list verb with the same filtering, and the hook replacing the count.
+func (p *OrmPlugin) generateCountHandler(message *protogen.Message, verb string) {
+	typeName := p.messageType(message)
+	ormable := p.getOrmable(typeName)
+	if !p.listHasCount(ormable, verb) {
+		return
+	}
+	handlerName := countHandlerName(verb, typeName)
+	hookName := verb + "Count"
+	p.P(`// `, handlerName, ` returns the count of the rows Default`, verb, typeName, ` lists, without`)
+	p.P(`// ordering and paging them`)
+	countSign := fmt.Sprint(`func `, handlerName, `(ctx `, p.qualifiedGoIdent(identCtx), `, db `, p.qualifiedGoIdentPtr(p.identGormDB))
+	hookSign := fmt.Sprint(hookName, `(`, p.qualifiedGoIdent(identCtx), `, `, p.qualifiedGoIdentPtr(p.identGormDB))
+	f, hookArgs := "nil", ""
+	if p.listHasFiltering(ormable, verb) {
+		countSign += fmt.Sprint(`, f `, p.qualifiedGoIdentPtr(p.identQueryFiltering))
+		hookSign += fmt.Sprint(`, `, p.qualifiedGoIdentPtr(p.identQueryFiltering))
+		f, hookArgs = "f", ", f"
+	}
+	p.P(countSign, `) (int64, error) {`)
+	p.P(`in := `, typeName, `{}`)
+	p.P(`ormObj, err := in.ToORM(ctx)`)
+	p.P(`if err != nil {`)
+	p.P(`return 0, err`)
+	p.P(`}`)
+	p.P(`if hook, ok := interface{}(&ormObj).(`, ormable.Name, `With`, hookName, `); ok {`)
+	p.P(`if count, ok, err := hook.`, hookName, `(ctx, db`, hookArgs, `); err != nil || ok {`)
+	p.P(`return count, err`)
+	p.P(`}`)
+	p.P(`}`)
+	p.generateApplyCollectionOperators(ormable, f, "nil", "nil", "nil", `return 0, `)
+	p.P(`db = db.Where(&ormObj)`)
+	if verb == listDeletedService {
+		p.P(`db = db.Unscoped().Where("`, deletedAtColumn(ormable), ` IS NOT NULL")`)
+	}
+	p.P(`var count int64`)
+	p.P(`if err := db.Model(&`, ormable.Name, `{}).Count(&count).Error; err != nil {`)
+	p.generateDBErrorReturn(`return 0, `)
+	p.P(`}`)
+	p.P(`return count, nil`)
+	p.P(`}`)
+	p.P()
+	p.P(`// `, ormable.Name, `With`, hookName, ` replaces the count of `, handlerName, ` when ok, e.g.`)
+	p.P(`// to skip counting or to estimate the count of huge tables`)
+	p.P(`type `, ormable.Name, `With`, hookName, ` interface {`)
+	p.P(hookSign, `) (count int64, ok bool, err error)`)
+	p.P(`}`)
+	p.P()
+}
+
+// generateCountServerCall outputs the call of the count handler of the list
+// method with the filtering f into the count variable.
+func (p *OrmPlugin) generateCountServerCall(service autogenService, method autogenMethod, f string) {
+	handlerCall := fmt.Sprint(`count, err := `, countHandlerName(method.verb, method.baseType), `(ctx, db`)
+	if f != "" {
+		handlerCall += fmt.Sprint(",", f)
+	}
+	p.P(handlerCall, `)`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
+	p.P(`}`)
+}
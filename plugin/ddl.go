@@ -0,0 +1,353 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// sqlDialect holds what the DDL of the supported database engines differs in.
+type sqlDialect struct {
+	engine     string
+	quoteOpen  string
+	quoteClose string
+	// columnTypes are the column types of the Go types of the ORM structs
+	columnTypes map[string]string
+	// sizedString is the column type of a string with a size set
+	sizedString string
+	// typeAliases replace the postgres column types set by the plugin which
+	// the engine does not have
+	typeAliases map[string]string
+	// inlineForeignKeys is set for the engines which cannot add a foreign key
+	// to an existing table
+	inlineForeignKeys bool
+}
+
+var sqlDialects = map[string]*sqlDialect{
+	EnginePostgres: {
+		engine:    EnginePostgres,
+		quoteOpen: `"`, quoteClose: `"`,
+		columnTypes: map[string]string{
+			"bool": "boolean",
+			"int":  "integer", "int8": "smallint", "int16": "smallint", "int32": "integer", "int64": "bigint",
+			"uint": "integer", "uint8": "smallint", "uint16": "integer", "uint32": "bigint", "uint64": "bigint",
+			"float32": "real", "float64": "double precision",
+			"string":                         "text",
+			"[]byte":                         "bytea",
+			"time.Time":                      "timestamp with time zone",
+			"gorm.io/gorm.DeletedAt":         "timestamp with time zone",
+			"time.Duration":                  "bigint",
+			"github.com/satori/go.uuid.UUID": "uuid",
+			"github.com/edhaight/protoc-gen-gorm/types.Inet":     "inet",
+			"github.com/edhaight/protoc-gen-gorm/types.Interval": "interval",
+			"github.com/jinzhu/gorm/dialects/postgres.Jsonb":     "jsonb",
+			"gorm.io/datatypes.JSON":                             "jsonb",
+		},
+		sizedString: "varchar(%d)",
+	},
+	EngineMySQL: {
+		engine:    EngineMySQL,
+		quoteOpen: "`", quoteClose: "`",
+		columnTypes: map[string]string{
+			"bool": "boolean",
+			"int":  "int", "int8": "tinyint", "int16": "smallint", "int32": "int", "int64": "bigint",
+			"uint": "int unsigned", "uint8": "tinyint unsigned", "uint16": "smallint unsigned", "uint32": "int unsigned", "uint64": "bigint unsigned",
+			"float32": "float", "float64": "double",
+			"string":                         "varchar(255)",
+			"[]byte":                         "longblob",
+			"time.Time":                      "datetime(6)",
+			"gorm.io/gorm.DeletedAt":         "datetime(6)",
+			"time.Duration":                  "bigint",
+			"github.com/satori/go.uuid.UUID": "char(36)",
+			"github.com/edhaight/protoc-gen-gorm/types.Inet": "varchar(45)",
+			"github.com/jinzhu/gorm/dialects/postgres.Jsonb": "json",
+			"gorm.io/datatypes.JSON":                         "json",
+		},
+		sizedString: "varchar(%d)",
+		typeAliases: map[string]string{"jsonb": "json", "bytea": "longblob", "uuid": "char(36)", "inet": "varchar(45)"},
+	},
+	EngineSQLite: {
+		engine:    EngineSQLite,
+		quoteOpen: `"`, quoteClose: `"`,
+		columnTypes: map[string]string{
+			"bool": "boolean",
+			"int":  "integer", "int8": "integer", "int16": "integer", "int32": "integer", "int64": "bigint",
+			"uint": "integer", "uint8": "integer", "uint16": "integer", "uint32": "integer", "uint64": "bigint",
+			"float32": "real", "float64": "real",
+			"string":                         "text",
+			"[]byte":                         "blob",
+			"time.Time":                      "datetime",
+			"gorm.io/gorm.DeletedAt":         "datetime",
+			"time.Duration":                  "bigint",
+			"github.com/satori/go.uuid.UUID": "text",
+			"github.com/edhaight/protoc-gen-gorm/types.Inet": "text",
+			"github.com/jinzhu/gorm/dialects/postgres.Jsonb": "text",
+			"gorm.io/datatypes.JSON":                         "text",
+		},
+		sizedString:       "varchar(%d)",
+		typeAliases:       map[string]string{"jsonb": "text", "bytea": "blob", "uuid": "text", "inet": "text"},
+		inlineForeignKeys: true,
+	},
+	EngineSQLServer: {
+		engine:    EngineSQLServer,
+		quoteOpen: "[", quoteClose: "]",
+		columnTypes: map[string]string{
+			"bool": "bit",
+			"int":  "int", "int8": "smallint", "int16": "smallint", "int32": "int", "int64": "bigint",
+			"uint": "int", "uint8": "smallint", "uint16": "int", "uint32": "bigint", "uint64": "bigint",
+			"float32": "real", "float64": "float",
+			"string":                         "nvarchar(max)",
+			"[]byte":                         "varbinary(max)",
+			"time.Time":                      "datetimeoffset",
+			"gorm.io/gorm.DeletedAt":         "datetimeoffset",
+			"time.Duration":                  "bigint",
+			"github.com/satori/go.uuid.UUID": "uniqueidentifier",
+			"github.com/edhaight/protoc-gen-gorm/types.Inet": "nvarchar(45)",
+			"github.com/jinzhu/gorm/dialects/postgres.Jsonb": "nvarchar(max)",
+			"gorm.io/datatypes.JSON":                         "nvarchar(max)",
+		},
+		sizedString: "nvarchar(%d)",
+		typeAliases: map[string]string{
+			"jsonb": "nvarchar(max)", "bytea": "varbinary(max)", "uuid": "uniqueidentifier",
+			"inet": "nvarchar(45)", "boolean": "bit", "text": "nvarchar(max)",
+		},
+	},
+}
+
+func (d *sqlDialect) quote(name string) string {
+	return d.quoteOpen + name + d.quoteClose
+}
+
+func (d *sqlDialect) quoteAll(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = d.quote(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// columnType returns the column type of field: the type set in its tag, or
+// else the one of its Go type. It returns an empty string for an unknown Go
+// type.
+func (d *sqlDialect) columnType(field *Field) string {
+	tag := field.GetTag()
+	if typ := tag.GetType(); typ != "" {
+		if alias, ok := d.typeAliases[strings.ToLower(typ)]; ok {
+			return alias
+		}
+		return typ
+	}
+	goType := fieldGoType(field)
+	if goType == "string" && tag.GetSize() > 0 {
+		return fmt.Sprintf(d.sizedString, tag.GetSize())
+	}
+	return d.columnTypes[goType]
+}
+
+// columnDefinition returns the definition of column in a CREATE TABLE
+// statement. inlinePrimaryKey is set when the primary key is declared with
+// the column rather than with the table.
+func (d *sqlDialect) columnDefinition(column *sqlColumn, inlinePrimaryKey bool) string {
+	typ := column.typ
+	var constraints []string
+	if column.notNull {
+		constraints = append(constraints, "NOT NULL")
+	}
+	if inlinePrimaryKey {
+		constraints = append(constraints, "PRIMARY KEY")
+	}
+	if column.autoIncrement {
+		switch d.engine {
+		case EnginePostgres:
+			switch typ {
+			case "smallint", "integer":
+				typ = "serial"
+			case "bigint":
+				typ = "bigserial"
+			}
+		case EngineMySQL:
+			constraints = append(constraints, "AUTO_INCREMENT")
+		case EngineSQLite:
+			// sqlite only auto increments an integer primary key
+			if inlinePrimaryKey {
+				typ = "integer"
+				constraints = append(constraints, "AUTOINCREMENT")
+			}
+		case EngineSQLServer:
+			constraints = append(constraints, "IDENTITY(1,1)")
+		}
+	}
+	if column.unique {
+		constraints = append(constraints, "UNIQUE")
+	}
+	if column.defaultValue != "" {
+		constraints = append(constraints, "DEFAULT "+column.defaultValue)
+	}
+	return strings.TrimSpace(d.quote(column.name) + " " + typ + " " + strings.Join(constraints, " "))
+}
+
+// createTable returns the CREATE TABLE statement of table, along with the
+// CREATE INDEX statements of its indexes.
+func (d *sqlDialect) createTable(table *sqlTable) []string {
+	// sqlite auto increments a primary key declared with its column only
+	inlinePrimaryKey := d.engine == EngineSQLite && len(table.primaryKey) == 1
+	var definitions []string
+	for _, column := range table.columns {
+		definitions = append(definitions, d.columnDefinition(column, inlinePrimaryKey && column.name == table.primaryKey[0]))
+	}
+	if len(table.primaryKey) > 0 && !inlinePrimaryKey {
+		definitions = append(definitions, fmt.Sprintf("PRIMARY KEY (%s)", d.quoteAll(table.primaryKey)))
+	}
+	if d.inlineForeignKeys {
+		for _, fk := range table.foreignKeys {
+			definitions = append(definitions, d.foreignKeyDefinition(fk))
+		}
+	}
+	statements := []string{
+		fmt.Sprintf("CREATE TABLE %s (\n  %s\n);", d.quote(table.name), strings.Join(definitions, ",\n  ")),
+	}
+	for _, index := range table.indexes {
+		statements = append(statements, d.createIndex(table, index))
+	}
+	return statements
+}
+
+func (d *sqlDialect) createIndex(table *sqlTable, index *sqlIndex) string {
+	create := "CREATE INDEX"
+	if index.unique {
+		create = "CREATE UNIQUE INDEX"
+	}
+	return fmt.Sprintf("%s %s ON %s (%s);", create, d.quote(index.name), d.quote(table.name), d.quoteAll(index.columns))
+}
+
+func (d *sqlDialect) foreignKeyDefinition(fk *sqlForeignKey) string {
+	return fmt.Sprintf("CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)",
+		d.quote(fk.name), d.quoteAll(fk.columns), d.quote(fk.refTable), d.quoteAll(fk.refColumns))
+}
+
+// addForeignKeys returns the ALTER TABLE statements adding the foreign keys
+// of table, they are run once all the tables exist.
+func (d *sqlDialect) addForeignKeys(table *sqlTable) []string {
+	if d.inlineForeignKeys {
+		return nil
+	}
+	var statements []string
+	for _, fk := range table.foreignKeys {
+		statements = append(statements, d.addForeignKey(table.name, fk))
+	}
+	return statements
+}
+
+func (d *sqlDialect) addForeignKey(table string, fk *sqlForeignKey) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD %s;", d.quote(table), d.foreignKeyDefinition(fk))
+}
+
+func (d *sqlDialect) dropForeignKey(table string, fk *sqlForeignKey) string {
+	if d.engine == EngineMySQL {
+		return fmt.Sprintf("ALTER TABLE %s DROP FOREIGN KEY %s;", d.quote(table), d.quote(fk.name))
+	}
+	return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;", d.quote(table), d.quote(fk.name))
+}
+
+func (d *sqlDialect) dropIndex(table string, index *sqlIndex) string {
+	switch d.engine {
+	case EngineMySQL, EngineSQLServer:
+		return fmt.Sprintf("DROP INDEX %s ON %s;", d.quote(index.name), d.quote(table))
+	}
+	return fmt.Sprintf("DROP INDEX %s;", d.quote(index.name))
+}
+
+func (d *sqlDialect) dropTable(table string) string {
+	return fmt.Sprintf("DROP TABLE %s;", d.quote(table))
+}
+
+func (d *sqlDialect) renameTable(from, to string) string {
+	switch d.engine {
+	case EngineMySQL:
+		return fmt.Sprintf("RENAME TABLE %s TO %s;", d.quote(from), d.quote(to))
+	case EngineSQLServer:
+		return fmt.Sprintf("EXEC sp_rename '%s', '%s';", from, to)
+	}
+	return fmt.Sprintf("ALTER TABLE %s RENAME TO %s;", d.quote(from), d.quote(to))
+}
+
+func (d *sqlDialect) addColumn(table string, column *sqlColumn) string {
+	if d.engine == EngineSQLServer {
+		return fmt.Sprintf("ALTER TABLE %s ADD %s;", d.quote(table), d.columnDefinition(column, false))
+	}
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", d.quote(table), d.columnDefinition(column, false))
+}
+
+func (d *sqlDialect) dropColumn(table string, column string) string {
+	return fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", d.quote(table), d.quote(column))
+}
+
+// alterColumn returns the statements changing the type, nullability and
+// default of column from to to. It returns false when the engine cannot
+// alter a column in place.
+func (d *sqlDialect) alterColumn(table string, from, to *sqlColumn) ([]string, bool) {
+	alter := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s", d.quote(table), d.quote(to.name))
+	var statements []string
+	switch d.engine {
+	case EnginePostgres:
+		if from.typ != to.typ {
+			statements = append(statements, fmt.Sprintf("%s TYPE %s USING %s::%s;", alter, to.typ, d.quote(to.name), to.typ))
+		}
+		if from.notNull != to.notNull {
+			if to.notNull {
+				statements = append(statements, alter+" SET NOT NULL;")
+			} else {
+				statements = append(statements, alter+" DROP NOT NULL;")
+			}
+		}
+		if from.defaultValue != to.defaultValue {
+			if to.defaultValue != "" {
+				statements = append(statements, alter+" SET DEFAULT "+to.defaultValue+";")
+			} else {
+				statements = append(statements, alter+" DROP DEFAULT;")
+			}
+		}
+	case EngineMySQL:
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s;", d.quote(table), d.columnDefinition(to, false)))
+	case EngineSQLServer:
+		if from.defaultValue != to.defaultValue {
+			// defaults are constraints named by the server
+			return nil, false
+		}
+		null := "NULL"
+		if to.notNull {
+			null = "NOT NULL"
+		}
+		statements = append(statements, fmt.Sprintf("%s %s %s;", alter, to.typ, null))
+	default:
+		return nil, false
+	}
+	return statements, true
+}
+
+// generateDDL outputs the statements creating the tables of the ormable types
+// of file in a .sql file next to the generated code.
+func (p *OrmPlugin) generateDDL(file *protogen.File) {
+	dialect := sqlDialects[p.Engine]
+	tables := p.buildSchema(file, dialect)
+	if len(tables) == 0 {
+		return
+	}
+	g := p.NewGeneratedFile(file.GeneratedFilenamePrefix+p.DDLSuffix(), file.GoImportPath)
+	g.P("-- Code generated by protoc-gen-gorm. DO NOT EDIT.")
+	g.P("-- source: ", file.Desc.Path())
+	g.P("-- engine: ", dialect.engine)
+	for _, table := range tables {
+		for _, statement := range dialect.createTable(table) {
+			g.P()
+			g.P(statement)
+		}
+	}
+	for _, table := range tables {
+		for _, statement := range dialect.addForeignKeys(table) {
+			g.P()
+			g.P(statement)
+		}
+	}
+}
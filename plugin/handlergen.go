@@ -2,35 +2,49 @@ package plugin
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
-	jgorm "github.com/jinzhu/gorm"
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
 func (p *OrmPlugin) generateDefaultHandlers(file *protogen.File) {
 	for _, message := range file.Messages {
 		if getMessageOptions(message).GetOrmable() {
+			p.generateTransactionHelper(p.getOrmable(message.GoIdent.GoName))
+			p.generateHistoryHelpers(p.getOrmable(message.GoIdent.GoName))
 			p.generateCreateHandler(message)
-			// FIXME: Temporary fix for Ormable objects that have no ID field but
-			// have pk.
-			if p.hasPrimaryKey(p.getOrmable(message.GoIdent.GoName)) && p.hasIDField(message) {
+			p.generateCreateSetHandler(message)
+			p.generateUpsertHandler(message)
+			if ormable := p.getOrmable(message.GoIdent.GoName); p.hasPrimaryKey(ormable) && p.hasMessagePrimaryKey(message) {
 				p.generateReadHandler(message)
 				p.generateDeleteHandler(message)
 				p.generateDeleteSetHandler(message)
 				p.generateStrictUpdateHandler(message)
 				p.generatePatchHandler(message)
 				p.generatePatchSetHandler(message)
+				if getMessageOptions(message).GetSoftDelete() {
+					p.generateRestoreHandler(message)
+					p.generateHardDeleteHandler(message)
+				}
+				p.generateListHistoryHandler(message)
 			}
 
 			p.generateApplyFieldMask(message)
-			p.generateListHandler(message)
+			p.generateQueryColumnMethod(p.getOrmable(message.GoIdent.GoName))
+			p.generateQueryBuilder(p.getOrmable(message.GoIdent.GoName))
+			p.generateListHandler(message, listService)
+			p.generateCountHandler(message, listService)
+			if getMessageOptions(message).GetSoftDelete() {
+				p.generateListHandler(message, listDeletedService)
+				p.generateCountHandler(message, listDeletedService)
+			}
 		}
 	}
 }
 
 type hookVerb interface {
-	defReturnType(f *protogen.GeneratedFile) string
+	defReturnType(p *OrmPlugin) string
 	callReturnVar() string
 	kind() string
 }
@@ -39,20 +53,20 @@ type beforeHookVerb struct{}
 
 func (b beforeHookVerb) callReturnVar() string { return `db, err` }
 func (b beforeHookVerb) kind() string          { return "Before" }
-func (b beforeHookVerb) defReturnType(f *protogen.GeneratedFile) string {
-	return `(*` + f.QualifiedGoIdent(identGormDB) + `, error)`
+func (b beforeHookVerb) defReturnType(p *OrmPlugin) string {
+	return `(*` + p.currentFile.QualifiedGoIdent(p.identGormDB) + `, error)`
 }
 
 type afterHookVerb struct{}
 
 func (a afterHookVerb) callReturnVar() string { return `err` }
 func (a afterHookVerb) kind() string          { return "After" }
-func (a afterHookVerb) defReturnType(f *protogen.GeneratedFile) string {
+func (a afterHookVerb) defReturnType(p *OrmPlugin) string {
 	return `error`
 }
 
 func (p *OrmPlugin) generateAccountIdWhereClause() {
-	p.P(`accountID, err := `, identGetAccountIDFn, `(ctx, nil)`)
+	p.P(`accountID, err := `, p.identGetAccountIDFn, `(ctx, nil)`)
 	p.P(`if err != nil {`)
 	p.P(`return nil, err`)
 	p.P(`}`)
@@ -61,7 +75,7 @@ func (p *OrmPlugin) generateAccountIdWhereClause() {
 
 func (p *OrmPlugin) generateHookDefHelper(orm *OrmableType, verb hookVerb, returnDB bool, method string) {
 	p.P(`type `, orm.Name, `With`, verb.kind(), method, ` interface {`)
-	p.P(verb.kind(), method, `(`, identCtx, `, *`, identGormDB, `) `, verb.defReturnType(p.currentFile))
+	p.P(verb.kind(), method, `(`, identCtx, `, *`, p.identGormDB, `) `, verb.defReturnType(p))
 	p.P(`}`)
 }
 func (p *OrmPlugin) generateBeforeHookDef(orm *OrmableType, method string) {
@@ -93,19 +107,22 @@ func (p *OrmPlugin) generateCreateHandler(message *protogen.Message) {
 	orm := p.getOrmable(typeName)
 	p.P(`// DefaultCreate`, typeName, ` executes a basic gorm create call`)
 	p.P(`func DefaultCreate`, typeName, `(ctx `, identCtx, `, in *`,
-		typeName, `, db *`, identGormDB, `) (*`, typeName, `, error) {`)
+		typeName, `, db *`, p.identGormDB, `) (*`, typeName, `, error) {`)
 	p.P(`if in == nil {`)
 	p.P(`return nil, `, identNilArgumentError)
 	p.P(`}`)
+	p.generateHistoryTransaction(orm, `DefaultCreate`+typeName+`(ctx, in, tx)`, `*`+typeName)
 	p.P(`ormObj, err := in.ToORM(ctx)`)
 	p.P(`if err != nil {`)
 	p.P(`return nil, err`)
 	p.P(`}`)
+	p.generateCreateAudit(orm)
 	create := "Create_"
 	p.generateBeforeHookCall(orm, create)
 	p.P(`if err = db.Create(&ormObj).Error; err != nil {`)
-	p.P(`return nil, err`)
+	p.generateDBErrorReturn(`return nil, `)
 	p.P(`}`)
+	p.generateHistoryWrite(orm, strconv.Quote(historyCreate), `nil`, `&ormObj`, `return nil, `)
 	p.generateAfterHookCall(orm, create)
 	p.P(`pbResponse, err := ormObj.ToPB(ctx)`)
 	p.P(`return &pbResponse, err`)
@@ -114,6 +131,90 @@ func (p *OrmPlugin) generateCreateHandler(message *protogen.Message) {
 	p.generateAfterHookDef(orm, create)
 }
 
+// generateCreateSetHandler outputs the handler creating a set of objects in a
+// transaction, and its hooks.
+func (p *OrmPlugin) generateCreateSetHandler(message *protogen.Message) {
+	typeName := message.GoIdent.GoName
+	orm := p.getOrmable(typeName)
+	p.P(`// DefaultCreate`, typeName, `Set executes a bulk gorm create call in a transaction,`)
+	if p.isGormV2() {
+		p.P(`// inserting the objects in batches of batchSize, all at once when 0`)
+	} else {
+		p.P(`// inserting the objects one by one as jinzhu/gorm has no batch insert, batchSize`)
+		p.P(`// is kept for compatibility with gorm v2`)
+	}
+	p.P(`func DefaultCreate`, typeName, `Set(ctx `, identCtx, `, in []*`, typeName, `, batchSize int, db *`, p.identGormDB, `) ([]*`, typeName, `, error) {`)
+	p.P(`if in == nil {`)
+	p.P(`return nil, `, identNilArgumentError)
+	p.P(`}`)
+	p.generateTransaction(orm, `DefaultCreate`+typeName+`Set(ctx, in, batchSize, tx)`, `[]*`+typeName)
+	p.P(`ormObjs := make([]*`, orm.Name, `, 0, len(in))`)
+	p.P(`for _, obj := range in {`)
+	p.P(`if obj == nil {`)
+	p.P(`return nil, `, identNilArgumentError)
+	p.P(`}`)
+	p.P(`ormObj, err := obj.ToORM(ctx)`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.generateCreateAudit(orm)
+	p.P(`ormObjs = append(ormObjs, &ormObj)`)
+	p.P(`}`)
+	p.P(`var err error`)
+	p.P(`if hook, ok := interface{}(&`, orm.Name, `{}).(`, orm.Name, `WithBeforeCreateSet); ok {`)
+	p.P(`if db, err = hook.BeforeCreateSet(ctx, ormObjs, db); err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.P(`}`)
+	if p.isGormV2() {
+		p.P(`if batchSize <= 0 {`)
+		p.P(`batchSize = len(ormObjs)`)
+		p.P(`}`)
+		p.P(`if len(ormObjs) > 0 {`)
+		p.P(`if err = db.CreateInBatches(ormObjs, batchSize).Error; err != nil {`)
+		p.generateDBErrorReturn(`return nil, `)
+		p.P(`}`)
+		p.P(`}`)
+	} else {
+		p.P(`for _, ormObj := range ormObjs {`)
+		p.P(`if err = db.Create(ormObj).Error; err != nil {`)
+		p.generateDBErrorReturn(`return nil, `)
+		p.P(`}`)
+		p.P(`}`)
+	}
+	if hasHistory(orm) {
+		p.P(`for _, ormObj := range ormObjs {`)
+		p.generateHistoryWrite(orm, strconv.Quote(historyCreate), `nil`, `ormObj`, `return nil, `)
+		p.P(`}`)
+	}
+	p.P(`if hook, ok := interface{}(&`, orm.Name, `{}).(`, orm.Name, `WithAfterCreateSet); ok {`)
+	p.P(`if err = hook.AfterCreateSet(ctx, ormObjs, db); err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`pbResponse := make([]*`, typeName, `, 0, len(ormObjs))`)
+	p.P(`for _, ormObj := range ormObjs {`)
+	p.P(`pbObj, err := ormObj.ToPB(ctx)`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.P(`pbResponse = append(pbResponse, &pbObj)`)
+	p.P(`}`)
+	p.P(`return pbResponse, nil`)
+	p.P(`}`)
+	p.P()
+	p.P(`// `, orm.Name, `WithBeforeCreateSet called before DefaultCreate`, typeName, `Set inserts the objects`)
+	p.P(`type `, orm.Name, `WithBeforeCreateSet interface {`)
+	p.P(`BeforeCreateSet(`, identCtx, `, []*`, orm.Name, `, `, p.qualifiedGoIdentPtr(p.identGormDB), `) (`, p.qualifiedGoIdentPtr(p.identGormDB), `, error)`)
+	p.P(`}`)
+	p.P()
+	p.P(`// `, orm.Name, `WithAfterCreateSet called after DefaultCreate`, typeName, `Set inserts the objects`)
+	p.P(`type `, orm.Name, `WithAfterCreateSet interface {`)
+	p.P(`AfterCreateSet(`, identCtx, `, []*`, orm.Name, `, `, p.qualifiedGoIdentPtr(p.identGormDB), `) error`)
+	p.P(`}`)
+	p.P()
+}
+
 func (p *OrmPlugin) generateReadHandler(message *protogen.Message) {
 	typeName := message.GoIdent.GoName
 	ident := message.GoIdent
@@ -122,10 +223,10 @@ func (p *OrmPlugin) generateReadHandler(message *protogen.Message) {
 	// Different behavior if there is a
 	if p.readHasFieldSelection(ormable) {
 		p.P(`func DefaultRead`, ident, `(ctx `, identCtx, `, in `,
-			p.qualifiedGoIdentPtr(ident), `, db `, p.qualifiedGoIdentPtr(identGormDB), `, fs `, p.qualifiedGoIdentPtr(identQueryFieldSelection), `) (`, p.qualifiedGoIdentPtr(ident), `, error) {`)
+			p.qualifiedGoIdentPtr(ident), `, db `, p.qualifiedGoIdentPtr(p.identGormDB), `, fs `, p.qualifiedGoIdentPtr(p.identQueryFieldSelection), `) (`, p.qualifiedGoIdentPtr(ident), `, error) {`)
 	} else {
 		p.P(`func DefaultRead`, ident, `(ctx `, identCtx, `, in `,
-			p.qualifiedGoIdentPtr(ident), `, db `, p.qualifiedGoIdentPtr(identGormDB), `) (`, p.qualifiedGoIdentPtr(ident), `, error) {`)
+			p.qualifiedGoIdentPtr(ident), `, db `, p.qualifiedGoIdentPtr(p.identGormDB), `) (`, p.qualifiedGoIdentPtr(ident), `, error) {`)
 	}
 	p.P(`if in == nil {`)
 	p.P(`return nil, `, identNilArgumentError)
@@ -135,14 +236,7 @@ func (p *OrmPlugin) generateReadHandler(message *protogen.Message) {
 	p.P(`if err != nil {`)
 	p.P(`return nil, err`)
 	p.P(`}`)
-	k, f := p.findPrimaryKey(ormable)
-	if strings.Contains(f.F.GoIdent.GoName, "*") {
-		p.P(`if ormObj.`, k, ` == nil || *ormObj.`, k, ` == `, p.guessZeroValue(f.F.GoIdent.GoName), ` {`)
-	} else {
-		p.P(`if ormObj.`, k, ` == `, p.guessZeroValue(f.F.GoIdent.GoName), ` {`)
-	}
-	p.P(`return nil, `, identEmptyIDError)
-	p.P(`}`)
+	p.generateEmptyPrimaryKeyCheck(ormable, `return nil, `)
 
 	var fs string
 	if p.readHasFieldSelection(ormable) {
@@ -152,14 +246,12 @@ func (p *OrmPlugin) generateReadHandler(message *protogen.Message) {
 	}
 
 	p.generateBeforeReadHookCall(ormable, "ApplyQuery")
-	p.P(`if db, err = `, identApplyFieldSelectionFn, `(ctx, db, `, fs, `, &`, ormable.Name, `{}); err != nil {`)
-	p.P(`return nil, err`)
-	p.P(`}`)
+	p.generateApplyFieldSelection(ormable, fs, `return nil, `)
 
 	p.generateBeforeReadHookCall(ormable, "Find")
 	p.P(`ormResponse := `, ormable.Name, `{}`)
 	p.P(`if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {`)
-	p.P(`return nil, err`)
+	p.generateDBErrorReturn(`return nil, `)
 	p.P(`}`)
 	p.generateAfterReadHookCall(ormable)
 	p.P(`pbResponse, err := ormResponse.ToPB(ctx)`)
@@ -172,20 +264,20 @@ func (p *OrmPlugin) generateReadHandler(message *protogen.Message) {
 
 func (p *OrmPlugin) generateBeforeReadHookDef(orm *OrmableType, suffix string) {
 	p.P(`type `, orm.Name, `WithBeforeRead`, suffix, ` interface {`)
-	hookSign := fmt.Sprint(`BeforeRead`, suffix, `(`, p.qualifiedGoIdent(identCtx), `, `, p.qualifiedGoIdentPtr(identGormDB))
+	hookSign := fmt.Sprint(`BeforeRead`, suffix, `(`, p.qualifiedGoIdent(identCtx), `, `, p.qualifiedGoIdentPtr(p.identGormDB))
 	if p.readHasFieldSelection(orm) {
-		hookSign += fmt.Sprint(`, `, p.qualifiedGoIdentPtr(identQueryFieldSelection))
+		hookSign += fmt.Sprint(`, `, p.qualifiedGoIdentPtr(p.identQueryFieldSelection))
 	}
-	hookSign += fmt.Sprint(`) (`, p.qualifiedGoIdentPtr(identGormDB), `, error)`)
+	hookSign += fmt.Sprint(`) (`, p.qualifiedGoIdentPtr(p.identGormDB), `, error)`)
 	p.P(hookSign)
 	p.P(`}`)
 }
 
 func (p *OrmPlugin) generateAfterReadHookDef(orm *OrmableType) {
 	p.P(`type `, orm.Name, `WithAfterReadFind interface {`)
-	hookSign := fmt.Sprint(`AfterReadFind`, `(`, p.qualifiedGoIdent(identCtx), `, `, p.qualifiedGoIdentPtr(identGormDB))
+	hookSign := fmt.Sprint(`AfterReadFind`, `(`, p.qualifiedGoIdent(identCtx), `, `, p.qualifiedGoIdentPtr(p.identGormDB))
 	if p.readHasFieldSelection(orm) {
-		hookSign += fmt.Sprint(`, `, p.qualifiedGoIdentPtr(identQueryFieldSelection))
+		hookSign += fmt.Sprint(`, `, p.qualifiedGoIdentPtr(p.identQueryFieldSelection))
 	}
 	hookSign += `) error`
 	p.P(hookSign)
@@ -224,7 +316,7 @@ func (p *OrmPlugin) generateApplyFieldMask(message *protogen.Message) {
 	p.P(`// DefaultApplyFieldMask`, typeName, ` patches an pbObject with patcher according to a field mask.`)
 	p.P(`func DefaultApplyFieldMask`, typeName, `(ctx `, identCtx, `, patchee *`,
 		typeName, `, patcher *`, typeName, `, updateMask `, p.qualifiedGoIdentPtr(identFieldMask),
-		`, prefix string, db `, p.qualifiedGoIdentPtr(identGormDB), `) (*`, typeName, `, error) {`)
+		`, prefix string, db `, p.qualifiedGoIdentPtr(p.identGormDB), `) (*`, typeName, `, error) {`)
 
 	p.P(`if patcher == nil {`)
 	p.P(`return nil, nil`)
@@ -239,10 +331,13 @@ func (p *OrmPlugin) generateApplyFieldMask(message *protogen.Message) {
 		fieldName := fieldName(field)
 		notSpecialType := !p.isSpecialType(field)
 
-		if desc.Message() != nil && notSpecialType && !desc.IsList() {
+		if isOneofMember(field) {
+			continue
+		}
+		if desc.Message() != nil && notSpecialType && !desc.IsList() && !desc.IsMap() {
 			p.P(`var updated`, fieldName, ` bool`)
 			hasNested = true
-		} else if strings.HasSuffix(fieldType, protoTypeJSON) {
+		} else if strings.HasSuffix(fieldType, protoTypeJSON) && !desc.IsList() {
 			p.P(`var updated`, fieldName, ` bool`)
 		}
 	}
@@ -256,6 +351,10 @@ func (p *OrmPlugin) generateApplyFieldMask(message *protogen.Message) {
 		desc := field.Desc
 		ccName := fieldName(field)
 		fieldType := p.fieldType(field)
+		if isOneofMember(field) {
+			p.generateOneofApplyFieldMask(field)
+			continue
+		}
 		//  for ormable message, do recursive patching
 		if desc.Message() != nil && p.isOrmable(fieldType) && !desc.IsList() {
 			ident := p.qualifiedGoIdent(fieldIdent(field))
@@ -288,9 +387,10 @@ func (p *OrmPlugin) generateApplyFieldMask(message *protogen.Message) {
 			p.P(`patchee.`, ccName, ` = patcher.`, ccName)
 			p.P(`continue`)
 			p.P(`}`)
-		} else if desc.Message() != nil && !p.isSpecialType(field) && !desc.IsList() {
+		} else if desc.Message() != nil && !p.isSpecialType(field) && !desc.IsList() && !desc.IsMap() {
 			ident := p.qualifiedGoIdent(fieldIdent(field))
 			p.P(`if !updated`, ccName, ` && `, identStringsHasPrefixFn, `(f, prefix+"`, ccName, `.") {`)
+			p.P(`updated`, ccName, ` = true`)
 			p.P(`if patcher.`, ccName, ` == nil {`)
 			p.P(`patchee.`, ccName, ` = nil`)
 			p.P(`continue`)
@@ -300,13 +400,14 @@ func (p *OrmPlugin) generateApplyFieldMask(message *protogen.Message) {
 			p.P(`}`)
 			p.P(`childMask := &`, identFieldMask, `{}`)
 			p.P(`for j := i; j < len(updateMask.Paths); j++ {`)
-			p.P(`if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"`, ccName, `."); trimPath != updateMask.Paths[j] {`)
+			p.P(`if trimPath := `, identStringsTrimPrefixFn, `(updateMask.Paths[j], prefix+"`, ccName, `."); trimPath != updateMask.Paths[j] {`)
 			p.P(`childMask.Paths = append(childMask.Paths, trimPath)`)
 			p.P(`}`)
 			p.P(`}`)
-			p.P(`if err := `, p.identFnCall(identMergeWithMaskFn, "patcher."+ccName, "patchee."+ccName, "childMask"), `; err != nil {`)
-			p.P(`return nil, nil`)
+			p.P(`if err := `, p.identFnCall(p.identMergeWithMaskFn, "patcher."+ccName, "patchee."+ccName, "childMask"), `; err != nil {`)
+			p.P(`return nil, err`)
 			p.P(`}`)
+			p.P(`continue`)
 			p.P(`}`)
 			p.P(`if f == prefix+"`, ccName, `" {`)
 			p.P(`updated`, ccName, ` = true`)
@@ -326,6 +427,15 @@ func (p *OrmPlugin) generateApplyFieldMask(message *protogen.Message) {
 			p.P(`}`)
 		}
 	}
+	for _, oneof := range message.Oneofs {
+		if oneof.Desc.IsSynthetic() {
+			continue
+		}
+		p.P(`if f == prefix+"`, oneof.GoName, `" {`)
+		p.P(`patchee.`, oneof.GoName, ` = patcher.`, oneof.GoName)
+		p.P(`continue`)
+		p.P(`}`)
+	}
 	p.P(`}`)
 	p.P(`if err != nil {`)
 	p.P(`return nil, err`)
@@ -335,44 +445,51 @@ func (p *OrmPlugin) generateApplyFieldMask(message *protogen.Message) {
 	p.P()
 }
 
-func (p *OrmPlugin) hasIDField(message *protogen.Message) bool {
+// hasMessagePrimaryKey reports whether the fields of the primary key of
+// message are all fields of the message, so the row of an object is found
+// from the message.
+func (p *OrmPlugin) hasMessagePrimaryKey(message *protogen.Message) bool {
+	fields := make(map[string]bool)
 	for _, field := range message.Fields {
-		if strings.ToLower(fieldName(field)) == "id" {
-			return true
+		fields[fieldName(field)] = true
+	}
+	for _, pkName := range p.primaryKeyNames(p.getOrmableMessage(message)) {
+		if !fields[pkName] {
+			return false
 		}
 	}
-	return false
+	return true
 }
 
-func (p *OrmPlugin) generatePatchHandler(message *protogen.Message) {
-	var isMultiAccount bool
+// primaryKeyLiteral returns the fields of the composite literal of message
+// holding the primary key of the in variable.
+func (p *OrmPlugin) primaryKeyLiteral(message *protogen.Message) string {
+	var fields []string
+	for _, pkName := range p.primaryKeyNames(p.getOrmableMessage(message)) {
+		fields = append(fields, pkName+`: in.Get`+pkName+`()`)
+	}
+	return strings.Join(fields, ", ")
+}
 
+func (p *OrmPlugin) generatePatchHandler(message *protogen.Message) {
 	typeName := p.messageType(message)
 	ormable := p.getOrmable(typeName)
 
-	if getMessageOptions(message).GetMultiAccount() {
-		isMultiAccount = true
-	}
-
-	if isMultiAccount && !p.hasIDField(message) {
-		p.P(fmt.Sprintf("// Cannot autogen DefaultPatch%s: this is a multi-account table without an \"id\" field in the message.\n", typeName))
-		return
-	}
-
 	p.P(`// DefaultPatch`, typeName, ` executes a basic gorm update call with patch behavior`)
 	p.P(`func DefaultPatch`, typeName, `(ctx `, identCtx, `, in *`,
-		typeName, `, updateMask `, p.qualifiedGoIdentPtr(identFieldMask), `, db `, p.qualifiedGoIdentPtr(identGormDB), `) (*`, typeName, `, error) {`)
+		typeName, `, updateMask `, p.qualifiedGoIdentPtr(identFieldMask), `, db `, p.qualifiedGoIdentPtr(p.identGormDB), `) (*`, typeName, `, error) {`)
 
 	p.P(`if in == nil {`)
 	p.P(`return nil, `, identNilArgumentError)
 	p.P(`}`)
+	p.generateHistoryTransaction(ormable, `DefaultPatch`+typeName+`(ctx, in, updateMask, tx)`, `*`+typeName)
 	p.P(`var pbObj `, typeName)
 	p.P(`var err error`)
 	p.generateBeforePatchHookCall(ormable, "Read")
 	if p.readHasFieldSelection(ormable) {
-		p.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db, nil)`)
+		p.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{`, p.primaryKeyLiteral(message), `}, db, nil)`)
 	} else {
-		p.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db)`)
+		p.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{`, p.primaryKeyLiteral(message), `}, db)`)
 	}
 
 	p.P(`if err != nil {`)
@@ -385,6 +502,10 @@ func (p *OrmPlugin) generatePatchHandler(message *protogen.Message) {
 	p.P(`if _, err := DefaultApplyFieldMask`, typeName, `(ctx, &pbObj, in, updateMask, "", db); err != nil {`)
 	p.P(`return nil, err`)
 	p.P(`}`)
+	if ormable.version != "" && isVersionExposed(ormable) {
+		// the version read is checked against the patch's one
+		p.P(`pbObj.`, ormable.version, ` = in.`, ormable.version)
+	}
 
 	p.generateBeforePatchHookCall(ormable, "Save")
 	p.P(`pbResponse, err := DefaultStrictUpdate`, typeName, `(ctx, &pbObj, db)`)
@@ -404,8 +525,8 @@ func (p *OrmPlugin) generatePatchHandler(message *protogen.Message) {
 
 func (p *OrmPlugin) generateBeforePatchHookDef(orm *OrmableType, suffix string) {
 	p.P(`type `, orm.OriginName, `WithBeforePatch`, suffix, ` interface {`)
-	p.P(`BeforePatch`, suffix, `(`, identCtx, `, *`, orm.OriginName, `, `, p.qualifiedGoIdentPtr(identFieldMask), `, *`, identGormDB,
-		`) (*`, identGormDB, `, error)`)
+	p.P(`BeforePatch`, suffix, `(`, identCtx, `, *`, orm.OriginName, `, `, p.qualifiedGoIdentPtr(identFieldMask), `, *`, p.identGormDB,
+		`) (*`, p.identGormDB, `, error)`)
 	p.P(`}`)
 }
 
@@ -419,7 +540,7 @@ func (p *OrmPlugin) generateBeforePatchHookCall(orm *OrmableType, suffix string)
 
 func (p *OrmPlugin) generateAfterPatchHookDef(orm *OrmableType, suffix string) {
 	p.P(`type `, orm.OriginName, `WithAfterPatch`, suffix, ` interface {`)
-	p.P(`AfterPatch`, suffix, `(`, identCtx, `, *`, orm.OriginName, `, `, p.qualifiedGoIdentPtr(identFieldMask), `, `, p.qualifiedGoIdentPtr(identGormDB),
+	p.P(`AfterPatch`, suffix, `(`, identCtx, `, *`, orm.OriginName, `, `, p.qualifiedGoIdentPtr(identFieldMask), `, `, p.qualifiedGoIdentPtr(p.identGormDB),
 		`) error`)
 	p.P(`}`)
 }
@@ -440,130 +561,435 @@ func (p *OrmPlugin) generatePatchSetHandler(message *protogen.Message) {
 		isMultiAccount = true
 	}
 
-	if isMultiAccount && !p.hasIDField(message) {
-		p.P(fmt.Sprintf("// Cannot autogen DefaultPatchSet%s: this is a multi-account table without an \"id\" field in the message.\n", typeName))
-		return
-	}
-
-	// p.UsingGoImports(stdFmtImport)
-	p.P(`// DefaultPatchSet`, typeName, ` executes a bulk gorm update call with patch behavior`)
+	ormable := p.getOrmable(typeName)
+	keyType, _ := p.primaryKeyTuple(ormable, "", false)
+	mapKeyType, _ := p.primaryKeyTuple(ormable, "", true)
+	p.P(`// DefaultPatchSet`, typeName, ` executes a bulk gorm update call with patch behavior in a`)
+	p.P(`// transaction. The rows are read at once, with the db returned by the BeforePatchSetRead`)
+	p.P(`// hook, but the ones of the objects with a BeforePatchRead hook, which are read, patched`)
+	p.P(`// and saved with the db returned by the hook of their object. The rows are patched in`)
+	p.P(`// memory, the failures of the objects are returned together in an errors.MultiError, no`)
+	p.P(`// row being changed`)
 	p.P(`func DefaultPatchSet`, typeName, `(ctx `, identCtx, `, objects []*`,
-		typeName, `, updateMasks []`, p.qualifiedGoIdentPtr(identFieldMask), `, db `, p.qualifiedGoIdentPtr(identGormDB), `) ([]*`, typeName, `, error) {`)
+		typeName, `, updateMasks []`, p.qualifiedGoIdentPtr(identFieldMask), `, db `, p.qualifiedGoIdentPtr(p.identGormDB), `) ([]*`, typeName, `, error) {`)
 	p.P(`if len(objects) != len(updateMasks) {`)
-	p.P(`return nil, `, identFmtErrorf, `(`, identBadRepeatedFieldMaskTplError, `, len(updateMasks), len(objects))`)
+	p.P(`return nil, `, identInvalidArgumentFn, `(`, identFmtErrorf, `(`, identBadRepeatedFieldMaskTplError, `, len(updateMasks), len(objects)))`)
 	p.P(`}`)
-	p.P(``)
-	p.P(`results := make([]*`, typeName, `, 0, len(objects))`)
-	p.P(`for i, patcher := range objects {`)
-	p.P(`pbResponse, err := DefaultPatch`, typeName, `(ctx, patcher, updateMasks[i], db)`)
+	p.generateTransaction(ormable, `DefaultPatchSet`+typeName+`(ctx, objects, updateMasks, tx)`, `[]*`+typeName)
+	p.P(`var err error`)
+	scope := ""
+	if isMultiAccount {
+		p.P(`accountID, err := `, p.identGetAccountIDFn, `(ctx, nil)`)
+		p.P(`if err != nil {`)
+		p.P(`return nil, err`)
+		p.P(`}`)
+		scope = `.Where(map[string]interface{}{"account_id": accountID})`
+	}
+	p.P(`errs := &`, identMultiError, `{}`)
+	p.P(`ormObjs := make([]*`, ormable.Name, `, len(objects))`)
+	p.P(`hookedRows := make([]*`, ormable.Name, `, len(objects))`)
+	p.P(`dbs := make([]*`, p.identGormDB, `, len(objects))`)
+	p.P(`keys := make([]`, keyType, `, 0, len(objects))`)
+	p.P(`for i, in := range objects {`)
+	p.P(`if in == nil {`)
+	p.P(`errs.Add(i, `, identNilArgumentError, `)`)
+	p.P(`continue`)
+	p.P(`}`)
+	p.P(`ormObj, err := in.ToORM(ctx)`)
 	p.P(`if err != nil {`)
+	p.P(`errs.Add(i, err)`)
+	p.P(`continue`)
+	p.P(`}`)
+	p.P(`if `, p.emptyPrimaryKeyCondition(ormable, "ormObj"), ` {`)
+	p.P(`errs.Add(i, `, identEmptyIDError, `)`)
+	p.P(`continue`)
+	p.P(`}`)
+	p.P(`hook, ok := interface{}(in).(`, ormable.OriginName, `WithBeforePatchRead)`)
+	p.P(`if !ok {`)
+	p.P(`ormObjs[i], dbs[i] = &ormObj, db`)
+	_, key := p.primaryKeyTuple(ormable, "ormObj", false)
+	p.P(`keys = append(keys, `, key, `)`)
+	p.P(`continue`)
+	p.P(`}`)
+	p.P(`// the conditions added by the hook only apply to the row of its object`)
+	p.P(`odb, err := hook.BeforePatchRead(ctx, in, updateMasks[i], db)`)
+	p.P(`if err != nil {`)
+	p.P(`errs.Add(i, err)`)
+	p.P(`continue`)
+	p.P(`}`)
+	condition, args := p.primaryKeyCondition(ormable, "ormObj")
+	p.P(`row := &`, ormable.Name, `{}`)
+	p.P(`if err = odb`, scope, `.Where("`, condition, `", `, strings.Join(args, ", "), `).First(row).Error; err != nil {`)
+	p.P(`errs.Add(i, `, identFromDBFn, `(err, `, p.identGormErrRecordNotFound, `))`)
+	p.P(`continue`)
+	p.P(`}`)
+	p.P(`ormObjs[i], dbs[i], hookedRows[i] = &ormObj, odb, row`)
+	p.P(`}`)
+	p.P(`if hook, ok := interface{}(&`, ormable.Name, `{}).(`, ormable.Name, `WithBeforePatchSetRead); ok {`)
+	p.P(`if db, err = hook.BeforePatchSetRead(ctx, objects, updateMasks, db); err != nil {`)
 	p.P(`return nil, err`)
 	p.P(`}`)
-	p.P(``)
+	p.P(`}`)
+	p.P(`rows := []*`, ormable.Name, `{}`)
+	p.P(`if len(keys) > 0 {`)
+	p.P(`if err = db`, scope, `.Where("`, p.primaryKeyIn(ormable), `", keys).Find(&rows).Error; err != nil {`)
+	p.generateDBErrorReturn(`return nil, `)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`stored := make(map[`, mapKeyType, `]*`, ormable.Name, `, len(rows))`)
+	p.P(`for _, row := range rows {`)
+	_, rowKey := p.primaryKeyTuple(ormable, "row", true)
+	p.P(`stored[`, rowKey, `] = row`)
+	p.P(`}`)
+	p.P(`patch := func(in *`, typeName, `, updateMask `, p.qualifiedGoIdentPtr(identFieldMask), `, row *`, ormable.Name, `, db *`, p.identGormDB, `) (*`, typeName, `, error) {`)
+	p.P(`pbObj, err := row.ToPB(ctx)`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	if ormable.version != "" && isVersionExposed(ormable) {
+		p.P(`if pbObj.`, ormable.version, ` != in.`, ormable.version, ` {`)
+		p.P(`return nil, `, identVersionConflictError)
+		p.P(`}`)
+	}
+	p.generateBeforePatchHookCall(ormable, "ApplyFieldMask")
+	p.P(`if _, err := DefaultApplyFieldMask`, typeName, `(ctx, &pbObj, in, updateMask, "", db); err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.P(`return &pbObj, nil`)
+	p.P(`}`)
+	p.P(`patched := make([]*`, typeName, `, len(objects))`)
+	p.P(`for i, ormObj := range ormObjs {`)
+	p.P(`if ormObj == nil {`)
+	p.P(`continue`)
+	p.P(`}`)
+	_, mapKey := p.primaryKeyTuple(ormable, "ormObj", true)
+	p.P(`row := hookedRows[i]`)
+	p.P(`if row == nil {`)
+	p.P(`var ok bool`)
+	p.P(`if row, ok = stored[`, mapKey, `]; !ok {`)
+	p.P(`errs.Add(i, `, identNotFoundFn, `(`, p.identGormErrRecordNotFound, `))`)
+	p.P(`continue`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`if patched[i], err = patch(objects[i], updateMasks[i], row, dbs[i]); err != nil {`)
+	p.P(`errs.Add(i, err)`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`if err = errs.ErrOrNil(); err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.P(`save := func(pbObj *`, typeName, `, in *`, typeName, `, updateMask `, p.qualifiedGoIdentPtr(identFieldMask), `, db *`, p.identGormDB, `) (*`, typeName, `, error) {`)
+	p.P(`var err error`)
+	p.P(`if hook, ok := interface{}(pbObj).(`, ormable.OriginName, `WithBeforePatchSave); ok {`)
+	p.P(`if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`pbResponse, err := DefaultStrictUpdate`, typeName, `(ctx, pbObj, db)`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.generateAfterPatchHookCall(ormable, "Save")
+	p.P(`return pbResponse, nil`)
+	p.P(`}`)
+	p.P(`results := make([]*`, typeName, `, 0, len(objects))`)
+	p.P(`for i, pbObj := range patched {`)
+	p.P(`pbResponse, err := save(pbObj, objects[i], updateMasks[i], dbs[i])`)
+	p.P(`if err != nil {`)
+	p.P(`errs.Add(i, err)`)
+	p.P(`return nil, errs`)
+	p.P(`}`)
 	p.P(`results = append(results, pbResponse)`)
 	p.P(`}`)
-	p.P(``)
 	p.P(`return results, nil`)
 	p.P(`}`)
+	p.P()
+	p.P(`// `, ormable.Name, `WithBeforePatchSetRead called before DefaultPatchSet`, typeName, ` reads the rows`)
+	p.P(`type `, ormable.Name, `WithBeforePatchSetRead interface {`)
+	p.P(`BeforePatchSetRead(`, identCtx, `, []*`, typeName, `, []`, p.qualifiedGoIdentPtr(identFieldMask), `, `, p.qualifiedGoIdentPtr(p.identGormDB), `) (`, p.qualifiedGoIdentPtr(p.identGormDB), `, error)`)
+	p.P(`}`)
+	p.P()
 }
 
 func (p *OrmPlugin) generateDeleteHandler(message *protogen.Message) {
 	typeName := p.messageType(message)
 	p.P(`func DefaultDelete`, typeName, `(ctx `, identCtx, `, in *`,
-		typeName, `, db `, p.qualifiedGoIdentPtr(identGormDB), `) error {`)
+		typeName, `, db `, p.qualifiedGoIdentPtr(p.identGormDB), `) error {`)
 	p.P(`if in == nil {`)
 	p.P(`return `, identNilArgumentError)
 	p.P(`}`)
+	ormable := p.getOrmable(typeName)
+	p.generateHistoryTransaction(ormable, `DefaultDelete`+typeName+`(ctx, in, tx)`, ``)
 	p.P(`ormObj, err := in.ToORM(ctx)`)
 	p.P(`if err != nil {`)
 	p.P(`return err`)
 	p.P(`}`)
-	ormable := p.getOrmable(typeName)
-	pkName, pk := p.findPrimaryKey(ormable)
-	if strings.Contains(pk.Type, "*") {
-		p.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, p.guessZeroValue(pk.Type), ` {`)
-	} else {
-		p.P(`if ormObj.`, pkName, ` == `, p.guessZeroValue(pk.Type), `{`)
-	}
-	p.P(`return `, identEmptyIDError)
-	p.P(`}`)
-	p.generateBeforeDeleteHookCall(ormable)
+	p.generateEmptyPrimaryKeyCheck(ormable, `return `)
+	delete := "Delete_"
+	p.generateBeforeDeleteHookCall(ormable, delete)
+	p.generateHistoryDeletedRows(ormable, `db`, `&ormObj`)
 	p.P(`err = db.Where(&ormObj).Delete(&`, ormable.Name, `{}).Error`)
 	p.P(`if err != nil {`)
-	p.P(`return err`)
+	p.generateDBErrorReturn(`return `)
 	p.P(`}`)
-	p.generateAfterDeleteHookCall(ormable)
+	p.generateHistoryDeletes(ormable)
+	p.generateAfterDeleteHookCall(ormable, delete)
 	p.P(`return err`)
 	p.P(`}`)
-	delete := "Delete_"
 	p.generateBeforeHookDef(ormable, delete)
 	p.generateAfterHookDef(ormable, delete)
 }
 
-func (p *OrmPlugin) generateBeforeDeleteHookCall(orm *OrmableType) {
-	p.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBeforeDelete_); ok {`)
-	p.P(`if db, err = hook.BeforeDelete_(ctx, db); err != nil {`)
+func (p *OrmPlugin) generateBeforeDeleteHookCall(orm *OrmableType, method string) {
+	p.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBefore`, method, `); ok {`)
+	p.P(`if db, err = hook.Before`, method, `(ctx, db); err != nil {`)
 	p.P(`return err`)
 	p.P(`}`)
 	p.P(`}`)
 }
 
-func (p *OrmPlugin) generateAfterDeleteHookCall(orm *OrmableType) {
-	p.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithAfterDelete_); ok {`)
-	p.P(`err = hook.AfterDelete_(ctx, db)`)
+func (p *OrmPlugin) generateAfterDeleteHookCall(orm *OrmableType, method string) {
+	p.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithAfter`, method, `); ok {`)
+	p.P(`err = hook.After`, method, `(ctx, db)`)
 	p.P(`}`)
 }
 
+func (p *OrmPlugin) generateHardDeleteHandler(message *protogen.Message) {
+	typeName := p.messageType(message)
+	p.P(`// DefaultHardDelete`, typeName, ` executes a gorm delete call removing the row, soft deleted or not`)
+	p.P(`func DefaultHardDelete`, typeName, `(ctx `, identCtx, `, in *`,
+		typeName, `, db `, p.qualifiedGoIdentPtr(p.identGormDB), `) error {`)
+	p.P(`if in == nil {`)
+	p.P(`return `, identNilArgumentError)
+	p.P(`}`)
+	ormable := p.getOrmable(typeName)
+	p.generateHistoryTransaction(ormable, `DefaultHardDelete`+typeName+`(ctx, in, tx)`, ``)
+	p.P(`ormObj, err := in.ToORM(ctx)`)
+	p.P(`if err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	p.generateEmptyPrimaryKeyCheck(ormable, `return `)
+	hardDelete := "HardDelete_"
+	p.generateBeforeDeleteHookCall(ormable, hardDelete)
+	p.generateHistoryDeletedRows(ormable, `db.Unscoped()`, `&ormObj`)
+	p.P(`err = db.Unscoped().Where(&ormObj).Delete(&`, ormable.Name, `{}).Error`)
+	p.P(`if err != nil {`)
+	p.generateDBErrorReturn(`return `)
+	p.P(`}`)
+	p.generateHistoryDeletes(ormable)
+	p.generateAfterDeleteHookCall(ormable, hardDelete)
+	p.P(`return err`)
+	p.P(`}`)
+	p.generateBeforeHookDef(ormable, hardDelete)
+	p.generateAfterHookDef(ormable, hardDelete)
+}
+
+func (p *OrmPlugin) generateRestoreHandler(message *protogen.Message) {
+	typeName := p.messageType(message)
+	p.P(`// DefaultRestore`, typeName, ` clears the deletion of a soft deleted `, typeName, ` and then executes a gorm read call`)
+	p.P(`func DefaultRestore`, typeName, `(ctx `, identCtx, `, in *`,
+		typeName, `, db `, p.qualifiedGoIdentPtr(p.identGormDB), `) (*`, typeName, `, error) {`)
+	p.P(`if in == nil {`)
+	p.P(`return nil, `, identNilArgumentError)
+	p.P(`}`)
+	ormable := p.getOrmable(typeName)
+	p.generateHistoryTransaction(ormable, `DefaultRestore`+typeName+`(ctx, in, tx)`, `*`+typeName)
+	p.P(`ormObj, err := in.ToORM(ctx)`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.generateEmptyPrimaryKeyCheck(ormable, `return nil, `)
+	restore := "Restore_"
+	p.generateBeforeHookCall(ormable, restore)
+	if hasHistory(ormable) {
+		p.P(`before := &`, ormable.Name, `{}`)
+		p.P(`if err = db.Unscoped().Where(&ormObj).First(before).Error; err != nil {`)
+		p.generateDBErrorReturn(`return nil, `)
+		p.P(`}`)
+	}
+	p.P(`res := db.Unscoped().Model(&`, ormable.Name, `{}).Where(&ormObj).Where("`, deletedAtColumn(ormable), ` IS NOT NULL").Update("`, deletedAtColumn(ormable), `", nil)`)
+	p.P(`if err = res.Error; err != nil {`)
+	p.generateDBErrorReturn(`return nil, `)
+	p.P(`}`)
+	p.P(`if res.RowsAffected == 0 {`)
+	p.P(`return nil, `, identNotFoundFn, `(`, p.identGormErrRecordNotFound, `)`)
+	p.P(`}`)
+	p.P(`ormResponse := `, ormable.Name, `{}`)
+	p.P(`if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {`)
+	p.generateDBErrorReturn(`return nil, `)
+	p.P(`}`)
+	p.generateHistoryWrite(ormable, strconv.Quote(historyRestore), `before`, `&ormResponse`, `return nil, `)
+	p.generateAfterHookCall(ormable, restore)
+	p.P(`pbResponse, err := ormResponse.ToPB(ctx)`)
+	p.P(`return &pbResponse, err`)
+	p.P(`}`)
+	p.generateBeforeHookDef(ormable, restore)
+	p.generateAfterHookDef(ormable, restore)
+}
+
+// generateDBErrorReturn outputs the return of the error of a gorm call held by
+// the err variable after ret, wrapped into the typed errors.
+func (p *OrmPlugin) generateDBErrorReturn(ret string) {
+	p.P(ret, identFromDBFn, `(err, `, p.identGormErrRecordNotFound, `)`)
+}
+
+// generateEmptyPrimaryKeyCheck outputs the check of the primary key of the
+// ormObj variable, returning the EmptyIdError after ret when it is not set.
+func (p *OrmPlugin) generateEmptyPrimaryKeyCheck(ormable *OrmableType, ret string) {
+	p.P(`if `, p.emptyPrimaryKeyCondition(ormable, "ormObj"), ` {`)
+	p.P(ret, identEmptyIDError)
+	p.P(`}`)
+}
+
+// emptyPrimaryKeyCondition returns the condition holding when the primary key
+// of the obj variable is not set, any of its fields for a composite key.
+func (p *OrmPlugin) emptyPrimaryKeyCondition(ormable *OrmableType, obj string) string {
+	var conditions []string
+	for _, pkName := range p.primaryKeyNames(ormable) {
+		pk := ormable.Fields[pkName]
+		if strings.Contains(pk.Type, "*") {
+			conditions = append(conditions, fmt.Sprint(obj, `.`, pkName, ` == nil || *`, obj, `.`, pkName, ` == `, p.guessZeroValue(pk.Type)))
+		} else {
+			conditions = append(conditions, fmt.Sprint(obj, `.`, pkName, ` == `, p.guessZeroValue(pk.Type)))
+		}
+	}
+	return strings.Join(conditions, " || ")
+}
+
+// primaryKeyCondition returns the condition matching the row of the obj
+// variable on all the columns of its primary key, and its arguments.
+func (p *OrmPlugin) primaryKeyCondition(ormable *OrmableType, obj string) (string, []string) {
+	var conditions, args []string
+	for _, pkName := range p.primaryKeyNames(ormable) {
+		conditions = append(conditions, columnName(pkName, ormable.Fields[pkName])+" = ?")
+		args = append(args, obj+"."+pkName)
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// primaryKeyIn returns the condition matching the rows whose primary key is
+// in a slice of the keys of primaryKeyTuple, a tuple IN for a composite key.
+func (p *OrmPlugin) primaryKeyIn(ormable *OrmableType) string {
+	var columns []string
+	for _, pkName := range p.primaryKeyNames(ormable) {
+		columns = append(columns, columnName(pkName, ormable.Fields[pkName]))
+	}
+	if len(columns) == 1 {
+		return columns[0] + " IN (?)"
+	}
+	return "(" + strings.Join(columns, ", ") + ") IN (?)"
+}
+
+// primaryKeyTuple returns the type and the value of the primary key of the
+// obj variable, once checked by emptyPrimaryKeyCondition. The fields of a
+// composite key are held by a slice of interface{}, or an array of it when
+// comparable, as a map key.
+func (p *OrmPlugin) primaryKeyTuple(ormable *OrmableType, obj string, comparable bool) (string, string) {
+	pkNames := p.primaryKeyNames(ormable)
+	var values []string
+	for _, pkName := range pkNames {
+		value := obj + "." + pkName
+		if strings.HasPrefix(ormable.Fields[pkName].Type, "*") {
+			value = "*" + value
+		}
+		values = append(values, value)
+	}
+	if len(pkNames) == 1 {
+		pk := ormable.Fields[pkNames[0]]
+		return strings.TrimPrefix(p.qualifiedGoIdent(pk.F.GoIdent), "*"), values[0]
+	}
+	tupleType := "[]interface{}"
+	if comparable {
+		tupleType = fmt.Sprintf("[%d]interface{}", len(pkNames))
+	}
+	return tupleType, tupleType + "{" + strings.Join(values, ", ") + "}"
+}
+
 func (p *OrmPlugin) generateDeleteSetHandler(message *protogen.Message) {
 	typeName := p.messageType(message)
+	p.P(`// DefaultDelete`, typeName, `Set executes a bulk gorm delete call in a transaction, the`)
+	p.P(`// failures of the objects, missing rows included, are returned together in an`)
+	p.P(`// errors.MultiError, no row being deleted`)
 	p.P(`func DefaultDelete`, typeName, `Set(ctx `, identCtx, `, in []*`,
-		typeName, `, db *`, identGormDB, `) error {`)
+		typeName, `, db *`, p.identGormDB, `) error {`)
 	p.P(`if in == nil {`)
 	p.P(`return `, identNilArgumentError)
 	p.P(`}`)
-	p.P(`var err error`)
 	ormable := p.getOrmable(typeName)
-	pkName, pk := p.findPrimaryKey(ormable)
-	column := pk.GetTag().GetColumn()
-	if len(column) != 0 {
-		pkName = column
-	}
-	p.P(`keys := []`, p.qualifiedGoIdent(pk.F.GoIdent), `{}`)
-	p.P(`for _, obj := range in {`)
+	p.generateTransaction(ormable, `DefaultDelete`+typeName+`Set(ctx, in, tx)`, ``)
+	p.P(`var err error`)
+	p.P(`errs := &`, identMultiError, `{}`)
+	keyType, key := p.primaryKeyTuple(ormable, "ormObj", false)
+	mapKeyType, mapKey := p.primaryKeyTuple(ormable, "ormObj", true)
+	p.P(`ormObjs := make([]*`, ormable.Name, `, len(in))`)
+	p.P(`keys := []`, keyType, `{}`)
+	p.P(`for i, obj := range in {`)
+	p.P(`if obj == nil {`)
+	p.P(`errs.Add(i, `, identNilArgumentError, `)`)
+	p.P(`continue`)
+	p.P(`}`)
 	p.P(`ormObj, err := obj.ToORM(ctx)`)
 	p.P(`if err != nil {`)
-	p.P(`return err`)
+	p.P(`errs.Add(i, err)`)
+	p.P(`continue`)
 	p.P(`}`)
-	if strings.Contains(pk.Type, "*") {
-		p.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, p.guessZeroValue(pk.Type), ` {`)
-	} else {
-		p.P(`if ormObj.`, pkName, ` == `, p.guessZeroValue(pk.Type), `{`)
-	}
-	p.P(`return `, identEmptyIDError)
+	p.P(`if `, p.emptyPrimaryKeyCondition(ormable, "ormObj"), ` {`)
+	p.P(`errs.Add(i, `, identEmptyIDError, `)`)
+	p.P(`continue`)
 	p.P(`}`)
-	p.P(`keys = append(keys, ormObj.`, pkName, `)`)
+	p.P(`ormObjs[i] = &ormObj`)
+	p.P(`keys = append(keys, `, key, `)`)
+	p.P(`}`)
+	p.P(`if err = errs.ErrOrNil(); err != nil {`)
+	p.P(`return err`)
 	p.P(`}`)
+	if p.hasCompositeKey(ormable) {
+		// an empty tuple IN is invalid
+		p.P(`if len(keys) == 0 {`)
+		p.P(`return nil`)
+		p.P(`}`)
+	}
 	p.generateBeforeDeleteSetHookCall(ormable)
 	if getMessageOptions(message).GetMultiAccount() {
-		p.P(`acctId, err := `, identGetAccountIDFn, `(ctx, nil)`)
+		p.P(`acctId, err := `, p.identGetAccountIDFn, `(ctx, nil)`)
 		p.P(`if err != nil {`)
 		p.P(`return err`)
 		p.P(`}`)
-		p.P(`err = db.Where("account_id = ? AND `, jgorm.ToDBName(pkName), ` in (?)", acctId, keys).Delete(&`, ormable.Name, `{}).Error`)
-	} else {
-		p.P(`err = db.Where("`, jgorm.ToDBName(pkName), ` in (?)", keys).Delete(&`, ormable.Name, `{}).Error`)
 	}
-	p.P(`if err != nil {`)
+	where := fmt.Sprint(`"`, p.primaryKeyIn(ormable), `", keys`)
+	if getMessageOptions(message).GetMultiAccount() {
+		where = fmt.Sprint(`"account_id = ? AND `, p.primaryKeyIn(ormable), `", acctId, keys`)
+	}
+	// the rows are read first to report the missing ones, and kept for the history
+	p.P(`before := []*`, ormable.Name, `{}`)
+	p.P(`if err = db.Where(`, where, `).Find(&before).Error; err != nil {`)
+	p.generateDBErrorReturn(`return `)
+	p.P(`}`)
+	p.P(`found := make(map[`, mapKeyType, `]bool, len(before))`)
+	p.P(`for _, row := range before {`)
+	_, rowKey := p.primaryKeyTuple(ormable, "row", true)
+	p.P(`found[`, rowKey, `] = true`)
+	p.P(`}`)
+	p.P(`for i, ormObj := range ormObjs {`)
+	p.P(`if ormObj != nil && !found[`, mapKey, `] {`)
+	p.P(`errs.Add(i, `, identNotFoundFn, `(`, p.identGormErrRecordNotFound, `))`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`if err = errs.ErrOrNil(); err != nil {`)
 	p.P(`return err`)
 	p.P(`}`)
+	p.P(`if err = db.Where(`, where, `).Delete(&`, ormable.Name, `{}).Error; err != nil {`)
+	p.generateDBErrorReturn(`return `)
+	p.P(`}`)
+	p.generateHistoryDeletes(ormable)
 	p.generateAfterDeleteSetHookCall(ormable)
 	p.P(`return err`)
 	p.P(`}`)
 	p.P(`type `, ormable.Name, `WithBeforeDeleteSet interface {`)
-	p.P(`BeforeDeleteSet(`, identCtx, `, []*`, ormable.OriginName, `, `, p.qualifiedGoIdentPtr(identGormDB), `) (`, p.qualifiedGoIdentPtr(identGormDB), `, error)`)
+	p.P(`BeforeDeleteSet(`, identCtx, `, []*`, ormable.OriginName, `, `, p.qualifiedGoIdentPtr(p.identGormDB), `) (`, p.qualifiedGoIdentPtr(p.identGormDB), `, error)`)
 	p.P(`}`)
 	p.P(`type `, ormable.Name, `WithAfterDeleteSet interface {`)
-	p.P(`AfterDeleteSet(`, identCtx, `, []*`, ormable.OriginName, `, `, p.qualifiedGoIdentPtr(identGormDB), `) error`)
+	p.P(`AfterDeleteSet(`, identCtx, `, []*`, ormable.OriginName, `, `, p.qualifiedGoIdentPtr(p.identGormDB), `) error`)
 	p.P(`}`)
 }
 
@@ -581,119 +1007,141 @@ func (p *OrmPlugin) generateAfterDeleteSetHookCall(orm *OrmableType) {
 	p.P(`}`)
 }
 
-func (p *OrmPlugin) generateListHandler(message *protogen.Message) {
+// generateListHandler outputs the handler of the list verb, i.e. the List
+// handler or the ListDeleted one of the soft deleted rows.
+func (p *OrmPlugin) generateListHandler(message *protogen.Message, verb string) {
 	typeName := p.messageType(message)
 	ormable := p.getOrmable(typeName)
 
-	p.P(`// DefaultList`, typeName, ` executes a gorm list call`)
-	listSign := fmt.Sprint(`func DefaultList`, typeName, `(ctx `, p.qualifiedGoIdent(identCtx), `, db `, p.qualifiedGoIdentPtr(identGormDB))
+	if verb == listDeletedService {
+		p.P(`// DefaultListDeleted`, typeName, ` executes a gorm list call of the soft deleted rows`)
+	} else {
+		p.P(`// DefaultList`, typeName, ` executes a gorm list call`)
+	}
+	listSign := fmt.Sprint(`func Default`, verb, typeName, `(ctx `, p.qualifiedGoIdent(identCtx), `, db `, p.qualifiedGoIdentPtr(p.identGormDB))
 	var f, s, pg, fs string
-	if p.listHasFiltering(ormable) {
-		listSign += fmt.Sprint(`, f `, p.qualifiedGoIdentPtr(identQueryFiltering))
+	if p.listHasFiltering(ormable, verb) {
+		listSign += fmt.Sprint(`, f `, p.qualifiedGoIdentPtr(p.identQueryFiltering))
 		f = "f"
 	} else {
 		f = "nil"
 	}
-	if p.listHasSorting(ormable) {
-		listSign += fmt.Sprint(`, s `, p.qualifiedGoIdentPtr(identQuerySorting))
+	if p.listHasSorting(ormable, verb) {
+		listSign += fmt.Sprint(`, s `, p.qualifiedGoIdentPtr(p.identQuerySorting))
 		s = "s"
 	} else {
 		s = "nil"
 	}
-	if p.listHasPagination(ormable) {
-		listSign += fmt.Sprint(`, p `, p.qualifiedGoIdentPtr(identQueryPagination))
+	if p.listHasPagination(ormable, verb) {
+		listSign += fmt.Sprint(`, p `, p.qualifiedGoIdentPtr(p.identQueryPagination))
 		pg = "p"
 	} else {
 		pg = "nil"
 	}
-	if p.listHasFieldSelection(ormable) {
-		listSign += fmt.Sprint(`, fs `, p.qualifiedGoIdentPtr(identQueryFieldSelection))
+	if p.listHasFieldSelection(ormable, verb) {
+		listSign += fmt.Sprint(`, fs `, p.qualifiedGoIdentPtr(p.identQueryFieldSelection))
 		fs = "fs"
 	} else {
 		fs = "nil"
 	}
-	listSign += fmt.Sprint(`) ([]*`, typeName, `, error) {`)
+	keyset := p.listHasKeyset(ormable, verb)
+	ret := `return nil, `
+	if keyset {
+		listSign += fmt.Sprint(`, pageToken string, pageSize int32) ([]*`, typeName, `, string, error) {`)
+		ret = `return nil, "", `
+	} else {
+		listSign += fmt.Sprint(`) ([]*`, typeName, `, error) {`)
+	}
 	p.P(listSign)
 	p.P(`in := `, typeName, `{}`)
 	p.P(`ormObj, err := in.ToORM(ctx)`)
 	p.P(`if err != nil {`)
-	p.P(`return nil, err`)
-	p.P(`}`)
-	p.generateBeforeListHookCall(ormable, "ApplyQuery", true)
-	p.P(`db, err = `, p.identFnCall(identApplyCollectionOperatorsFn, "ctx", "db", "&"+ormable.Name+"{}", "&"+typeName+"{}", f, s, pg, fs))
-	p.P(`if err != nil {`)
-	p.P(`return nil, err`)
+	p.P(ret, `err`)
 	p.P(`}`)
-	p.generateBeforeListHookCall(ormable, "Find", true)
+	p.generateBeforeListHookCall(ormable, verb, "ApplyQuery", true)
+	p.generateApplyCollectionOperators(ormable, f, s, pg, fs, ret)
+	p.generateBeforeListHookCall(ormable, verb, "Find", true)
 	p.P(`db = db.Where(&ormObj)`)
+	if verb == listDeletedService {
+		p.P(`db = db.Unscoped().Where("`, deletedAtColumn(ormable), ` IS NOT NULL")`)
+	}
 
 	// add default ordering by primary key
 	if p.hasPrimaryKey(ormable) {
-		pkName, pk := p.findPrimaryKey(ormable)
-		column := pk.GetTag().GetColumn()
-		if len(column) == 0 {
-			column = jgorm.ToDBName(pkName)
+		var columns []string
+		for _, pkName := range p.primaryKeyNames(ormable) {
+			columns = append(columns, columnName(pkName, ormable.Fields[pkName]))
 		}
-		p.P(`db = db.Order("`, column, `")`)
+		p.P(`db = db.Order("`, strings.Join(columns, ", "), `")`)
+	}
+	if keyset {
+		p.generateKeysetSetup(ormable, verb)
 	}
 
 	p.P(`ormResponse := []`, ormable.Name, `{}`)
 	p.P(`if err := db.Find(&ormResponse).Error; err != nil {`)
-	p.P(`return nil, err`)
+	p.generateDBErrorReturn(ret)
 	p.P(`}`)
-	p.generateAfterListHookCall(ormable, "Find", true)
+	if keyset {
+		p.generateKeysetPage()
+	}
+	p.generateAfterListHookCall(ormable, verb, "Find", true)
 	p.P(`pbResponse := []*`, typeName, `{}`)
 	p.P(`for _, responseEntry := range ormResponse {`)
 	p.P(`temp, err := responseEntry.ToPB(ctx)`)
 	p.P(`if err != nil {`)
-	p.P(`return nil, err`)
+	p.P(ret, `err`)
 	p.P(`}`)
 	p.P(`pbResponse = append(pbResponse, &temp)`)
 	p.P(`}`)
-	p.P(`return pbResponse, nil`)
+	if keyset {
+		p.P(`return pbResponse, nextPageToken, nil`)
+	} else {
+		p.P(`return pbResponse, nil`)
+	}
 	p.P(`}`)
-	p.generateBeforeListHookDef(ormable, "ApplyQuery", true)
-	p.generateBeforeListHookDef(ormable, "Find", true)
-	p.generateAfterListHookDef(ormable, "Find", true)
+	p.generateBeforeListHookDef(ormable, verb, "ApplyQuery", true)
+	p.generateBeforeListHookDef(ormable, verb, "Find", true)
+	p.generateAfterListHookDef(ormable, verb, "Find", true)
 }
 
-func (p *OrmPlugin) generateListHookDefHelper(orm *OrmableType, suffix string, returnDB bool) {
+func (p *OrmPlugin) generateListHookDefHelper(orm *OrmableType, verb, suffix string, returnDB bool) {
 	p.P(`type `, orm.Name, `With`, suffix, ` interface {`)
-	hookSign := fmt.Sprint(suffix, `(`, p.qualifiedGoIdent(identCtx), `, `, p.qualifiedGoIdentPtr(identGormDB))
+	hookSign := fmt.Sprint(suffix, `(`, p.qualifiedGoIdent(identCtx), `, `, p.qualifiedGoIdentPtr(p.identGormDB))
 	if returnDB {
 		hookSign += fmt.Sprint(`, *[]`, orm.Name)
 	}
-	if p.listHasFiltering(orm) {
-		hookSign += fmt.Sprint(`, `, p.qualifiedGoIdentPtr(identQueryFiltering))
+	if p.listHasFiltering(orm, verb) {
+		hookSign += fmt.Sprint(`, `, p.qualifiedGoIdentPtr(p.identQueryFiltering))
 	}
-	if p.listHasSorting(orm) {
-		hookSign += fmt.Sprint(`, `, p.qualifiedGoIdentPtr(identQuerySorting))
+	if p.listHasSorting(orm, verb) {
+		hookSign += fmt.Sprint(`, `, p.qualifiedGoIdentPtr(p.identQuerySorting))
 	}
-	if p.listHasPagination(orm) {
-		hookSign += fmt.Sprint(`, `, p.qualifiedGoIdentPtr(identQueryPagination))
+	if p.listHasPagination(orm, verb) {
+		hookSign += fmt.Sprint(`, `, p.qualifiedGoIdentPtr(p.identQueryPagination))
 	}
-	if p.listHasFieldSelection(orm) {
-		hookSign += fmt.Sprint(`, `, p.qualifiedGoIdentPtr(identQueryFieldSelection))
+	if p.listHasFieldSelection(orm, verb) {
+		hookSign += fmt.Sprint(`, `, p.qualifiedGoIdentPtr(p.identQueryFieldSelection))
 	}
 	hookSign += fmt.Sprint(`) `)
 	if returnDB {
 		hookSign += fmt.Sprint(`error`)
 	} else {
-		hookSign += fmt.Sprint(`(`, p.qualifiedGoIdentPtr(identGormDB), `, error)`)
+		hookSign += fmt.Sprint(`(`, p.qualifiedGoIdentPtr(p.identGormDB), `, error)`)
 	}
 	p.P(hookSign)
 	p.P(`}`)
 }
 
-func (p *OrmPlugin) generateBeforeListHookDef(orm *OrmableType, suffix string, returnDB bool) {
-	p.generateListHookDefHelper(orm, "BeforeList"+suffix, false)
+func (p *OrmPlugin) generateBeforeListHookDef(orm *OrmableType, verb, suffix string, returnDB bool) {
+	p.generateListHookDefHelper(orm, verb, "Before"+verb+suffix, false)
 }
 
-func (p *OrmPlugin) generateAfterListHookDef(orm *OrmableType, suffix string, returnDB bool) {
-	p.generateListHookDefHelper(orm, "AfterList"+suffix, true)
+func (p *OrmPlugin) generateAfterListHookDef(orm *OrmableType, verb, suffix string, returnDB bool) {
+	p.generateListHookDefHelper(orm, verb, "After"+verb+suffix, true)
 }
 
-func (p *OrmPlugin) generateListHookCallHelper(orm *OrmableType, suffix string, passORMResponse bool, returnDB bool) {
+func (p *OrmPlugin) generateListHookCallHelper(orm *OrmableType, verb, suffix string, passORMResponse bool, returnDB bool) {
 	p.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `With`, suffix, `); ok {`)
 	hookCall := ""
 	if passORMResponse {
@@ -701,21 +1149,23 @@ func (p *OrmPlugin) generateListHookCallHelper(orm *OrmableType, suffix string,
 	} else {
 		hookCall += fmt.Sprint(`if db, err = hook.`, suffix, `(ctx, db`)
 	}
-	if p.listHasFiltering(orm) {
+	if p.listHasFiltering(orm, verb) {
 		hookCall += `, f`
 	}
-	if p.listHasSorting(orm) {
+	if p.listHasSorting(orm, verb) {
 		hookCall += `, s`
 	}
-	if p.listHasPagination(orm) {
+	if p.listHasPagination(orm, verb) {
 		hookCall += `, p`
 	}
-	if p.listHasFieldSelection(orm) {
+	if p.listHasFieldSelection(orm, verb) {
 		hookCall += `, fs`
 	}
 	hookCall += `); err != nil {`
 	p.P(hookCall)
-	if returnDB {
+	if returnDB && p.listHasKeyset(orm, verb) {
+		p.P(`return nil, "", err`)
+	} else if returnDB {
 		p.P(`return nil, err`)
 	} else {
 		p.P(`return err`)
@@ -724,22 +1174,24 @@ func (p *OrmPlugin) generateListHookCallHelper(orm *OrmableType, suffix string,
 	p.P(`}`)
 }
 
-func (p *OrmPlugin) generateBeforeListHookCall(orm *OrmableType, suffix string, returnDB bool) {
-	p.generateListHookCallHelper(orm, "BeforeList"+suffix, false, returnDB)
+func (p *OrmPlugin) generateBeforeListHookCall(orm *OrmableType, verb, suffix string, returnDB bool) {
+	p.generateListHookCallHelper(orm, verb, "Before"+verb+suffix, false, returnDB)
 }
 
-func (p *OrmPlugin) generateAfterListHookCall(orm *OrmableType, suffix string, returnDB bool) {
-	p.generateListHookCallHelper(orm, "AfterList"+suffix, true, returnDB)
+func (p *OrmPlugin) generateAfterListHookCall(orm *OrmableType, verb, suffix string, returnDB bool) {
+	p.generateListHookCallHelper(orm, verb, "After"+verb+suffix, true, returnDB)
 }
 
 func (p *OrmPlugin) generateStrictUpdateHandler(message *protogen.Message) {
 	typeName := p.messageType(message)
 	p.P(`// DefaultStrictUpdate`, typeName, ` clears / replaces / appends first level 1:many children and then executes a gorm update call`)
 	p.P(`func DefaultStrictUpdate`, typeName, `(ctx `, identCtx, `, in *`,
-		typeName, `, db *`, identGormDB, `) (*`, typeName, `, error) {`)
+		typeName, `, db *`, p.identGormDB, `) (*`, typeName, `, error) {`)
 	p.P(`if in == nil {`)
-	p.P(`return nil, `, identFmtErrorf, `("Nil argument to DefaultStrictUpdate`, typeName, `")`)
+	p.P(`return nil, `, identNilArgumentError)
 	p.P(`}`)
+	ormable := p.getOrmable(typeName)
+	p.generateHistoryTransaction(ormable, `DefaultStrictUpdate`+typeName+`(ctx, in, tx)`, `*`+typeName)
 	p.P(`ormObj, err := in.ToORM(ctx)`)
 	p.P(`if err != nil {`)
 	p.P(`return nil, err`)
@@ -747,31 +1199,50 @@ func (p *OrmPlugin) generateStrictUpdateHandler(message *protogen.Message) {
 	if getMessageOptions(message).GetMultiAccount() {
 		p.generateAccountIdWhereClause()
 	}
-	ormable := p.getOrmable(typeName)
-	if p.Gateway {
+	softDelete := getMessageOptions(message).GetSoftDelete()
+	if p.Gateway || softDelete && p.hasPrimaryKey(ormable) {
 		p.P(`var count int64`)
 	}
 	if p.hasPrimaryKey(ormable) {
-		pkName, pk := p.findPrimaryKey(ormable)
-		column := pk.GetTag().GetColumn()
-		if len(column) == 0 {
-			column = jgorm.ToDBName(pkName)
-		}
+		condition, args := p.primaryKeyCondition(ormable, "ormObj")
+		where := `"` + condition + `", ` + strings.Join(args, ", ")
 		p.P(`lockedRow := &`, typeName, `ORM{}`)
 		var count string
 		var rowsAffected string
-		if p.Gateway {
+		if p.Gateway || softDelete {
 			count = `count = `
 			rowsAffected = `.RowsAffected`
 		}
-		p.P(count+`db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("`, column, `=?", ormObj.`, pkName, `).First(lockedRow)`+rowsAffected)
+		if p.isGormV2() {
+			p.P(count+`db.Model(&ormObj).Clauses(`, identGormClauseLocking, `{Strength: "UPDATE"}).Where(`, where, `).First(lockedRow)`+rowsAffected)
+		} else {
+			p.P(count+`db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where(`, where, `).First(lockedRow)`+rowsAffected)
+		}
+		if softDelete {
+			// Saving over a soft-deleted row would insert a duplicate primary key
+			p.P(`if count == 0 {`)
+			p.P(`var deleted int64`)
+			p.P(`if err = db.Unscoped().Model(&`, ormable.Name, `{}).Where(`, where, `).Where("`, deletedAtColumn(ormable), ` IS NOT NULL").Count(&deleted).Error; err != nil {`)
+			p.generateDBErrorReturn(`return nil, `)
+			p.P(`}`)
+			p.P(`if deleted > 0 {`)
+			p.P(`return nil, `, identNotFoundFn, `(`, p.identGormErrRecordNotFound, `)`)
+			p.P(`}`)
+			p.P(`}`)
+		}
+		if ormable.version != "" {
+			p.generateVersionCheck(ormable)
+		}
+		p.generateUpdateAudit(ormable)
+		p.generateHistoryLockedRow(ormable)
 	}
 	p.generateBeforeHookCall(ormable, "StrictUpdateCleanup")
 	p.handleChildAssociations(message)
 	p.generateBeforeHookCall(ormable, "StrictUpdateSave")
 	p.P(`if err = db.Save(&ormObj).Error; err != nil {`)
-	p.P(`return nil, err`)
+	p.generateDBErrorReturn(`return nil, `)
 	p.P(`}`)
+	p.generateHistoryWrite(ormable, `operation`, `before`, `&ormObj`, `return nil, `)
 	p.generateAfterHookCall(ormable, "StrictUpdateSave")
 	p.P(`pbResponse, err := ormObj.ToPB(ctx)`)
 	p.P(`if err != nil {`)
@@ -780,7 +1251,7 @@ func (p *OrmPlugin) generateStrictUpdateHandler(message *protogen.Message) {
 
 	if p.Gateway {
 		p.P(`if count == 0 {`)
-		p.P(`err = `, identGatewaySetCreatedFn, `(ctx, "")`)
+		p.P(`err = `, p.identGatewaySetCreatedFn, `(ctx, "")`)
 		p.P(`}`)
 	}
 
@@ -853,7 +1324,11 @@ func (p *OrmPlugin) handleChildAssociationsByName(message *protogen.Message, fie
 			action = fmt.Sprintf("%s()", assocHandler)
 		}
 
-		p.P(`if err = db.Model(&ormObj).Association("`, fieldName, `").`, action, `.Error; err != nil {`)
+		// gorm v2 association methods return the error directly
+		if !p.isGormV2() {
+			action += ".Error"
+		}
+		p.P(`if err = db.Model(&ormObj).Association("`, fieldName, `").`, action, `; err != nil {`)
 		p.P(`return nil, err`)
 		p.P(`}`)
 		p.P(`ormObj.`, fieldName, ` = nil`)
@@ -878,31 +1353,33 @@ func (p *OrmPlugin) removeChildAssociationsByName(message *protogen.Message, fie
 			assocKeyName = field.GetHasOne().GetAssociationForeignkey()
 			foreignKeyName = field.GetHasOne().GetForeignkey()
 		}
-		assocKeyType := ormable.Fields[assocKeyName].Type
 		assocOrmable := p.getOrmable(field.Type)
-		foreignKeyType := p.qualifiedGoIdent(assocOrmable.Fields[foreignKeyName].F.GoIdent)
-
-		// foreignKeyF := assocOrmable.Fields[foreignKeyName].F
+		foreignKeyNames := splitKeyNames(foreignKeyName, false)
 		p.P(`filter`, fieldName, ` := `, strings.Trim(field.Type, "[]*"), `{}`)
-		zeroValue := p.guessZeroValue(assocKeyType)
-		if strings.Contains(assocKeyType, "*") {
-			p.P(`if ormObj.`, assocKeyName, ` == nil || *ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
-		} else {
-			p.P(`if ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
-		}
-		p.P(`return nil, `, identEmptyIDError)
-		p.P(`}`)
-		filterDesc := "filter" + fieldName + "." + foreignKeyName
-		ormDesc := "ormObj." + assocKeyName
-		if strings.HasPrefix(foreignKeyType, "*") {
-			p.P(filterDesc, ` = new(`, strings.TrimPrefix(foreignKeyType, "*"), `)`)
-			filterDesc = "*" + filterDesc
-		}
-		if strings.HasPrefix(assocKeyType, "*") {
-			ormDesc = "*" + ormDesc
+		for i, assocKeyName := range splitKeyNames(assocKeyName, false) {
+			foreignKeyName := foreignKeyNames[i]
+			assocKeyType := ormable.Fields[assocKeyName].Type
+			foreignKeyType := p.qualifiedGoIdent(assocOrmable.Fields[foreignKeyName].F.GoIdent)
+			zeroValue := p.guessZeroValue(assocKeyType)
+			if strings.Contains(assocKeyType, "*") {
+				p.P(`if ormObj.`, assocKeyName, ` == nil || *ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
+			} else {
+				p.P(`if ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
+			}
+			p.P(`return nil, `, identEmptyIDError)
+			p.P(`}`)
+			filterDesc := "filter" + fieldName + "." + foreignKeyName
+			ormDesc := "ormObj." + assocKeyName
+			if strings.HasPrefix(foreignKeyType, "*") {
+				p.P(filterDesc, ` = new(`, strings.TrimPrefix(foreignKeyType, "*"), `)`)
+				filterDesc = "*" + filterDesc
+			}
+			if strings.HasPrefix(assocKeyType, "*") {
+				ormDesc = "*" + ormDesc
+			}
+			p.P(filterDesc, " = ", ormDesc)
 		}
-		p.P(filterDesc, " = ", ormDesc)
-		p.P(`if err = db.Where(filter`, fieldName, `).Delete(`, strings.Trim(field.Type, "[]*"), `{}).Error; err != nil {`)
+		p.P(`if err = db.Where(filter`, fieldName, `).Delete(&`, strings.Trim(field.Type, "[]*"), `{}).Error; err != nil {`)
 		p.P(`return nil, err`)
 		p.P(`}`)
 	}
@@ -950,18 +1427,20 @@ func (p *OrmPlugin) readHasFieldSelection(ormable *OrmableType) bool {
 	return p.hasMethodGenericHelper(ormable, readService, p.getFieldSelection)
 }
 
-func (p *OrmPlugin) listHasFiltering(ormable *OrmableType) bool {
-	return p.hasMethodGenericHelper(ormable, listService, p.getFiltering)
+func (p *OrmPlugin) listHasFiltering(ormable *OrmableType, verb string) bool {
+	return p.hasMethodGenericHelper(ormable, verb, p.getFiltering) || p.hasMethodGenericHelper(ormable, verb, p.getFilter)
 }
 
-func (p *OrmPlugin) listHasSorting(ormable *OrmableType) bool {
-	return p.hasMethodGenericHelper(ormable, listService, p.getSorting)
+func (p *OrmPlugin) listHasSorting(ormable *OrmableType, verb string) bool {
+	return p.hasMethodGenericHelper(ormable, verb, p.getSorting) || p.hasMethodGenericHelper(ormable, verb, p.getOrderBy)
 }
 
-func (p *OrmPlugin) listHasPagination(ormable *OrmableType) bool {
-	return p.hasMethodGenericHelper(ormable, listService, p.getPagination)
+// listHasPagination reports whether the list verb of ormable is limit/offset
+// paginated, the keyset paginated lists ignore the Pagination of the request.
+func (p *OrmPlugin) listHasPagination(ormable *OrmableType, verb string) bool {
+	return !p.listHasKeyset(ormable, verb) && p.hasMethodGenericHelper(ormable, verb, p.getPagination)
 }
 
-func (p *OrmPlugin) listHasFieldSelection(ormable *OrmableType) bool {
-	return p.hasMethodGenericHelper(ormable, listService, p.getFieldSelection)
+func (p *OrmPlugin) listHasFieldSelection(ormable *OrmableType, verb string) bool {
+	return p.hasMethodGenericHelper(ormable, verb, p.getFieldSelection)
 }
@@ -0,0 +1,274 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	gorm "github.com/edhaight/protoc-gen-gorm/options"
+)
+
+// The operations recorded in the history rows.
+const (
+	historyCreate  = "CREATE"
+	historyUpdate  = "UPDATE"
+	historyDelete  = "DELETE"
+	historyRestore = "RESTORE"
+)
+
+// historyTypeName is the name the type of the history rows of parent is
+// registered under, e.g. UserHistory for User.
+func historyTypeName(parent *OrmableType) string {
+	return parent.OriginName + "History"
+}
+
+// hasHistory reports whether the handlers of ormable write history rows.
+func hasHistory(ormable *OrmableType) bool {
+	return getMessageOptions(ormable.Message).GetHistory()
+}
+
+// parseHistory registers the type of the history rows of parent, which are
+// keyed by its primary key.
+func (p *OrmPlugin) parseHistory(parent *OrmableType) {
+	if !hasHistory(parent) {
+		return
+	}
+	typeName := historyTypeName(parent)
+	if _, ok := p.messages[typeName]; ok || p.isOrmable(typeName) {
+		p.Fail("Cannot generate history table of", parent.Name, "as type", typeName, "already exists.")
+	}
+	if !p.hasPrimaryKey(parent) {
+		p.Fail("Cannot generate history table of", parent.Name, "as it has no primary key.")
+	}
+	if p.hasCompositeKey(parent) {
+		p.Fail("Cannot generate history table of", parent.Name, "as its history rows can't reference its composite primary key.")
+	}
+	_, pk := p.findPrimaryKey(parent)
+	history := NewOrmableType(typeName, parent.Message, parent.File)
+	history.Name = fmt.Sprintf("%sORM", typeName)
+	history.Package = parent.Package
+	history.historyOf = parent
+
+	builtin := func(name string, tag *gorm.GormTag) *Field {
+		return &Field{F: &protogen.Field{GoIdent: protogen.GoIdent{GoName: name}}, Type: name,
+			GormFieldOptions: &gorm.GormFieldOptions{Tag: tag}}
+	}
+	history.Fields["Id"] = builtin("uint64", &gorm.GormTag{PrimaryKey: proto.Bool(true)})
+	objectIdTag := &gorm.GormTag{Index: proto.String("")}
+	if sqlType := pk.GetTag().GetType(); sqlType != "" {
+		objectIdTag.Type = proto.String(sqlType)
+	}
+	history.Fields["ObjectId"] = &Field{F: &protogen.Field{GoIdent: pk.F.GoIdent}, Type: pk.Type,
+		GormFieldOptions: &gorm.GormFieldOptions{Tag: objectIdTag}}
+	history.Fields["Operation"] = builtin("string", &gorm.GormTag{NotNull: proto.Bool(true)})
+	history.Fields["Timestamp"] = &Field{F: &protogen.Field{GoIdent: identTime}, Type: identTime.GoName,
+		GormFieldOptions: &gorm.GormFieldOptions{Tag: &gorm.GormTag{NotNull: proto.Bool(true)}}}
+	history.Fields["Actor"] = builtin("string", nil)
+	history.Fields["Before"] = builtin("string", &gorm.GormTag{Type: proto.String("text")})
+	history.Fields["After"] = builtin("string", &gorm.GormTag{Type: proto.String("text")})
+	if accountID, ok := parent.Fields["AccountID"]; ok && getMessageOptions(parent.Message).GetMultiAccount() {
+		history.Fields["AccountID"] = accountID
+	}
+	p.ormableTypes[typeName] = history
+}
+
+// generateHistoryType outputs the type of the history rows of message.
+func (p *OrmPlugin) generateHistoryType(message *protogen.Message) {
+	ormable := p.getOrmableMessage(message)
+	if !hasHistory(ormable) {
+		return
+	}
+	history := p.getOrmable(historyTypeName(ormable))
+	p.P(`// `, history.Name, ` holds a change of `, ormable.Name, `, its state before and after`)
+	p.P(`// the change are serialized as JSON`)
+	p.generateOrmableStruct(history)
+	p.P()
+	p.P(`// TableName overrides the default tablename generated by GORM`)
+	p.P(`func (`, history.Name, `) TableName() string {`)
+	p.P(`return "`, p.tableName(history), `"`)
+	p.P(`}`)
+	p.P()
+}
+
+// generateHistoryHelpers outputs the function writing the history rows of the
+// changes of ormable.
+func (p *OrmPlugin) generateHistoryHelpers(ormable *OrmableType) {
+	if !hasHistory(ormable) {
+		return
+	}
+	typeName := ormable.OriginName
+	pkName, _ := p.findPrimaryKey(ormable)
+	p.P(`// write`, typeName, `History inserts the history row of the operation changing`)
+	p.P(`// the before state of `, ormable.Name, `, nil when created, to the after one, nil`)
+	p.P(`// when deleted.`)
+	p.P(`func write`, typeName, `History(ctx `, identCtx, `, db *`, p.identGormDB, `, operation string, before, after *`, ormable.Name, `) error {`)
+	p.P(`actor, err := `, p.identityIdent(), `(ctx)`)
+	p.P(`if err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	history := p.getOrmable(historyTypeName(ormable))
+	p.P(`row := `, history.Name, `{Operation: operation, Timestamp: `, identTimeNow, `(), Actor: actor}`)
+	for _, state := range []string{"before", "after"} {
+		p.P(`if `, state, ` != nil {`)
+		p.P(`row.ObjectId = `, state, `.`, pkName)
+		if _, ok := history.Fields["AccountID"]; ok {
+			p.P(`row.AccountID = `, state, `.AccountID`)
+		}
+		p.P(`data, err := `, identJsonMarshal, `(`, state, `)`)
+		p.P(`if err != nil {`)
+		p.P(`return err`)
+		p.P(`}`)
+		if state == "before" {
+			p.P(`row.Before = string(data)`)
+		} else {
+			p.P(`row.After = string(data)`)
+		}
+		p.P(`}`)
+	}
+	p.P(`return db.Create(&row).Error`)
+	p.P(`}`)
+	p.P()
+}
+
+// generateHistoryTransaction outputs the rerun of the call of the handler in
+// a transaction when ormable has history rows and db isn't a transaction.
+func (p *OrmPlugin) generateHistoryTransaction(ormable *OrmableType, call, out string) {
+	if hasHistory(ormable) {
+		p.generateTransaction(ormable, call, out)
+	}
+}
+
+// generateTransactionHelper outputs the function running the changes of
+// ormable in a transaction.
+func (p *OrmPlugin) generateTransactionHelper(ormable *OrmableType) {
+	typeName := ormable.OriginName
+	p.P(`// with`, typeName, `Transaction runs fn in a new transaction of db, so that the`)
+	p.P(`// changes of `, ormable.Name, ` are committed together. It reports false`)
+	p.P(`// without running fn when db is a transaction already.`)
+	p.P(`func with`, typeName, `Transaction(db *`, p.identGormDB, `, fn func(*`, p.identGormDB, `) error) (bool, error) {`)
+	if p.isGormV2() {
+		p.P(`if _, ok := db.Statement.ConnPool.(`, identGormTxCommitter, `); ok {`)
+	} else {
+		p.P(`if _, ok := db.CommonDB().(*`, identSqlTx, `); ok {`)
+	}
+	p.P(`return false, nil`)
+	p.P(`}`)
+	p.P(`tx := db.Begin()`)
+	p.P(`if tx.Error != nil {`)
+	p.P(`return true, tx.Error`)
+	p.P(`}`)
+	p.P(`if err := fn(tx); err != nil {`)
+	p.P(`tx.Rollback()`)
+	p.P(`return true, err`)
+	p.P(`}`)
+	p.P(`return true, tx.Commit().Error`)
+	p.P(`}`)
+	p.P()
+}
+
+// generateTransaction outputs the rerun of the call of the handler in a
+// transaction when db isn't one, out is the type of the object returned by
+// the handler along with the error, if any.
+func (p *OrmPlugin) generateTransaction(ormable *OrmableType, call, out string) {
+	if out == "" {
+		p.P(`if ok, err := with`, ormable.OriginName, `Transaction(db, func(tx *`, p.identGormDB, `) error {`)
+		p.P(`return `, call)
+		p.P(`}); ok {`)
+		p.P(`return err`)
+		p.P(`}`)
+		return
+	}
+	p.P(`var out `, out)
+	p.P(`if ok, err := with`, ormable.OriginName, `Transaction(db, func(tx *`, p.identGormDB, `) (err error) {`)
+	p.P(`out, err = `, call)
+	p.P(`return err`)
+	p.P(`}); ok {`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.P(`return out, nil`)
+	p.P(`}`)
+}
+
+// generateHistoryWrite outputs the writing of the history row of the
+// operation expression, returning its error after ret.
+func (p *OrmPlugin) generateHistoryWrite(ormable *OrmableType, operation, before, after, ret string) {
+	if !hasHistory(ormable) {
+		return
+	}
+	p.P(`if err = write`, ormable.OriginName, `History(ctx, db, `, operation, `, `, before, `, `, after, `); err != nil {`)
+	p.P(ret, `err`)
+	p.P(`}`)
+}
+
+// generateHistoryDeletedRows outputs the read of the rows about to be deleted
+// with the where clause of the db expression, into the before variable.
+func (p *OrmPlugin) generateHistoryDeletedRows(ormable *OrmableType, db, where string) {
+	if !hasHistory(ormable) {
+		return
+	}
+	p.P(`before := []*`, ormable.Name, `{}`)
+	p.P(`if err = `, db, `.Where(`, where, `).Find(&before).Error; err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+}
+
+// generateHistoryDeletes outputs the writing of the history rows of the
+// deleted rows held by the before variable.
+func (p *OrmPlugin) generateHistoryDeletes(ormable *OrmableType) {
+	if !hasHistory(ormable) {
+		return
+	}
+	p.P(`for _, row := range before {`)
+	p.generateHistoryWrite(ormable, strconv.Quote(historyDelete), `row`, `nil`, `return `)
+	p.P(`}`)
+}
+
+// generateListHistoryHandler outputs the handler listing the history rows
+// of the object with the primary key of in, oldest first.
+func (p *OrmPlugin) generateListHistoryHandler(message *protogen.Message) {
+	typeName := p.messageType(message)
+	ormable := p.getOrmable(typeName)
+	if !hasHistory(ormable) {
+		return
+	}
+	history := p.getOrmable(historyTypeName(ormable))
+	p.P(`// DefaultListHistory`, typeName, ` returns the history rows of the `, typeName, ` with the`)
+	p.P(`// primary key of in, oldest first`)
+	p.P(`func DefaultListHistory`, typeName, `(ctx `, identCtx, `, in *`, typeName, `, db *`, p.identGormDB, `) ([]*`, history.Name, `, error) {`)
+	p.P(`if in == nil {`)
+	p.P(`return nil, `, identNilArgumentError)
+	p.P(`}`)
+	p.P(`ormObj, err := in.ToORM(ctx)`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.generateEmptyPrimaryKeyCheck(ormable, `return nil, `)
+	if getMessageOptions(message).GetMultiAccount() {
+		p.generateAccountIdWhereClause()
+	}
+	pkName, _ := p.findPrimaryKey(ormable)
+	p.P(`rows := []*`, history.Name, `{}`)
+	p.P(`if err = db.Where("`, columnName("ObjectId", history.Fields["ObjectId"]), ` = ?", ormObj.`, pkName, `).Order("`,
+		columnName("Id", history.Fields["Id"]), `").Find(&rows).Error; err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.P(`return rows, nil`)
+	p.P(`}`)
+	p.P()
+}
+
+// generateHistoryLockedRow outputs the before variable holding the stored
+// row read into the lockedRow variable, and the operation variable. The row
+// is missing, and nil, when the update inserts it.
+func (p *OrmPlugin) generateHistoryLockedRow(ormable *OrmableType) {
+	if !hasHistory(ormable) {
+		return
+	}
+	p.P(`before, operation := lockedRow, `, strconv.Quote(historyUpdate))
+	p.P(`if `, p.emptyPrimaryKeyCondition(ormable, "lockedRow"), ` {`)
+	p.P(`before, operation = nil, `, strconv.Quote(historyCreate))
+	p.P(`}`)
+}
@@ -0,0 +1,118 @@
+package plugin
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// getPageToken returns the name of the page_token field of the request of a
+// keyset paginated list.
+func (p *OrmPlugin) getPageToken(object *protogen.Message) string {
+	return p.getFieldOfName(object, "page_token")
+}
+
+// getPageSize returns the name of the page_size field of the request of a
+// keyset paginated list.
+func (p *OrmPlugin) getPageSize(object *protogen.Message) string {
+	return p.getFieldOfName(object, "page_size")
+}
+
+// getNextPageToken returns the name of the next_page_token field of the
+// response of a keyset paginated list.
+func (p *OrmPlugin) getNextPageToken(object *protogen.Message) string {
+	return p.getFieldOfName(object, "next_page_token")
+}
+
+func (p *OrmPlugin) getFieldOfName(object *protogen.Message, name string) string {
+	for _, field := range object.Fields {
+		if string(field.Desc.Name()) == name {
+			return field.GoName
+		}
+	}
+	return ""
+}
+
+// listHasKeyset reports whether the list verb of ormable is keyset paginated,
+// i.e. its request has a page_token field.
+func (p *OrmPlugin) listHasKeyset(ormable *OrmableType, verb string) bool {
+	return p.hasPrimaryKey(ormable) && p.hasMethodGenericHelper(ormable, verb, p.getPageToken)
+}
+
+// generateQueryColumnMethod outputs the method returning the column and the
+// field of the field paths of ormable, used by the keyset pagination and the
+// query runtime package.
+func (p *OrmPlugin) generateQueryColumnMethod(ormable *OrmableType) {
+	if !p.localQuery && !p.listHasKeyset(ormable, listService) && !p.listHasKeyset(ormable, listDeletedService) {
+		return
+	}
+	p.P(`// QueryColumn returns the column and a pointer to the field of the field path,`)
+	p.P(`// or a nil pointer when path isn't a column of `, ormable.Name)
+	p.P(`func (m *`, ormable.Name, `) QueryColumn(path string) (string, interface{}) {`)
+	p.P(`switch path {`)
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		field := ormable.Fields[fieldName]
+		tag := field.GetTag()
+		// the foreign keys of the associations keep the field of the association
+		if field.F == nil || field.F.Desc == nil || field.F.GoName != fieldName || isAssociation(field) ||
+			tag.GetIgnore() || tag.GetEmbedded() {
+			continue
+		}
+		p.P(`case "`, field.F.Desc.Name(), `":`)
+		p.P(`return "`, columnName(fieldName, field), `", &m.`, fieldName)
+	}
+	p.P(`}`)
+	p.P(`return "", nil`)
+	p.P(`}`)
+	p.P()
+}
+
+// generateKeysetSetup outputs the sort keys of the list, the ones of the
+// sorting criteria followed by the primary key, and the condition selecting
+// the rows following the ones of the page token. The nullable sort fields are
+// rejected, and SQL Server, which can't compare row values, gets the expanded
+// condition.
+func (p *OrmPlugin) generateKeysetSetup(ormable *OrmableType, verb string) {
+	p.P(`keysetRow := &`, ormable.Name, `{}`)
+	p.P(`keys := []`, identKeysetKey, `{}`)
+	if p.listHasSorting(ormable, verb) {
+		p.P(`for _, c := range s.GetCriterias() {`)
+		p.P(`column, value := keysetRow.QueryColumn(c.GetTag())`)
+		p.P(`if value == nil {`)
+		p.P(`return nil, "", `, identInvalidArgumentFn, `(`, identFmtErrorf, `("cannot paginate by %s", c.GetTag()))`)
+		p.P(`}`)
+		p.P(`if `, identKeysetNullableFn, `(value) {`)
+		p.P(`return nil, "", `, identInvalidArgumentFn, `(`, identFmtErrorf, `("cannot paginate by the nullable %s", c.GetTag()))`)
+		p.P(`}`)
+		p.P(`keys = append(keys, `, identKeysetKey, `{Column: column, Desc: c.IsDesc(), Value: value})`)
+		p.P(`}`)
+	}
+	for _, pkName := range p.primaryKeyNames(ormable) {
+		p.P(`keys = append(keys, `, identKeysetKey, `{Column: "`, columnName(pkName, ormable.Fields[pkName]), `", Value: &keysetRow.`, pkName, `})`)
+	}
+	p.P(`if pageToken != "" {`)
+	p.P(`if err := `, identKeysetDecodeFn, `(pageToken, keys); err != nil {`)
+	p.P(`return nil, "", err`)
+	p.P(`}`)
+	where := identKeysetWhereFn
+	if p.Engine == EngineSQLServer {
+		where = identKeysetExpandedWhereFn
+	}
+	p.P(`where, args := `, where, `(keys)`)
+	p.P(`db = db.Where(where, args...)`)
+	p.P(`}`)
+	p.P(`if pageSize > 0 {`)
+	p.P(`db = db.Limit(int(pageSize) + 1)`)
+	p.P(`}`)
+}
+
+// generateKeysetPage outputs the trimming of the extra row read past the page
+// size, and the next page token following the last row of the page.
+func (p *OrmPlugin) generateKeysetPage() {
+	p.P(`nextPageToken := ""`)
+	p.P(`if pageSize > 0 && len(ormResponse) > int(pageSize) {`)
+	p.P(`ormResponse = ormResponse[:pageSize]`)
+	p.P(`*keysetRow = ormResponse[pageSize-1]`)
+	p.P(`if nextPageToken, err = `, identKeysetEncodeFn, `(keys); err != nil {`)
+	p.P(`return nil, "", err`)
+	p.P(`}`)
+	p.P(`}`)
+}
@@ -4,53 +4,79 @@ import "google.golang.org/protobuf/compiler/protogen"
 
 var (
 	// stdlib idents
-	identCtx                = newKnownIdent("Context", "context")
-	identTime               = newKnownIdent("Time", "time")
-	identStringsHasPrefixFn = newKnownIdent("HasPrefix", "strings")
-	identJsonMarshal        = newKnownIdent("Marshal", "encoding/json")
-	identFmtErrorf          = newKnownIdent("Errorf", "fmt")
+	identCtx                 = newKnownIdent("Context", "context")
+	identTime                = newKnownIdent("Time", "time")
+	identTimeDuration        = newKnownIdent("Duration", "time")
+	identTimeNow             = newKnownIdent("Now", "time")
+	identStringsHasPrefixFn  = newKnownIdent("HasPrefix", "strings")
+	identStringsTrimPrefixFn = newKnownIdent("TrimPrefix", "strings")
+	identJsonMarshal         = newKnownIdent("Marshal", "encoding/json")
+	identJsonUnmarshal       = newKnownIdent("Unmarshal", "encoding/json")
+	identJsonRawMessage      = newKnownIdent("RawMessage", "encoding/json")
+	identFmtErrorf           = newKnownIdent("Errorf", "fmt")
+	identFmtSprintf          = newKnownIdent("Sprintf", "fmt")
+	identSqlErrNoRows        = newKnownIdent("ErrNoRows", "database/sql")
+	identSqlTx               = newKnownIdent("Tx", "database/sql")
+	// protobuf idents
+	identProtojsonMarshal   = newKnownIdent("Marshal", "google.golang.org/protobuf/encoding/protojson")
+	identProtojsonUnmarshal = newKnownIdent("Unmarshal", "google.golang.org/protobuf/encoding/protojson")
+	identProtoMarshal       = newKnownIdent("Marshal", "google.golang.org/protobuf/proto")
+	identProtoUnmarshal     = newKnownIdent("Unmarshal", "google.golang.org/protobuf/proto")
 	// proto custom types
 	identTypesInet               = newKnownIdent("Inet", "github.com/edhaight/protoc-gen-gorm/types")
 	identTypesInetValue          = newKnownIdent("InetValue", "github.com/edhaight/protoc-gen-gorm/types")
 	identTypesUUIDValue          = newKnownIdent("UUIDValue", "github.com/edhaight/protoc-gen-gorm/types")
 	identTypesUUID               = newKnownIdent("UUID", "github.com/edhaight/protoc-gen-gorm/types")
+	identTypesInterval           = newKnownIdent("Interval", "github.com/edhaight/protoc-gen-gorm/types")
 	identTypesJSONValue          = newKnownIdent("JSONValue", "github.com/edhaight/protoc-gen-gorm/types")
 	identTypesParseInetFn        = newKnownIdent("ParseInet", "github.com/edhaight/protoc-gen-gorm/types")
 	identTypesParseTimeFn        = newKnownIdent("ParseTime", "github.com/edhaight/protoc-gen-gorm/types")
 	identTypesTimeOnlyByStringFn = newKnownIdent("TimeOnlyByString", "github.com/edhaight/protoc-gen-gorm/types")
 	// gorm idents
-	identGormDB         = newKnownIdent("DB", "github.com/jinzhu/gorm")
 	identpqJsonb        = newKnownIdent("Jsonb", "github.com/jinzhu/gorm/dialects/postgres")
-	identpqBoolArray    = newKnownIdent("Jsonb", "github.com/jinzhu/gorm/dialects/postgres")
+	identpqBoolArray    = newKnownIdent("BoolArray", "github.com/lib/pq")
 	identpqFloat32Array = newKnownIdent("Float32Array", "github.com/lib/pq")
 	identpqFloat64Array = newKnownIdent("Float64Array", "github.com/lib/pq")
 	identpqInt32Array   = newKnownIdent("Int32Array", "github.com/lib/pq")
 	identpqInt64Array   = newKnownIdent("Int64Array", "github.com/lib/pq")
 	identpqStringArray  = newKnownIdent("StringArray", "github.com/lib/pq")
+	// gorm v2 idents
+	identGormClauseLocking           = newKnownIdent("Locking", "gorm.io/gorm/clause")
+	identGormClauseOnConflict        = newKnownIdent("OnConflict", "gorm.io/gorm/clause")
+	identGormClauseColumn            = newKnownIdent("Column", "gorm.io/gorm/clause")
+	identGormClauseAssignment        = newKnownIdent("Assignment", "gorm.io/gorm/clause")
+	identGormClauseAssignmentColumns = newKnownIdent("AssignmentColumns", "gorm.io/gorm/clause")
+	identGormClauseAssociations      = newKnownIdent("Associations", "gorm.io/gorm/clause")
+	identGormClauseWhere             = newKnownIdent("Where", "gorm.io/gorm/clause")
+	identGormClauseExpression        = newKnownIdent("Expression", "gorm.io/gorm/clause")
+	identGormDeletedAt               = newKnownIdent("DeletedAt", "gorm.io/gorm")
+	identGormTxCommitter             = newKnownIdent("TxCommitter", "gorm.io/gorm")
+	identDatatypesJSON               = newKnownIdent("JSON", "gorm.io/datatypes")
 	// timestamp idents
 	identTimestamp      = newKnownIdent("Timestamp", "github.com/golang/protobuf/ptypes")
 	identTimestampProto = newKnownIdent("TimestampProto", "github.com/golang/protobuf/ptypes")
+	// duration idents
+	identDuration      = newKnownIdent("Duration", "github.com/golang/protobuf/ptypes")
+	identDurationProto = newKnownIdent("DurationProto", "github.com/golang/protobuf/ptypes")
 	// error idents
 	identNilArgumentError             = newKnownIdent("NilArgumentError", "github.com/edhaight/protoc-gen-gorm/errors")
 	identEmptyIDError                 = newKnownIdent("EmptyIdError", "github.com/edhaight/protoc-gen-gorm/errors")
 	identBadRepeatedFieldMaskTplError = newKnownIdent("BadRepeatedFieldMaskTpl", "github.com/edhaight/protoc-gen-gorm/errors")
 	identNoTransactionError           = newKnownIdent("NoTransactionError", "github.com/edhaight/protoc-gen-gorm/errors")
-	// field selection idents
-	identQueryFieldSelection = newKnownIdent("FieldSelection", "github.com/infobloxopen/atlas-app-toolkit/query")
-	identQueryPagination     = newKnownIdent("Pagination", "github.com/infobloxopen/atlas-app-toolkit/query")
-	identQuerySorting        = newKnownIdent("Sorting", "github.com/infobloxopen/atlas-app-toolkit/query")
-	identQueryFiltering      = newKnownIdent("Filtering", "github.com/infobloxopen/atlas-app-toolkit/query")
-	identQueryPageInfo       = newKnownIdent("PageInfo", "github.com/infobloxopen/atlas-app-toolkit/query")
-
-	identApplyFieldSelectionFn      = newKnownIdent("ApplyFieldSelection", "github.com/infobloxopen/atlas-app-toolkit/gorm")
-	identMergeWithMaskFn            = newKnownIdent("MergeWithMask", "github.com/infobloxopen/atlas-app-toolkit/gorm")
-	identApplyCollectionOperatorsFn = newKnownIdent("ApplyCollectionOperators", "github.com/infobloxopen/atlas-app-toolkit/gorm")
-	identTkFromContextFn            = newKnownIdent("FromContext", "github.com/infobloxopen/atlas-app-toolkit/gorm")
-	// Atlas resources idents
-	identResourceEncodeFn      = newKnownIdent("Encode", "github.com/infobloxopen/atlas-app-toolkit/gorm/resource")
-	identResourceDecodeFn      = newKnownIdent("Decode", "github.com/infobloxopen/atlas-app-toolkit/gorm/resource")
-	identResourceDecodeBytesFn = newKnownIdent("DecodeBytes", "github.com/infobloxopen/atlas-app-toolkit/gorm/resource")
-	identResourceDecodeInt64Fn = newKnownIdent("DecodeInt64", "github.com/infobloxopen/atlas-app-toolkit/gorm/resource")
+	identVersionConflictError         = newKnownIdent("VersionConflictError", "github.com/edhaight/protoc-gen-gorm/errors")
+	identFromDBFn                     = newKnownIdent("FromDB", "github.com/edhaight/protoc-gen-gorm/errors")
+	identNotFoundFn                   = newKnownIdent("NotFound", "github.com/edhaight/protoc-gen-gorm/errors")
+	identErrorsTranslator             = newKnownIdent("Translator", "github.com/edhaight/protoc-gen-gorm/errors")
+	identStatusTranslator             = newKnownIdent("StatusTranslator", "github.com/edhaight/protoc-gen-gorm/errors")
+	identInvalidArgumentFn            = newKnownIdent("InvalidArgument", "github.com/edhaight/protoc-gen-gorm/errors")
+	identMultiError                   = newKnownIdent("MultiError", "github.com/edhaight/protoc-gen-gorm/errors")
+	// keyset pagination idents
+	identKeysetKey             = newKnownIdent("Key", "github.com/edhaight/protoc-gen-gorm/keyset")
+	identKeysetEncodeFn        = newKnownIdent("Encode", "github.com/edhaight/protoc-gen-gorm/keyset")
+	identKeysetDecodeFn        = newKnownIdent("Decode", "github.com/edhaight/protoc-gen-gorm/keyset")
+	identKeysetWhereFn         = newKnownIdent("Where", "github.com/edhaight/protoc-gen-gorm/keyset")
+	identKeysetExpandedWhereFn = newKnownIdent("ExpandedWhere", "github.com/edhaight/protoc-gen-gorm/keyset")
+	identKeysetNullableFn      = newKnownIdent("Nullable", "github.com/edhaight/protoc-gen-gorm/keyset")
 	// trace idents
 	identTraceSpan              = newKnownIdent("Span", "go.opencensus.io/trace")
 	identTraceStartSpanFn       = newKnownIdent("StartSpan", "go.opencensus.io/trace")
@@ -58,11 +84,6 @@ var (
 	identTraceStringAttributeFn = newKnownIdent("StringAttribute", "go.opencensus.io/trace")
 	identTraceStatus            = newKnownIdent("Status", "go.opencensus.io/trace")
 	identTraceStatusCodeUnknown = newKnownIdent("StatusCodeUnknown", "go.opencensus.io/trace")
-	// gateway idents
-	identGatewaySetCreatedFn = newKnownIdent("SetCreated", "github.com/infobloxopen/atlas-app-toolkit/gateway")
-
-	// GetAccountID function ident
-	identGetAccountIDFn = newKnownIdent("GetAccountID", "github.com/infobloxopen/atlas-app-toolkit/auth")
 	// fieldMask ident
 	identFieldMask = newKnownIdent("FieldMask", "google.golang.org/genproto/protobuf/field_mask")
 	// uuid idents
@@ -72,9 +93,73 @@ var (
 )
 
 var specialImports = map[string]struct{}{
-	"github.com/edhaight/protoc-gen-gorm/types":              {},
-	"github.com/infobloxopen/atlas-app-toolkit/rpc/resource": {},
-	"github.com/golang/protobuf/ptypes/timestamp":            {},
+	"github.com/edhaight/protoc-gen-gorm/types":                 {},
+	"github.com/infobloxopen/atlas-app-toolkit/rpc/resource":    {},
+	"github.com/infobloxopen/atlas-app-toolkit/v2/rpc/resource": {},
+	"github.com/golang/protobuf/ptypes/timestamp":               {},
+}
+
+// targetIdents holds the gorm and atlas-app-toolkit idents, whose import
+// paths depend on the orm target of the run.
+type targetIdents struct {
+	// gorm idents
+	identGormDB                protogen.GoIdent
+	identGormErrRecordNotFound protogen.GoIdent
+	identGormExpr              protogen.GoIdent
+	// field selection idents
+	identQueryFieldSelection protogen.GoIdent
+	identQueryPagination     protogen.GoIdent
+	identQuerySorting        protogen.GoIdent
+	identQueryFiltering      protogen.GoIdent
+	identQueryPageInfo       protogen.GoIdent
+
+	identApplyFieldSelectionFn      protogen.GoIdent
+	identMergeWithMaskFn            protogen.GoIdent
+	identApplyCollectionOperatorsFn protogen.GoIdent
+	identTkFromContextFn            protogen.GoIdent
+	// Atlas resources idents
+	identResourceEncodeFn      protogen.GoIdent
+	identResourceDecodeFn      protogen.GoIdent
+	identResourceDecodeBytesFn protogen.GoIdent
+	identResourceDecodeInt64Fn protogen.GoIdent
+	// gateway idents
+	identGatewaySetCreatedFn protogen.GoIdent
+	// GetAccountID function ident
+	identGetAccountIDFn protogen.GoIdent
+}
+
+// newTargetIdents returns the idents of github.com/jinzhu/gorm and of
+// atlas-app-toolkit, or of their gorm.io/gorm flavoured counterparts when
+// gormV2 is set.
+func newTargetIdents(gormV2 bool) targetIdents {
+	gorm, toolkit := "github.com/jinzhu/gorm", "github.com/infobloxopen/atlas-app-toolkit"
+	if gormV2 {
+		gorm, toolkit = "gorm.io/gorm", "github.com/infobloxopen/atlas-app-toolkit/v2"
+	}
+	return targetIdents{
+		identGormDB:                newKnownIdent("DB", gorm),
+		identGormErrRecordNotFound: newKnownIdent("ErrRecordNotFound", gorm),
+		identGormExpr:              newKnownIdent("Expr", gorm),
+
+		identQueryFieldSelection: newKnownIdent("FieldSelection", toolkit+"/query"),
+		identQueryPagination:     newKnownIdent("Pagination", toolkit+"/query"),
+		identQuerySorting:        newKnownIdent("Sorting", toolkit+"/query"),
+		identQueryFiltering:      newKnownIdent("Filtering", toolkit+"/query"),
+		identQueryPageInfo:       newKnownIdent("PageInfo", toolkit+"/query"),
+
+		identApplyFieldSelectionFn:      newKnownIdent("ApplyFieldSelection", toolkit+"/gorm"),
+		identMergeWithMaskFn:            newKnownIdent("MergeWithMask", toolkit+"/gorm"),
+		identApplyCollectionOperatorsFn: newKnownIdent("ApplyCollectionOperators", toolkit+"/gorm"),
+		identTkFromContextFn:            newKnownIdent("FromContext", toolkit+"/gorm"),
+
+		identResourceEncodeFn:      newKnownIdent("Encode", toolkit+"/gorm/resource"),
+		identResourceDecodeFn:      newKnownIdent("Decode", toolkit+"/gorm/resource"),
+		identResourceDecodeBytesFn: newKnownIdent("DecodeBytes", toolkit+"/gorm/resource"),
+		identResourceDecodeInt64Fn: newKnownIdent("DecodeInt64", toolkit+"/gorm/resource"),
+
+		identGatewaySetCreatedFn: newKnownIdent("SetCreated", toolkit+"/gateway"),
+		identGetAccountIDFn:      newKnownIdent("GetAccountID", toolkit+"/auth"),
+	}
 }
 
 func newKnownIdent(goName, goImportPath string) protogen.GoIdent {
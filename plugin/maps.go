@@ -0,0 +1,236 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	jgorm "github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	gorm "github.com/edhaight/protoc-gen-gorm/options"
+)
+
+// mapTable describes the key/value type generated for a map field stored in
+// a child table.
+type mapTable struct {
+	table string
+}
+
+// mapTableTypeName is the name the key/value type of a map field is
+// registered under, e.g. UserLabels for the labels field of User.
+func mapTableTypeName(parent *OrmableType, field *protogen.Field) string {
+	return parent.OriginName + field.GoName
+}
+
+// parseMapTable registers the key/value type of a map field stored in a
+// child table. The has-many association to it is set up by
+// parseMapTableAssociation once all the basic fields are known.
+func (p *OrmPlugin) parseMapTable(parent *OrmableType, field *protogen.Field, opts *gorm.GormFieldOptions) {
+	typeName := mapTableTypeName(parent, field)
+	if _, ok := p.messages[typeName]; ok || p.isOrmable(typeName) {
+		p.Fail("Cannot generate child table for map field", field.GoName, "of", parent.Name, "as type", typeName, "already exists.")
+	}
+	child := NewOrmableType(typeName, field.Message, parent.File)
+	child.Name = fmt.Sprintf("%sORM", typeName)
+	child.Package = parent.Package
+
+	table := opts.GetMap().GetTable()
+	if table == "" {
+		table = jgorm.ToDBName(typeName)
+	}
+	child.mapTable = &mapTable{table: table}
+
+	keyTag := opts.GetMap().GetKeyTag()
+	if keyTag == nil {
+		keyTag = &gorm.GormTag{}
+	}
+	keyTag.PrimaryKey = proto.Bool(true)
+	p.disableAutoIncrement(keyTag, protoPrimitiveKinds[field.Message.Fields[0].Desc.Kind()])
+	child.Fields["Key"] = p.mapEntryField(parent, field, field.Message.Fields[0], keyTag)
+	child.Fields["Value"] = p.mapEntryField(parent, field, field.Message.Fields[1], opts.GetMap().GetValueTag())
+	p.ormableTypes[typeName] = child
+}
+
+func (p *OrmPlugin) mapEntryField(parent *OrmableType, mapField *protogen.Field, entryField *protogen.Field, tag *gorm.GormTag) *Field {
+	var fieldType string
+	switch {
+	case entryField.Desc.Enum() != nil:
+		fieldType = "int32"
+		if p.StringEnums() {
+			fieldType = "string"
+		}
+	case entryField.Desc.Message() != nil:
+		p.Fail("Cannot generate child table for map field", mapField.GoName, "of", parent.Name,
+			"as message values are not supported, store it as jsonb instead.")
+	default:
+		fieldType = protoPrimitiveKinds[entryField.Desc.Kind()]
+	}
+	f := &protogen.Field{GoIdent: protogen.GoIdent{GoName: fieldType}}
+	return &Field{F: f, Type: fieldType, GormFieldOptions: &gorm.GormFieldOptions{Tag: tag}}
+}
+
+// parseMapTableAssociation links a map field stored in a child table to its
+// key/value type with a has-many association. The foreign key is part of the
+// child's primary key, next to the map key.
+func (p *OrmPlugin) parseMapTableAssociation(msg *protogen.Message, parent *OrmableType, field *protogen.Field, opts *gorm.GormFieldOptions) {
+	typeName := mapTableTypeName(parent, field)
+	child := p.getOrmable(typeName)
+	hasMany := opts.GetHasMany()
+	if hasMany == nil {
+		hasMany = &gorm.HasManyOptions{}
+		opts.Association = &gorm.GormFieldOptions_HasMany{HasMany: hasMany}
+	}
+	if hasMany.GetForeignkey() == "" {
+		assocKeyName, _ := p.getAssocKeyName(hasMany, parent)
+		hasMany.Foreignkey = proto.String(parent.OriginName + assocKeyName)
+	}
+	if hasMany.ForeignkeyTag == nil {
+		hasMany.ForeignkeyTag = &gorm.GormTag{}
+	}
+	hasMany.ForeignkeyTag.PrimaryKey = proto.Bool(true)
+	hasMany.ForeignkeyTag.NotNull = proto.Bool(true)
+	p.parseHasMany(msg, parent, fieldName(field), typeName, field, child, opts)
+	p.disableAutoIncrement(hasMany.ForeignkeyTag, strings.TrimPrefix(child.Fields[hasMany.GetForeignkey()].Type, "*"))
+
+	fieldType := fmt.Sprintf("[]*%s", child.Name)
+	copiedF := *field
+	copiedF.GoIdent = protogen.GoIdent{GoName: fieldType, GoImportPath: parent.File.GoImportPath}
+	parent.Fields[fieldName(field)] = &Field{Type: fieldType, GormFieldOptions: opts, F: &copiedF}
+}
+
+// disableAutoIncrement keeps jinzhu/gorm from turning an integer column of a
+// composite primary key into an auto increment one, by giving it an explicit
+// sql type.
+func (p *OrmPlugin) disableAutoIncrement(tag *gorm.GormTag, goType string) {
+	if p.isGormV2() || tag.Type != nil {
+		return
+	}
+	switch goType {
+	case "int32", "uint32":
+		tag.Type = proto.String("integer")
+	case "int64", "uint64":
+		tag.Type = proto.String("bigint")
+	}
+}
+
+// generateMapTables outputs the key/value types of the map fields of message
+// that are stored in child tables.
+func (p *OrmPlugin) generateMapTables(message *protogen.Message) {
+	ormable := p.getOrmableMessage(message)
+	for _, field := range message.Fields {
+		if !field.Desc.IsMap() || getFieldOptions(field).GetDrop() || !getFieldOptions(field).GetMap().GetChildTable() {
+			continue
+		}
+		child := p.getOrmable(mapTableTypeName(ormable, field))
+		p.P(`// `, child.Name, ` holds an entry of the `, field.GoName, ` map of `, ormable.OriginName)
+		p.generateOrmableStruct(child)
+		p.P()
+		p.P(`// TableName overrides the default tablename generated by GORM`)
+		p.P(`func (`, child.Name, `) TableName() string {`)
+		p.P(`return "`, child.mapTable.table, `"`)
+		p.P(`}`)
+		p.P()
+	}
+}
+
+// generateMapFieldConversion outputs the conversion of a map field to and
+// from its jsonb column or child table entries.
+func (p *OrmPlugin) generateMapFieldConversion(field *protogen.Field, toORM bool, ofield *Field) {
+	fieldName := fieldName(field)
+	keyField, valueField := field.Message.Fields[0], field.Message.Fields[1]
+
+	if getFieldOptions(field).GetMap().GetChildTable() {
+		child := p.getOrmable(ofield.Type)
+		if toORM {
+			value := `v`
+			if valueField.Desc.Enum() != nil {
+				value = `int32(v)`
+				if p.StringEnums() {
+					value = fmt.Sprint(p.qualifiedGoIdent(valueField.Enum.GoIdent), `_name[int32(v)]`)
+				}
+			}
+			p.P(`for k, v := range m.`, fieldName, ` {`)
+			p.P(`to.`, fieldName, ` = append(to.`, fieldName, `, &`, child.Name, `{Key: k, Value: `, value, `})`)
+			p.P(`}`)
+		} else {
+			value := `v.Value`
+			if valueField.Desc.Enum() != nil {
+				ident := p.qualifiedGoIdent(valueField.Enum.GoIdent)
+				value = fmt.Sprint(ident, `(v.Value)`)
+				if p.StringEnums() {
+					value = fmt.Sprint(ident, `(`, ident, `_value[v.Value])`)
+				}
+			}
+			p.P(`if m.`, fieldName, ` != nil {`)
+			p.P(`to.`, fieldName, ` = make(`, p.mapGoType(field), `, len(m.`, fieldName, `))`)
+			p.P(`for _, v := range m.`, fieldName, ` {`)
+			p.P(`if v != nil {`)
+			p.P(`to.`, fieldName, `[v.Key] = `, value)
+			p.P(`}`)
+			p.P(`}`)
+			p.P(`}`)
+		}
+		return
+	}
+
+	// message values are marshalled one by one with protojson
+	isMessage := valueField.Desc.Message() != nil
+	keyType := protoPrimitiveKinds[keyField.Desc.Kind()]
+	if toORM {
+		p.P(`if m.`, fieldName, ` != nil {`)
+		if isMessage {
+			p.P(`raw := make(map[`, keyType, `]`, identJsonRawMessage, `, len(m.`, fieldName, `))`)
+			p.P(`for k, v := range m.`, fieldName, ` {`)
+			p.P(`if raw[k], err = `, identProtojsonMarshal, `(v); err != nil {`)
+			p.P(`return to, err`)
+			p.P(`}`)
+			p.P(`}`)
+			p.P(`data, err := `, identJsonMarshal, `(raw)`)
+		} else {
+			p.P(`data, err := `, identJsonMarshal, `(m.`, fieldName, `)`)
+		}
+		p.P(`if err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.generateJsonbAssignment("to." + fieldName)
+		p.P(`}`)
+	} else {
+		data := p.jsonbData("m." + fieldName)
+		p.P(`if m.`, fieldName, ` != nil {`)
+		if isMessage {
+			ident := p.qualifiedGoIdent(valueField.Message.GoIdent)
+			p.P(`raw := map[`, keyType, `]`, identJsonRawMessage, `{}`)
+			p.P(`if err = `, identJsonUnmarshal, `(`, data, `, &raw); err != nil {`)
+			p.P(`return to, err`)
+			p.P(`}`)
+			p.P(`to.`, fieldName, ` = make(`, p.mapGoType(field), `, len(raw))`)
+			p.P(`for k, v := range raw {`)
+			p.P(`to.`, fieldName, `[k] = &`, ident, `{}`)
+			p.P(`if err = `, identProtojsonUnmarshal, `(v, to.`, fieldName, `[k]); err != nil {`)
+			p.P(`return to, err`)
+			p.P(`}`)
+			p.P(`}`)
+		} else {
+			p.P(`if err = `, identJsonUnmarshal, `(`, data, `, &to.`, fieldName, `); err != nil {`)
+			p.P(`return to, err`)
+			p.P(`}`)
+		}
+		p.P(`}`)
+	}
+}
+
+// mapGoType returns the Go type of a map field in the protobuf message.
+func (p *OrmPlugin) mapGoType(field *protogen.Field) string {
+	keyField, valueField := field.Message.Fields[0], field.Message.Fields[1]
+	var valueType string
+	switch {
+	case valueField.Enum != nil:
+		valueType = p.qualifiedGoIdent(valueField.Enum.GoIdent)
+	case valueField.Message != nil:
+		valueType = "*" + p.qualifiedGoIdent(valueField.Message.GoIdent)
+	default:
+		valueType = protoPrimitiveKinds[valueField.Desc.Kind()]
+	}
+	return fmt.Sprintf("map[%s]%s", protoPrimitiveKinds[keyField.Desc.Kind()], valueType)
+}
@@ -0,0 +1,307 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// Phases of a migration, the statements of a phase may depend on the ones of
+// the previous phases, e.g. a foreign key is added once the table it
+// references exists.
+const (
+	phaseRenameTables = iota
+	phaseDropForeignKeys
+	phaseDropIndexes
+	phaseCreateTables
+	phaseAlterColumns
+	phaseCreateIndexes
+	phaseDropTables
+	phaseAddForeignKeys
+	migrationPhases
+)
+
+// migration collects the statements migrating a schema to another one.
+type migration struct {
+	dialect *sqlDialect
+	phases  [migrationPhases][]string
+}
+
+func (m *migration) add(phase int, statements ...string) {
+	m.phases[phase] = append(m.phases[phase], statements...)
+}
+
+// addDestructive adds a statement which loses data, flagged with a comment.
+func (m *migration) addDestructive(phase int, reason string, statement string) {
+	m.add(phase, "-- DESTRUCTIVE: "+reason+"\n"+statement)
+}
+
+// addWarning adds a statement which may fail on existing rows, or a change
+// which cannot be migrated when statement is empty, flagged with a comment.
+func (m *migration) addWarning(phase int, reason string, statement string) {
+	m.add(phase, strings.TrimSuffix("-- WARNING: "+reason+"\n"+statement, "\n"))
+}
+
+func (m *migration) statements() []string {
+	var statements []string
+	for _, phase := range m.phases {
+		statements = append(statements, phase...)
+	}
+	return statements
+}
+
+// diffSchemas returns the statements migrating the from tables to the to
+// tables. Tables are matched by the message they are stored from, so that a
+// table option change renames the table, or else by name.
+func diffSchemas(dialect *sqlDialect, from, to []*sqlTable) []string {
+	m := &migration{dialect: dialect}
+	matched := make(map[*sqlTable]bool)
+	for _, table := range to {
+		prev := findTable(from, table, matched)
+		if prev == nil {
+			m.createTable(table)
+			continue
+		}
+		matched[prev] = true
+		m.diffTable(prev, table)
+	}
+	for _, table := range from {
+		if !matched[table] {
+			m.addDestructive(phaseDropTables, "drops table "+table.name+" and its rows", dialect.dropTable(table.name))
+		}
+	}
+	return m.statements()
+}
+
+func findTable(tables []*sqlTable, table *sqlTable, matched map[*sqlTable]bool) *sqlTable {
+	for _, t := range tables {
+		if !matched[t] && table.source != "" && t.source == table.source {
+			return t
+		}
+	}
+	for _, t := range tables {
+		if !matched[t] && t.name == table.name {
+			return t
+		}
+	}
+	return nil
+}
+
+func (m *migration) createTable(table *sqlTable) {
+	m.add(phaseCreateTables, m.dialect.createTable(table)...)
+	m.add(phaseAddForeignKeys, m.dialect.addForeignKeys(table)...)
+}
+
+func (m *migration) diffTable(from, to *sqlTable) {
+	d := m.dialect
+	if from.name != to.name {
+		m.add(phaseRenameTables, d.renameTable(from.name, to.name))
+	}
+	if strings.Join(from.primaryKey, ",") != strings.Join(to.primaryKey, ",") {
+		m.addWarning(phaseAlterColumns, fmt.Sprintf("the primary key of %s changed from (%s) to (%s), it is not migrated",
+			to.name, strings.Join(from.primaryKey, ", "), strings.Join(to.primaryKey, ", ")), "")
+	}
+	for _, column := range to.columns {
+		prev := findColumn(from.columns, column.name)
+		if prev == nil {
+			if column.notNull && column.defaultValue == "" && !column.autoIncrement {
+				m.addWarning(phaseAlterColumns, "adds column "+column.name+" as not null with no default, it fails if "+to.name+" holds rows",
+					d.addColumn(to.name, column))
+			} else {
+				m.add(phaseAlterColumns, d.addColumn(to.name, column))
+			}
+			continue
+		}
+		m.diffColumn(to.name, prev, column)
+	}
+	for _, column := range from.columns {
+		if findColumn(to.columns, column.name) == nil {
+			m.addDestructive(phaseAlterColumns, "drops column "+column.name+" of "+to.name+" and its values", d.dropColumn(to.name, column.name))
+		}
+	}
+	for _, index := range to.indexes {
+		prev := findIndex(from.indexes, index.name)
+		if prev != nil && prev.unique == index.unique && strings.Join(prev.columns, ",") == strings.Join(index.columns, ",") {
+			continue
+		}
+		if prev != nil {
+			m.add(phaseDropIndexes, d.dropIndex(to.name, prev))
+		}
+		if index.unique {
+			m.addWarning(phaseCreateIndexes, "adds unique index "+index.name+", it fails if "+to.name+" holds duplicates",
+				d.createIndex(to, index))
+		} else {
+			m.add(phaseCreateIndexes, d.createIndex(to, index))
+		}
+	}
+	for _, index := range from.indexes {
+		if findIndex(to.indexes, index.name) == nil {
+			m.add(phaseDropIndexes, d.dropIndex(to.name, index))
+		}
+	}
+	for _, fk := range to.foreignKeys {
+		prev := findForeignKey(from.foreignKeys, fk.name)
+		if prev != nil && sameForeignKey(prev, fk) {
+			continue
+		}
+		if d.inlineForeignKeys {
+			m.addWarning(phaseAddForeignKeys, fmt.Sprintf("%s cannot add foreign key %s to an existing table, rebuild %s to add it",
+				d.engine, fk.name, to.name), "")
+			continue
+		}
+		if prev != nil {
+			m.add(phaseDropForeignKeys, d.dropForeignKey(to.name, prev))
+		}
+		m.addWarning(phaseAddForeignKeys, "adds foreign key "+fk.name+", it fails if "+to.name+" holds dangling references",
+			d.addForeignKey(to.name, fk))
+	}
+	for _, fk := range from.foreignKeys {
+		if findForeignKey(to.foreignKeys, fk.name) != nil {
+			continue
+		}
+		if d.inlineForeignKeys {
+			m.addWarning(phaseDropForeignKeys, fmt.Sprintf("%s cannot drop foreign key %s from an existing table, rebuild %s to drop it",
+				d.engine, fk.name, to.name), "")
+			continue
+		}
+		m.add(phaseDropForeignKeys, d.dropForeignKey(to.name, fk))
+	}
+}
+
+func (m *migration) diffColumn(table string, from, to *sqlColumn) {
+	d := m.dialect
+	if from.unique != to.unique || from.autoIncrement != to.autoIncrement {
+		m.addWarning(phaseAlterColumns, "the unique or auto increment setting of column "+to.name+" of "+table+
+			" changed, it is not migrated", "")
+	}
+	if from.typ == to.typ && from.notNull == to.notNull && from.defaultValue == to.defaultValue {
+		return
+	}
+	statements, ok := d.alterColumn(table, from, to)
+	switch {
+	case !ok:
+		m.addWarning(phaseAlterColumns, fmt.Sprintf("%s cannot alter column %s of an existing table, rebuild %s to alter it",
+			d.engine, to.name, table), "")
+	case from.typ != to.typ:
+		m.addDestructive(phaseAlterColumns, fmt.Sprintf("changes the type of column %s of %s from %s to %s, values may be lost",
+			to.name, table, from.typ, to.typ), strings.Join(statements, "\n"))
+	case to.notNull && !from.notNull:
+		m.addWarning(phaseAlterColumns, "makes column "+to.name+" not null, it fails if "+table+" holds NULL values",
+			strings.Join(statements, "\n"))
+	default:
+		m.add(phaseAlterColumns, statements...)
+	}
+}
+
+func findColumn(columns []*sqlColumn, name string) *sqlColumn {
+	for _, column := range columns {
+		if column.name == name {
+			return column
+		}
+	}
+	return nil
+}
+
+func findIndex(indexes []*sqlIndex, name string) *sqlIndex {
+	for _, index := range indexes {
+		if index.name == name {
+			return index
+		}
+	}
+	return nil
+}
+
+func findForeignKey(fks []*sqlForeignKey, name string) *sqlForeignKey {
+	for _, fk := range fks {
+		if fk.name == name {
+			return fk
+		}
+	}
+	return nil
+}
+
+func sameForeignKey(a, b *sqlForeignKey) bool {
+	return a.refTable == b.refTable && strings.Join(a.columns, ",") == strings.Join(b.columns, ",") &&
+		strings.Join(a.refColumns, ",") == strings.Join(b.refColumns, ",")
+}
+
+// loadPrevious parses the previous version of the proto files held in the
+// FileDescriptorSet at the Previous path. It returns the plugin holding the
+// previous ormable types, along with the previous files by path.
+func (p *OrmPlugin) loadPrevious() (*OrmPlugin, map[string]*protogen.File) {
+	data, err := os.ReadFile(p.Previous)
+	if err != nil {
+		p.Fail("Cannot read the previous descriptor set:", err.Error())
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, set); err != nil {
+		p.Fail("Cannot parse the previous descriptor set", p.Previous+":", err.Error())
+	}
+	req := &pluginpb.CodeGeneratorRequest{ProtoFile: set.GetFile()}
+	for _, fd := range set.GetFile() {
+		if file, ok := p.FilesByPath[fd.GetName()]; ok && file.Generate {
+			req.FileToGenerate = append(req.FileToGenerate, fd.GetName())
+		}
+	}
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		p.Fail("Cannot load the previous descriptor set", p.Previous+", it must be built with --include_imports:", err.Error())
+	}
+	config := *p.Config
+	config.Quiet, config.DDL, config.Previous = true, false, ""
+	prev := &OrmPlugin{Config: &config}
+	prev.Init(gen)
+	for _, file := range gen.Files {
+		// parsing qualifies some of the ORM types, the previous version is
+		// never written out
+		prev.setFile(gen.NewGeneratedFile(file.GeneratedFilenamePrefix+p.Suffix, file.GoImportPath))
+		prev.currentPackage = file.GoImportPath
+		prev.parseFile(file)
+	}
+	return prev, gen.FilesByPath
+}
+
+// generateMigrations outputs the up and down migrations between the schema of
+// the previous version of file, if any, and its current one in .up.sql and
+// .down.sql files next to the generated code. The tables of the proto files
+// removed since the previous version are not dropped.
+func (p *OrmPlugin) generateMigrations(file *protogen.File, prev *OrmPlugin, prevFile *protogen.File) {
+	dialect := sqlDialects[p.Engine]
+	tables := p.buildSchema(file, dialect)
+	var prevTables []*sqlTable
+	if prevFile != nil {
+		prevTables = prev.buildSchema(prevFile, dialect)
+	}
+	if len(tables) == 0 && len(prevTables) == 0 {
+		return
+	}
+	base := file.GeneratedFilenamePrefix + strings.TrimSuffix(p.DDLSuffix(), ".sql")
+	for _, direction := range []struct {
+		suffix   string
+		comment  string
+		from, to []*sqlTable
+	}{
+		{".up.sql", "migrates the schema of the previous version up to the current one", prevTables, tables},
+		{".down.sql", "migrates the schema of the current version down to the previous one", tables, prevTables},
+	} {
+		g := p.NewGeneratedFile(base+direction.suffix, file.GoImportPath)
+		g.P("-- Code generated by protoc-gen-gorm. DO NOT EDIT.")
+		g.P("-- source: ", file.Desc.Path())
+		g.P("-- engine: ", dialect.engine)
+		g.P("-- ", direction.comment)
+		statements := diffSchemas(dialect, direction.from, direction.to)
+		if len(statements) == 0 {
+			g.P()
+			g.P("-- no schema changes")
+		}
+		for _, statement := range statements {
+			g.P()
+			g.P(statement)
+		}
+	}
+}
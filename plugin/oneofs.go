@@ -0,0 +1,217 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	gorm "github.com/edhaight/protoc-gen-gorm/options"
+)
+
+// isOneofMember reports whether field is a member of a oneof, proto3 optional
+// fields are not.
+func isOneofMember(field *protogen.Field) bool {
+	return field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
+}
+
+// isDiscriminated reports whether a oneof is stored in a case and a value
+// column instead of a column per member.
+func isDiscriminated(oneof *protogen.Oneof) bool {
+	return getOneofOptions(oneof).GetDiscriminator()
+}
+
+// oneofWrapperIdent returns the wrapper type protoc-gen-go generates for a
+// oneof member. It is recorded by parseBasicFields before the field ident is
+// replaced with the ORM type.
+func (p *OrmPlugin) oneofWrapperIdent(field *protogen.Field) string {
+	ident, ok := p.oneofWrappers[field]
+	if !ok {
+		p.Fail("Unknown oneof member", field.GoName, "of", field.Parent.GoIdent.GoName)
+	}
+	return p.qualifiedGoIdent(ident)
+}
+
+// nullableOneofMember makes the ORM type of a oneof member nullable, so that
+// the member that is set can be told apart from its zero value.
+func (p *OrmPlugin) nullableOneofMember(field *protogen.Field) {
+	goType := field.GoIdent.GoName
+	if strings.HasPrefix(goType, "*") || goType == "[]byte" {
+		return
+	}
+	if field.Desc.Message() != nil {
+		p.Fail("Oneof member", field.GoName, "of", field.Parent.GoIdent.GoName, "has non nullable ORM type", goType,
+			"set (gorm.oneof).discriminator to store the oneof in a jsonb column instead.")
+	}
+	field.GoIdent.GoName = "*" + goType
+}
+
+// parseDiscriminatedOneofs adds the case and value columns of the oneofs of
+// msg that are stored with a discriminator.
+func (p *OrmPlugin) parseDiscriminatedOneofs(msg *protogen.Message, ormable *OrmableType) {
+	for _, oneof := range msg.Oneofs {
+		if oneof.Desc.IsSynthetic() || !isDiscriminated(oneof) {
+			continue
+		}
+		opts := getOneofOptions(oneof)
+		caseName, valueName := oneof.GoName+"Case", oneof.GoName+"Value"
+		for _, name := range []string{caseName, valueName} {
+			if _, ok := ormable.Fields[name]; ok {
+				p.Fail("Cannot include", name, "field into", ormable.Name, "as it already exists there.")
+			}
+		}
+		ormable.Fields[caseName] = &Field{
+			Type:             "string",
+			F:                &protogen.Field{GoIdent: protogen.GoIdent{GoName: "string"}},
+			GormFieldOptions: &gorm.GormFieldOptions{Tag: opts.GetCaseTag()},
+		}
+		valueIdent := ptrIdent(p.jsonbIdent())
+		ormable.Fields[valueName] = &Field{
+			Type:             valueIdent.GoName,
+			F:                &protogen.Field{GoIdent: valueIdent},
+			GormFieldOptions: &gorm.GormFieldOptions{Tag: tagWithType(opts.GetValueTag(), "jsonb")},
+		}
+	}
+}
+
+// generateOneofMemberConversion outputs the conversion of a oneof member to
+// and from its nullable column.
+func (p *OrmPlugin) generateOneofMemberConversion(message *protogen.Message, field *protogen.Field, toORM bool, ofield *Field) {
+	if isDiscriminated(field.Oneof) || ofield == nil {
+		return
+	}
+	fieldName := fieldName(field)
+	oneofName := field.Oneof.GoName
+	wrapper := p.oneofWrapperIdent(field)
+	desc := field.Desc
+
+	if desc.Message() != nil {
+		if toORM {
+			p.generateValueConversion(message, field, toORM, ofield, fmt.Sprintf("m.Get%s()", fieldName), "to."+fieldName)
+			return
+		}
+		p.P(`if m.`, fieldName, ` != nil {`)
+		p.P(`var oneof`, fieldName, ` *`, p.qualifiedGoIdent(field.Message.GoIdent))
+		p.generateValueConversion(message, field, toORM, ofield, "m."+fieldName, "oneof"+fieldName)
+		p.P(`to.`, oneofName, ` = &`, wrapper, `{`, fieldName, `: oneof`, fieldName, `}`)
+		p.P(`}`)
+		return
+	}
+
+	isBytes := desc.Kind() == protoreflect.BytesKind
+	if toORM {
+		value := `x.` + fieldName
+		if desc.Enum() != nil {
+			value = `int32(x.` + fieldName + `)`
+			if p.StringEnums() {
+				value = fmt.Sprint(p.qualifiedGoIdent(field.Enum.GoIdent), `_name[int32(x.`, fieldName, `)]`)
+			}
+		}
+		p.P(`if x, ok := m.`, oneofName, `.(*`, wrapper, `); ok {`)
+		if isBytes {
+			p.P(`to.`, fieldName, ` = `, value)
+		} else {
+			p.P(`v := `, value)
+			p.P(`to.`, fieldName, ` = &v`)
+		}
+		p.P(`}`)
+	} else {
+		value := `*m.` + fieldName
+		if isBytes {
+			value = `m.` + fieldName
+		} else if desc.Enum() != nil {
+			ident := p.qualifiedGoIdent(field.Enum.GoIdent)
+			value = fmt.Sprint(ident, `(*m.`, fieldName, `)`)
+			if p.StringEnums() {
+				value = fmt.Sprint(ident, `(`, ident, `_value[*m.`, fieldName, `])`)
+			}
+		}
+		p.P(`if m.`, fieldName, ` != nil {`)
+		p.P(`to.`, oneofName, ` = &`, wrapper, `{`, fieldName, `: `, value, `}`)
+		p.P(`}`)
+	}
+}
+
+// generateDiscriminatedOneofConversions outputs the conversion of the oneofs
+// of message that are stored with a discriminator to and from their case and
+// value columns. Members are identified by their proto name in the case column.
+func (p *OrmPlugin) generateDiscriminatedOneofConversions(message *protogen.Message, toORM bool) {
+	for _, oneof := range message.Oneofs {
+		if oneof.Desc.IsSynthetic() || !isDiscriminated(oneof) {
+			continue
+		}
+		caseName, valueName := oneof.GoName+"Case", oneof.GoName+"Value"
+		if toORM {
+			p.P(`if m.`, oneof.GoName, ` != nil {`)
+			p.P(`var data []byte`)
+			p.P(`switch x := m.`, oneof.GoName, `.(type) {`)
+			for _, field := range oneof.Fields {
+				fieldName := fieldName(field)
+				p.P(`case *`, p.oneofWrapperIdent(field), `:`)
+				p.P(`to.`, caseName, ` = "`, field.Desc.Name(), `"`)
+				switch {
+				case field.Desc.Message() != nil:
+					p.P(`data, err = `, identProtojsonMarshal, `(x.`, fieldName, `)`)
+				case field.Desc.Enum() != nil && p.StringEnums():
+					p.P(`data, err = `, identJsonMarshal, `(x.`, fieldName, `.String())`)
+				default:
+					p.P(`data, err = `, identJsonMarshal, `(x.`, fieldName, `)`)
+				}
+			}
+			p.P(`}`)
+			p.P(`if err != nil {`)
+			p.P(`return to, err`)
+			p.P(`}`)
+			p.generateJsonbAssignment("to." + valueName)
+			p.P(`}`)
+			continue
+		}
+
+		p.P(`if m.`, valueName, ` != nil {`)
+		p.P(`data := `, p.jsonbData("m."+valueName))
+		p.P(`switch m.`, caseName, ` {`)
+		for _, field := range oneof.Fields {
+			fieldName := fieldName(field)
+			wrapper := p.oneofWrapperIdent(field)
+			p.P(`case "`, field.Desc.Name(), `":`)
+			switch {
+			case field.Desc.Message() != nil:
+				p.P(`x := &`, wrapper, `{`, fieldName, `: &`, p.qualifiedGoIdent(field.Message.GoIdent), `{}}`)
+				p.P(`err = `, identProtojsonUnmarshal, `(data, x.`, fieldName, `)`)
+				p.P(`to.`, oneof.GoName, ` = x`)
+			case field.Desc.Enum() != nil && p.StringEnums():
+				ident := p.qualifiedGoIdent(field.Enum.GoIdent)
+				p.P(`var name string`)
+				p.P(`err = `, identJsonUnmarshal, `(data, &name)`)
+				p.P(`to.`, oneof.GoName, ` = &`, wrapper, `{`, fieldName, `: `, ident, `(`, ident, `_value[name])}`)
+			default:
+				p.P(`x := &`, wrapper, `{}`)
+				p.P(`err = `, identJsonUnmarshal, `(data, &x.`, fieldName, `)`)
+				p.P(`to.`, oneof.GoName, ` = x`)
+			}
+		}
+		p.P(`}`)
+		p.P(`if err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`}`)
+	}
+}
+
+// generateOneofApplyFieldMask outputs the DefaultApplyFieldMask handling of a
+// oneof member, which replaces the oneof when the patcher has the member set
+// and clears it when the patchee has.
+func (p *OrmPlugin) generateOneofApplyFieldMask(field *protogen.Field) {
+	fieldName := fieldName(field)
+	oneofName := field.Oneof.GoName
+	wrapper := p.oneofWrapperIdent(field)
+	p.P(`if f == prefix+"`, fieldName, `" {`)
+	p.P(`if _, ok := patcher.`, oneofName, `.(*`, wrapper, `); ok {`)
+	p.P(`patchee.`, oneofName, ` = patcher.`, oneofName)
+	p.P(`} else if _, ok := patchee.`, oneofName, `.(*`, wrapper, `); ok {`)
+	p.P(`patchee.`, oneofName, ` = nil`)
+	p.P(`}`)
+	p.P(`continue`)
+	p.P(`}`)
+}
@@ -7,8 +7,6 @@ import (
 	"strings"
 
 	"github.com/golang/protobuf/protoc-gen-go/generator"
-	jgorm "github.com/jinzhu/gorm"
-	"github.com/jinzhu/inflection"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -31,6 +29,14 @@ const (
 	protoTimeOnly      = "TimeOnly"
 )
 
+// ORM libraries the generated code can target.
+const (
+	// TargetJinzhu generates code for github.com/jinzhu/gorm.
+	TargetJinzhu = "jinzhu"
+	// TargetGormV2 generates code for gorm.io/gorm.
+	TargetGormV2 = "gormv2"
+)
+
 var wellKnownTypes = map[string]string{
 	"StringValue": "*string",
 	"DoubleValue": "*float64",
@@ -40,7 +46,6 @@ var wellKnownTypes = map[string]string{
 	"UInt32Value": "*uint32",
 	"UInt64Value": "*uint64",
 	"BoolValue":   "*bool",
-	//  "BytesValue" : "*[]byte",
 }
 
 var protoPrimitiveKinds = map[protoreflect.Kind]string{
@@ -86,6 +91,13 @@ type OrmableType struct {
 	Fields     map[string]*Field
 	debug      map[string]bool
 	Methods    map[string]*autogenMethod
+	// mapTable is set for the key/value types of map fields stored in child tables
+	mapTable *mapTable
+	// version is the name of the field holding the version of the rows, if any
+	version string
+	// historyOf is set for the types of the history rows of the types with the
+	// history option
+	historyOf *OrmableType
 }
 
 type Field struct {
@@ -111,16 +123,25 @@ func NewOrmableType(oname string, msg *protogen.Message, file *protogen.File) *O
 // OrmPlugin implements the plugin interface and creates GORM code from .protos
 type OrmPlugin struct {
 	*protogen.Plugin
-	SuppressWarnings bool
-	StringEnums      bool
-	Gateway          bool
-	ormableTypes     OrmableLookup
-	EmptyFiles       []string
-	currentPackage   protogen.GoImportPath
-	currentFile      *protogen.GeneratedFile
-	fileName         string
-	messages         map[string]struct{}
-	ormableServices  []autogenService
+	*Config
+	ormableTypes    OrmableLookup
+	EmptyFiles      []string
+	currentPackage  protogen.GoImportPath
+	currentFile     *protogen.GeneratedFile
+	fileName        string
+	messages        map[string]struct{}
+	ormableServices []autogenService
+	oneofWrappers   map[*protogen.Field]protogen.GoIdent
+	typeMappings    map[string]*gorm.TypeMapping
+	// localQuery is set when the services take the collection operators of
+	// the query runtime package
+	localQuery bool
+	targetIdents
+}
+
+// isGormV2 reports whether the generated code targets gorm.io/gorm.
+func (p *OrmPlugin) isGormV2() bool {
+	return p.Target == TargetGormV2
 }
 
 func (p *OrmPlugin) Fail(args ...string) {
@@ -146,17 +167,19 @@ func (p *OrmPlugin) Init(g *protogen.Plugin) {
 	p.Plugin = g
 	p.messages = make(map[string]struct{})
 	p.ormableTypes = make(map[string]*OrmableType)
+	p.oneofWrappers = make(map[*protogen.Field]protogen.GoIdent)
 
-	// params := g.Request.GetParameter()
-	// if strings.EqualFold(g.Param["enums"], "string") {
-	p.StringEnums = true
-	// }
-	// if _, ok := g.Param["gateway"]; ok {
-	// 	p.Gateway = true
-	// }
-	// if _, ok := g.Param["quiet"]; ok {
-	// 	p.SuppressWarnings = true
-	// }
+	if p.Config == nil {
+		p.Config = NewConfig()
+	}
+	if err := p.Config.Validate(); err != nil {
+		p.Fail(err.Error())
+	}
+	p.targetIdents = newTargetIdents(p.isGormV2())
+	if p.localQuery = p.usesLocalQuery(g.Files); p.localQuery {
+		p.targetIdents.useLocalQuery()
+	}
+	p.typeMappings = p.parseTypeMappings(g.Files)
 }
 
 // Generate produces the code generated by the plugin for this file,
@@ -168,7 +191,7 @@ func (p *OrmPlugin) Generate() {
 	for _, file := range p.Plugin.Files {
 		p.currentPackage = file.GoImportPath
 		if file.Generate {
-			outfile := p.NewGeneratedFile(file.GeneratedFilenamePrefix+".pb.gorm.go", p.currentPackage)
+			outfile := p.NewGeneratedFile(file.GeneratedFilenamePrefix+p.Suffix, p.currentPackage)
 			p.setFile(outfile)
 			p.fileName = file.GeneratedFilenamePrefix
 			generatedFileLookup[file] = outfile
@@ -176,36 +199,12 @@ func (p *OrmPlugin) Generate() {
 		} else {
 			skipped = append(skipped, file.GeneratedFilenamePrefix)
 		}
-		// Preload just the types we'll be creating
-		for _, msg := range file.Messages {
-			// We don't want to bother with the MapEntry stuff
-			if msg.Desc.IsMapEntry() {
-				continue
-			}
-
-			typeName := messageName(msg)
-			p.messages[typeName] = struct{}{}
-
-			if getMessageOptions(msg).GetOrmable() && !p.isOrmable(typeName) {
-				p.ormableTypes[typeName] = NewOrmableType(typeName, msg, file)
-			}
-		}
-		for _, msg := range file.Messages {
-			if p.isOrmableMessage(msg) {
-				p.parseBasicFields(msg)
-			}
-		}
-		for _, msg := range file.Messages {
-			if p.isOrmableMessage(msg) {
-				p.parseAssociations(msg)
-				o := p.getOrmableMessage(msg)
-				if p.hasPrimaryKey(o) {
-					_, fd := p.findPrimaryKey(o)
-					fd.ParentOriginName = o.OriginName
-				}
-			}
-		}
-		p.parseServices(file)
+		p.parseFile(file)
+	}
+	var prev *OrmPlugin
+	var prevFiles map[string]*protogen.File
+	if p.Previous != "" {
+		prev, prevFiles = p.loadPrevious()
 	}
 	for file, generated := range generatedFileLookup {
 		p.setFile(generated)
@@ -218,19 +217,77 @@ func (p *OrmPlugin) Generate() {
 			p.generateTableNameFunction(msg)
 			p.generateConvertFunctions(msg)
 			p.generateHookInterfaces(msg)
+			p.generateMapTables(msg)
+			p.generateHistoryType(msg)
 		}
 		p.generateDefaultHandlers(file)
 		p.generateDefaultServer(file)
+		if p.DDL {
+			p.generateDDL(file)
+		}
+		if prev != nil {
+			p.generateMigrations(file, prev, prevFiles[file.Desc.Path()])
+		}
 	}
 
 }
 
+// parseFile registers the ormable types of file, then parses their fields,
+// associations and services.
+func (p *OrmPlugin) parseFile(file *protogen.File) {
+	// Preload just the types we'll be creating
+	for _, msg := range file.Messages {
+		// We don't want to bother with the MapEntry stuff
+		if msg.Desc.IsMapEntry() {
+			continue
+		}
+
+		typeName := messageName(msg)
+		p.messages[typeName] = struct{}{}
+
+		if getMessageOptions(msg).GetOrmable() && !p.isOrmable(typeName) {
+			p.ormableTypes[typeName] = NewOrmableType(typeName, msg, file)
+		}
+	}
+	for _, msg := range file.Messages {
+		if p.isOrmableMessage(msg) {
+			p.parseBasicFields(msg)
+		}
+	}
+	for _, msg := range file.Messages {
+		if p.isOrmableMessage(msg) {
+			p.parseAssociations(msg)
+			o := p.getOrmableMessage(msg)
+			if p.hasCompositeKey(o) && p.Engine == EngineSQLServer {
+				// the set handlers select the rows with a tuple IN
+				p.Fail("Composite primary key of", o.OriginName, "is not supported by engine", p.Engine+".")
+			}
+			for _, pkName := range p.primaryKeyNames(o) {
+				fd := o.Fields[pkName]
+				fd.ParentOriginName = o.OriginName
+				if p.hasCompositeKey(o) {
+					p.disableAutoIncrement(fd.GetTag(), strings.TrimPrefix(fd.Type, "*"))
+				}
+			}
+			p.parseHistory(o)
+		}
+	}
+	p.parseServices(file)
+}
+
 func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 	typeName := messageName(msg)
 	ormable := p.getOrmable(typeName)
 	ormable.Name = fmt.Sprintf("%sORM", typeName)
 
 	for _, field := range msg.Fields {
+		if isOneofMember(field) {
+			p.oneofWrappers[field] = field.GoIdent
+			if isDiscriminated(field.Oneof) {
+				// stored in the case and value columns of the oneof
+				continue
+			}
+		}
 		fieldOpts := getFieldOptions(field)
 		if fieldOpts == nil {
 			fieldOpts = &gorm.GormFieldOptions{}
@@ -253,14 +310,37 @@ func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 			fieldType = p.qualifiedGoIdent(ident)
 			field.GoIdent = ident
 			fieldOpts.Tag = tagWithType(tag, tagString)
+		} else if desc.IsMap() {
+			if fieldOpts.GetMap().GetChildTable() {
+				// stored in a child table, set up with the associations
+				p.parseMapTable(ormable, field, fieldOpts)
+				continue
+			}
+			field.GoIdent = ptrIdent(p.jsonbIdent())
+			fieldOpts.Tag = tagWithType(tag, "jsonb")
+		} else if desc.IsList() && desc.Enum() != nil {
+			ident, tagString := p.repeatedEnumIdent()
+			field.GoIdent = ident
+			fieldOpts.Tag = tagWithType(tag, tagString)
+		} else if p.isRepeatedJsonb(field) {
+			field.GoIdent = ptrIdent(p.jsonbIdent())
+			fieldOpts.Tag = tagWithType(tag, "jsonb")
 		} else if (desc.Message() != nil || !p.isOrmable(fieldType)) && desc.IsList() {
 			// Not implemented yet
 			continue
 		} else if desc.Enum() != nil {
 			field.GoIdent.GoName = "int32"
-			if p.StringEnums {
+			if p.StringEnums() {
 				field.GoIdent.GoName = "string"
 			}
+		} else if mapping := p.getTypeMapping(field); mapping != nil {
+			p.parseMappedField(field, mapping, fieldOpts)
+		} else if isWellKnownType(field, protoTypeAny) {
+			// stored in the type url and value columns
+			p.parseAnyField(ormable, field, fieldOpts)
+			continue
+		} else if p.parseWellKnownField(field, fieldOpts) {
+			// stored in a dedicated column type
 		} else if desc.Message() != nil {
 
 			fieldType = string(desc.Message().Name())
@@ -278,7 +358,7 @@ func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 			} else if rawType == protoTypeTimestamp {
 				field.GoIdent = ptrIdent(identTime)
 			} else if rawType == protoTypeJSON {
-				field.GoIdent = ptrIdent(identpqJsonb)
+				field.GoIdent = ptrIdent(p.jsonbIdent())
 				fieldOpts.Tag = tagWithType(tag, "jsonb")
 			} else if rawType == protoTypeResource {
 				tag := getFieldOptions(field).GetTag()
@@ -314,12 +394,20 @@ func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 			} else if rawType == protoTimeOnly {
 				field.GoIdent.GoName = "string"
 				fieldOpts.Tag = tagWithType(tag, "time")
-			} else {
+			} else if p.isOrmable(rawType) {
+				// set up with the associations
+				continue
+			} else if !p.parseSerializedField(field, fieldOpts) {
+				p.warning("field %s of %s is dropped as its type %s is not ormable, set (gorm.field).serializer to store it",
+					fieldName, typeName, rawType)
 				continue
 			}
 		} else {
 			field.GoIdent.GoName = fieldType
 		}
+		if isOneofMember(field) {
+			p.nullableOneofMember(field)
+		}
 
 		f := &Field{F: field, Type: field.GoIdent.GoName, Package: typePackage, GormFieldOptions: fieldOpts}
 
@@ -331,6 +419,7 @@ func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 		}
 		ormable.Fields[fieldName] = f
 	}
+	p.parseDiscriminatedOneofs(msg, ormable)
 	if getMessageOptions(msg).GetMultiAccount() {
 		if accID, ok := ormable.Fields["AccountID"]; !ok {
 			ormable.Fields["AccountID"] = &Field{Type: "string"}
@@ -338,6 +427,18 @@ func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 			p.Fail("Cannot include AccountID field into", ormable.Name, "as it already exists there with a different type.")
 		}
 	}
+	if getMessageOptions(msg).GetSoftDelete() {
+		ident := p.deletedAtIdent()
+		if deletedAt, ok := ormable.Fields["DeletedAt"]; !ok {
+			ormable.Fields["DeletedAt"] = &Field{
+				F:                &protogen.Field{GoIdent: ident},
+				Type:             ident.GoName,
+				GormFieldOptions: &gorm.GormFieldOptions{Tag: &gorm.GormTag{Index: proto.String("")}},
+			}
+		} else if deletedAt.F.GoIdent != ident {
+			p.Fail("Cannot include DeletedAt field into", ormable.Name, "as it already exists there with a different type.")
+		}
+	}
 	for _, field := range getMessageOptions(msg).GetInclude() {
 		fieldName := field.GetName()
 		if _, ok := ormable.Fields[fieldName]; !ok {
@@ -346,6 +447,8 @@ func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 			p.Fail("Cannot include", fieldName, "field into", ormable.Name, "as it aready exists there.")
 		}
 	}
+	p.parseVersionField(msg, ormable)
+	p.parseAuditFields(ormable)
 }
 
 func tagWithType(tag *gorm.GormTag, typename string) *gorm.GormTag {
@@ -381,7 +484,7 @@ func (p *OrmPlugin) addIncludedField(ormable *OrmableType, field *gorm.ExtraFiel
 		} else if rawType == "UUID" {
 			f.GoIdent = identUUID
 		} else if field.GetType() == "Jsonb" {
-			f.GoIdent = identpqJsonb
+			f.GoIdent = p.jsonbIdent()
 		} else if rawType == "Inet" {
 			f.GoIdent = identTypesInet
 		} else {
@@ -409,7 +512,10 @@ func (p *OrmPlugin) getSortedFieldNames(fields map[string]*Field) []string {
 }
 
 func (p *OrmPlugin) generateOrmable(message *protogen.Message) {
-	ormable := p.getOrmableMessage(message)
+	p.generateOrmableStruct(p.getOrmableMessage(message))
+}
+
+func (p *OrmPlugin) generateOrmableStruct(ormable *OrmableType) {
 	p.P(`type `, ormable.Name, ` struct {`)
 	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
 		field := ormable.Fields[fieldName]
@@ -433,12 +539,7 @@ func (p *OrmPlugin) generateTableNameFunction(message *protogen.Message) {
 
 	p.P(`// TableName overrides the default tablename generated by GORM`)
 	p.P(`func (`, typeName, `ORM) TableName() string {`)
-
-	tableName := inflection.Plural(jgorm.ToDBName(typeName))
-	if opts := getMessageOptions(message); opts != nil && opts.Table != nil {
-		tableName = opts.GetTable()
-	}
-	p.P(`return "`, tableName, `"`)
+	p.P(`return "`, p.tableName(p.getOrmableMessage(message)), `"`)
 	p.P(`}`)
 }
 
@@ -465,10 +566,15 @@ func (p *OrmPlugin) generateConvertFunctions(message *protogen.Message) {
 		fname := field.GoName
 
 		ofield := ormable.Fields[fname]
+		if isOneofMember(field) {
+			p.generateOneofMemberConversion(message, field, true, ofield)
+			continue
+		}
 		p.generateFieldConversion(message, field, true, ofield)
 	}
+	p.generateDiscriminatedOneofConversions(message, true)
 	if getMessageOptions(message).GetMultiAccount() {
-		p.P(`accountID, err := `, identGetAccountIDFn, `(ctx, nil)`)
+		p.P(`accountID, err := `, p.identGetAccountIDFn, `(ctx, nil)`)
 		p.P(`if err != nil {`)
 		p.P(`return to, err`)
 		p.P(`}`)
@@ -500,8 +606,13 @@ func (p *OrmPlugin) generateConvertFunctions(message *protogen.Message) {
 			continue
 		}
 		ofield := ormable.Fields[field.GoName]
+		if isOneofMember(field) {
+			p.generateOneofMemberConversion(message, field, false, ofield)
+			continue
+		}
 		p.generateFieldConversion(message, field, false, ofield)
 	}
+	p.generateDiscriminatedOneofConversions(message, false)
 	p.P(`if posthook, ok := interface{}(m).(`, typeName, `WithAfterToPB); ok {`)
 	p.P(`err = posthook.AfterToPB(ctx, &to)`)
 	p.P(`}`)
@@ -511,35 +622,48 @@ func (p *OrmPlugin) generateConvertFunctions(message *protogen.Message) {
 
 // Output code that will convert a field to/from orm.
 func (p *OrmPlugin) generateFieldConversion(message *protogen.Message, field *protogen.Field, toORM bool, ofield *Field) error {
+	fieldName := fieldName(field)
+	return p.generateValueConversion(message, field, toORM, ofield, "m."+fieldName, "to."+fieldName)
+}
+
+// generateValueConversion outputs code converting the value of field held in
+// src to/from orm and storing it in dst.
+func (p *OrmPlugin) generateValueConversion(message *protogen.Message, field *protogen.Field, toORM bool, ofield *Field, src, dst string) error {
 	desc := field.Desc
 	fieldName := fieldName(field)
 	fieldType := p.fieldType(field)
 	ident := fieldIdent(field)
 
-	if desc.IsList() { // Repeated Object ----------------------------------
+	if desc.IsMap() { // Map, stored as jsonb or in a child table ----------
+		p.generateMapFieldConversion(field, toORM, ofield)
+	} else if desc.IsList() { // Repeated Object ----------------------------------
 		// Some repeated fields can be handled by github.com/lib/pq
 		if p.IsAbleToMakePQArray(fieldType) {
 			pqIdent, _, _ := p.fieldToPQArrayIdent(field)
-			p.P(`if m.`, fieldName, ` != nil {`)
-			p.P(`to.`, fieldName, ` = make(`, pqIdent, `, len(m.`, fieldName, `))`)
-			p.P(`copy(to.`, fieldName, `, m.`, fieldName, `)`)
+			p.P(`if `, src, ` != nil {`)
+			p.P(dst, ` = make(`, pqIdent, `, len(`, src, `))`)
+			p.P(`copy(`, dst, `, `, src, `)`)
 			p.P(`}`)
+		} else if desc.Enum() != nil { // Repeated enum, stored in a lib/pq array
+			p.generateRepeatedEnumConversion(field, toORM, src, dst)
+		} else if p.isRepeatedJsonb(field) { // Repeated non ORMable message, stored as jsonb
+			p.generateRepeatedJsonbConversion(field, toORM, src, dst)
 		} else if p.isOrmable(fieldType) { // Repeated ORMable type
 			//fieldType = strings.Trim(fieldType, "[]*")
 
-			p.P(`for _, v := range m.`, fieldName, ` {`)
+			p.P(`for _, v := range `, src, ` {`)
 			p.P(`if v != nil {`)
 			if toORM {
 				p.P(`if temp`, fieldName, `, cErr := v.ToORM(ctx); cErr == nil {`)
 			} else {
 				p.P(`if temp`, fieldName, `, cErr := v.ToPB(ctx); cErr == nil {`)
 			}
-			p.P(`to.`, fieldName, ` = append(to.`, fieldName, `, &temp`, fieldName, `)`)
+			p.P(dst, ` = append(`, dst, `, &temp`, fieldName, `)`)
 			p.P(`} else {`)
 			p.P(`return to, cErr`)
 			p.P(`}`)
 			p.P(`} else {`)
-			p.P(`to.`, fieldName, ` = append(to.`, fieldName, `, nil)`)
+			p.P(dst, ` = append(`, dst, `, nil)`)
 			p.P(`}`)
 			p.P(`}`) // end repeated for
 		} else {
@@ -548,86 +672,98 @@ func (p *OrmPlugin) generateFieldConversion(message *protogen.Message, field *pr
 		}
 	} else if desc.Enum() != nil { // Singular Enum, which is an int32 ---
 		if toORM {
-			if p.StringEnums {
-				p.P(`to.`, fieldName, ` = `, ident, `_name[int32(m.`, fieldName, `)]`)
+			if p.StringEnums() {
+				p.P(dst, ` = `, ident, `_name[int32(`, src, `)]`)
 			} else {
-				p.P(`to.`, fieldName, ` = int32(m.`, fieldName, `)`)
+				p.P(dst, ` = int32(`, src, `)`)
 			}
 		} else {
-			if p.StringEnums {
-				p.P(`to.`, fieldName, ` = `, ident, `(`, ident, `_value[m.`, fieldName, `])`)
+			if p.StringEnums() {
+				p.P(dst, ` = `, ident, `(`, ident, `_value[`, src, `])`)
 			} else {
-				p.P(`to.`, fieldName, ` = `, ident, `(m.`, fieldName, `)`)
+				p.P(dst, ` = `, ident, `(`, src, `)`)
 			}
 		}
 	} else if desc.Message() != nil { // Singular Object -------------
 		//Check for WKTs
 		parts := strings.Split(fieldType, ".")
 		coreType := parts[len(parts)-1]
-		// Type is a WKT, convert to/from as ptr to base type
-		if _, exists := wellKnownTypes[coreType]; exists { // Singular WKT -----
+		if mapping := p.getTypeMapping(field); mapping != nil { // Custom type mapping
+			p.generateMappedFieldConversion(mapping, toORM, src, dst)
+		} else if hasWellKnownStorage(field) { // Duration, Struct, Any, BytesValue, etc.
+			p.generateWellKnownFieldConversion(field, toORM, src, dst)
+		} else if _, exists := wellKnownTypes[coreType]; exists { // Singular WKT, convert to/from as ptr to base type
 			if toORM {
-				p.P(`if m.`, fieldName, ` != nil {`)
-				p.P(`v := m.`, fieldName, `.Value`)
-				p.P(`to.`, fieldName, ` = &v`)
+				p.P(`if `, src, ` != nil {`)
+				p.P(`v := `, src, `.Value`)
+				p.P(dst, ` = &v`)
 				p.P(`}`)
 			} else {
-				p.P(`if m.`, fieldName, ` != nil {`)
-				p.P(`to.`, fieldName, ` = &`, ident,
-					`{Value: *m.`, fieldName, `}`)
+				p.P(`if `, src, ` != nil {`)
+				p.P(dst, ` = &`, ident,
+					`{Value: *`, src, `}`)
 				p.P(`}`)
 			}
 		} else if coreType == protoTypeUUIDValue { // Singular UUIDValue type ----
 			if toORM {
-				p.P(`if m.`, fieldName, ` != nil {`)
-				p.P(`tempUUID, uErr := `, p.identFnCall(identUUIDFromStringFn, fmt.Sprintf("m.%s.Value", fieldName)))
+				p.P(`if `, src, ` != nil {`)
+				p.P(`tempUUID, uErr := `, p.identFnCall(identUUIDFromStringFn, src+".Value"))
 				p.P(`if uErr != nil {`)
 				p.P(`return to, uErr`)
 				p.P(`}`)
-				p.P(`to.`, fieldName, ` = &tempUUID`)
+				p.P(dst, ` = &tempUUID`)
 				p.P(`}`)
 			} else {
-				p.P(`if m.`, fieldName, ` != nil {`)
-				p.P(`to.`, fieldName, ` = &`, identTypesUUIDValue, `{Value: m.`, fieldName, `.String()}`)
+				p.P(`if `, src, ` != nil {`)
+				p.P(dst, ` = &`, identTypesUUIDValue, `{Value: `, src, `.String()}`)
 				p.P(`}`)
 			}
 		} else if coreType == protoTypeUUID { // Singular UUID type --------------
 			if toORM {
-				p.P(`if m.`, fieldName, ` != nil {`)
-				p.P(`to.`, fieldName, `, err = `, p.identFnCall(identUUIDFromStringFn, fmt.Sprintf("m.%s.Value", fieldName)))
+				p.P(`if `, src, ` != nil {`)
+				p.P(dst, `, err = `, p.identFnCall(identUUIDFromStringFn, src+".Value"))
 				p.P(`if err != nil {`)
 				p.P(`return to, err`)
 				p.P(`}`)
 				p.P(`} else {`)
-				p.P(`to.`, fieldName, ` = `, identNilUUID)
+				p.P(dst, ` = `, identNilUUID)
 				p.P(`}`)
 			} else {
-				p.P(`to.`, fieldName, ` = &`, identTypesUUID, `{Value: m.`, fieldName, `.String()}`)
+				p.P(dst, ` = &`, identTypesUUID, `{Value: `, src, `.String()}`)
 			}
 		} else if coreType == protoTypeTimestamp { // Singular WKT Timestamp ---
 			if toORM {
-				p.P(`if m.`, fieldName, ` != nil {`)
+				p.P(`if `, src, ` != nil {`)
 				p.P(`var t `, identTime)
-				p.P(`if t, err = `, identTimestamp, `(m.`, fieldName, `); err != nil {`)
+				p.P(`if t, err = `, identTimestamp, `(`, src, `); err != nil {`)
 				p.P(`return to, err`)
 				p.P(`}`)
-				p.P(`to.`, fieldName, ` = &t`)
+				p.P(dst, ` = &t`)
 				p.P(`}`)
 			} else {
-				p.P(`if m.`, fieldName, ` != nil {`)
-				p.P(`if to.`, fieldName, `, err = `, identTimestampProto, `(*m.`, fieldName, `); err != nil {`)
+				p.P(`if `, src, ` != nil {`)
+				p.P(`if `, dst, `, err = `, identTimestampProto, `(*`, src, `); err != nil {`)
 				p.P(`return to, err`)
 				p.P(`}`)
 				p.P(`}`)
 			}
 		} else if coreType == protoTypeJSON {
 			if toORM {
-				p.P(`if m.`, fieldName, ` != nil {`)
-				p.P(`to.`, fieldName, ` = &`, identpqJsonb, `{[]byte(m.`, fieldName, `.Value)}`)
+				p.P(`if `, src, ` != nil {`)
+				if p.isGormV2() {
+					p.P(`v := `, identDatatypesJSON, `(`, src, `.Value)`)
+					p.P(dst, ` = &v`)
+				} else {
+					p.P(dst, ` = &`, identpqJsonb, `{[]byte(`, src, `.Value)}`)
+				}
 				p.P(`}`)
 			} else {
-				p.P(`if m.`, fieldName, ` != nil {`)
-				p.P(`to.`, fieldName, ` = &`, identTypesJSONValue, `{Value: string(m.`, fieldName, `.RawMessage)}`)
+				p.P(`if `, src, ` != nil {`)
+				if p.isGormV2() {
+					p.P(dst, ` = &`, identTypesJSONValue, `{Value: string(*`, src, `)}`)
+				} else {
+					p.P(dst, ` = &`, identTypesJSONValue, `{Value: string(`, src, `.RawMessage)}`)
+				}
 				p.P(`}`)
 			}
 		} else if coreType == protoTypeResource {
@@ -641,36 +777,36 @@ func (p *OrmPlugin) generateFieldConversion(message *protogen.Message, field *pr
 
 			if toORM {
 				if nillable {
-					p.P(`if m.`, fieldName, ` != nil {`)
+					p.P(`if `, src, ` != nil {`)
 				}
 				switch btype {
 				case "int64":
-					p.P(`if v, err :=`, identResourceDecodeInt64Fn, `(`, resource, `, m.`, fieldName, `); err != nil {`)
+					p.P(`if v, err :=`, p.identResourceDecodeInt64Fn, `(`, resource, `, `, src, `); err != nil {`)
 					p.P(`	return to, err`)
 					p.P(`} else {`)
 					if nillable {
-						p.P(`to.`, fieldName, ` = &v`)
+						p.P(dst, ` = &v`)
 					} else {
-						p.P(`to.`, fieldName, ` = v`)
+						p.P(dst, ` = v`)
 					}
 					p.P(`}`)
 				case "[]byte":
-					p.P(`if v, err :=`, identResourceDecodeBytesFn, `(`, resource, `, m.`, fieldName, `); err != nil {`)
+					p.P(`if v, err :=`, p.identResourceDecodeBytesFn, `(`, resource, `, `, src, `); err != nil {`)
 					p.P(`	return to, err`)
 					p.P(`} else {`)
-					p.P(`	to.`, fieldName, ` = v`)
+					p.P(`	`, dst, ` = v`)
 					p.P(`}`)
 				default:
-					p.P(`if v, err :=`, identResourceDecodeFn, `(`, resource, `, m.`, fieldName, `); err != nil {`)
+					p.P(`if v, err :=`, p.identResourceDecodeFn, `(`, resource, `, `, src, `); err != nil {`)
 					p.P(`return to, err`)
 					p.P(`} else if v != nil {`)
 					if nillable {
 						p.P(`vv := v.(`, btype, `)`)
-						p.P(`to.`, fieldName, ` = &vv`)
+						p.P(dst, ` = &vv`)
 					} else if iface {
-						p.P(`to.`, fieldName, `= v`)
+						p.P(dst, `= v`)
 					} else {
-						p.P(`to.`, fieldName, ` = v.(`, btype, `)`)
+						p.P(dst, ` = v.(`, btype, `)`)
 					}
 					p.P(`}`)
 				}
@@ -681,64 +817,66 @@ func (p *OrmPlugin) generateFieldConversion(message *protogen.Message, field *pr
 
 			if !toORM {
 				if nillable {
-					p.P(`if m.`, fieldName, `!= nil {`)
-					p.P(`	if v, err := `, identResourceEncodeFn, `(`, resource, `, *m.`, fieldName, `); err != nil {`)
+					p.P(`if `, src, `!= nil {`)
+					p.P(`	if v, err := `, p.identResourceEncodeFn, `(`, resource, `, *`, src, `); err != nil {`)
 					p.P(`		return to, err`)
 					p.P(`	} else {`)
-					p.P(`		to.`, fieldName, ` = v`)
+					p.P(`		`, dst, ` = v`)
 					p.P(`	}`)
 					p.P(`}`)
 
 				} else {
-					p.P(`if v, err := `, identResourceEncodeFn, `(`, resource, `, m.`, fieldName, `); err != nil {`)
+					p.P(`if v, err := `, p.identResourceEncodeFn, `(`, resource, `, `, src, `); err != nil {`)
 					p.P(`return to, err`)
 					p.P(`} else {`)
-					p.P(`to.`, fieldName, ` = v`)
+					p.P(dst, ` = v`)
 					p.P(`}`)
 				}
 			}
 		} else if coreType == protoTypeInet { // Inet type for Postgres only, currently
 			if toORM {
-				p.P(`if m.`, fieldName, ` != nil {`)
-				p.P(`if to.`, fieldName, `, err = `, identTypesParseInetFn, `(m.`, fieldName, `.Value); err != nil {`)
+				p.P(`if `, src, ` != nil {`)
+				p.P(`if `, dst, `, err = `, identTypesParseInetFn, `(`, src, `.Value); err != nil {`)
 				p.P(`return to, err`)
 				p.P(`}`)
 				p.P(`}`)
 			} else {
-				p.P(`if m.`, fieldName, ` != nil && m.`, fieldName, `.IPNet != nil {`)
-				p.P(`to.`, fieldName, ` = &`, identTypesInetValue, `{Value: m.`, fieldName, `.String()}`)
+				p.P(`if `, src, ` != nil && `, src, `.IPNet != nil {`)
+				p.P(dst, ` = &`, identTypesInetValue, `{Value: `, src, `.String()}`)
 				p.P(`}`)
 			}
 		} else if coreType == protoTimeOnly { // Time only to support time via string
 			if toORM {
-				p.P(`if m.`, fieldName, ` != nil {`)
-				p.P(`if to.`, fieldName, `, err = `, identTypesParseTimeFn, `(m.`, fieldName, `.Value); err != nil {`)
+				p.P(`if `, src, ` != nil {`)
+				p.P(`if `, dst, `, err = `, identTypesParseTimeFn, `(`, src, `.Value); err != nil {`)
 				p.P(`return to, err`)
 				p.P(`}`)
 				p.P(`}`)
 			} else {
-				p.P(`if m.`, fieldName, ` != "" {`)
-				p.P(`if to.`, fieldName, `, err = `, identTypesTimeOnlyByStringFn, `( m.`, fieldName, `); err != nil {`)
+				p.P(`if `, src, ` != "" {`)
+				p.P(`if `, dst, `, err = `, identTypesTimeOnlyByStringFn, `( `, src, `); err != nil {`)
 				p.P(`return to, err`)
 				p.P(`}`)
 				p.P(`}`)
 			}
 		} else if p.isOrmable(fieldType) {
 			// Not a WKT, but a type we're building converters for
-			p.P(`if m.`, fieldName, ` != nil {`)
+			p.P(`if `, src, ` != nil {`)
 			if toORM {
-				p.P(`temp`, fieldName, `, err := m.`, fieldName, `.ToORM (ctx)`)
+				p.P(`temp`, fieldName, `, err := `, src, `.ToORM (ctx)`)
 			} else {
-				p.P(`temp`, fieldName, `, err := m.`, fieldName, `.ToPB (ctx)`)
+				p.P(`temp`, fieldName, `, err := `, src, `.ToPB (ctx)`)
 			}
 			p.P(`if err != nil {`)
 			p.P(`return to, err`)
 			p.P(`}`)
-			p.P(`to.`, fieldName, ` = &temp`, fieldName)
+			p.P(dst, ` = &temp`, fieldName)
 			p.P(`}`)
+		} else if isSerialized(field) { // Non ORMable type stored as jsonb or bytea
+			p.generateSerializedFieldConversion(field, toORM, src, dst)
 		}
 	} else { // Singular raw ----------------------------------------------------
-		p.P(`to.`, fieldName, ` = m.`, fieldName)
+		p.P(dst, ` = `, src)
 	}
 	return nil
 }
@@ -788,7 +926,7 @@ func (p *OrmPlugin) setupOrderedHasManyByName(message *protogen.Message, fieldNa
 }
 
 func (p *OrmPlugin) warning(format string, v ...interface{}) {
-	if !p.SuppressWarnings {
+	if !p.Quiet {
 		log.Printf("WARNING: "+format, v...)
 	}
 }
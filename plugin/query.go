@@ -0,0 +1,159 @@
+package plugin
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// queryImportPath is the import path of the query runtime package, which
+// replaces the collection operators of atlas-app-toolkit.
+const queryImportPath = protogen.GoImportPath("github.com/edhaight/protoc-gen-gorm/query")
+
+// queryTypeNames are the names of the collection operator types, shared by
+// the query runtime package and atlas-app-toolkit.
+var queryTypeNames = map[string]bool{
+	"Filtering":      true,
+	"Sorting":        true,
+	"Pagination":     true,
+	"PageInfo":       true,
+	"FieldSelection": true,
+}
+
+// usesLocalQuery reports whether the requests and responses of the services
+// of files take the collection operators of the query runtime package, or the
+// AIP filter and order_by strings parsed by it, rather than the
+// atlas-app-toolkit ones, which can't be mixed.
+func (p *OrmPlugin) usesLocalQuery(files []*protogen.File) bool {
+	var local, atlas, aip string
+	for _, file := range files {
+		for _, service := range file.Services {
+			for _, method := range service.Methods {
+				if strings.HasPrefix(method.GoName, listService) && (p.getStringFieldOfName(method.Input, "filter") != "" ||
+					p.getStringFieldOfName(method.Input, "order_by") != "") {
+					aip = string(method.Input.Desc.FullName())
+				}
+				for _, message := range []*protogen.Message{method.Input, method.Output} {
+					for _, field := range message.Fields {
+						if field.Message == nil || !queryTypeNames[field.Message.GoIdent.GoName] {
+							continue
+						}
+						if field.Message.GoIdent.GoImportPath == queryImportPath {
+							local = string(message.Desc.FullName())
+						} else if strings.Contains(string(field.Message.GoIdent.GoImportPath), "atlas-app-toolkit") {
+							atlas = string(message.Desc.FullName())
+						}
+					}
+				}
+			}
+		}
+	}
+	if local != "" && atlas != "" {
+		p.Fail("Cannot mix the collection operators of the query package, in", local,
+			"and of atlas-app-toolkit, in", atlas+".")
+	}
+	if aip != "" && atlas != "" {
+		p.warning("the filter and order_by strings of %s are ignored along the collection operators of atlas-app-toolkit", aip)
+		return local != ""
+	}
+	return local != "" || aip != ""
+}
+
+// getFilter returns the name of the AIP-160 filter string field of a list
+// request, parsed into a query.Filtering.
+func (p *OrmPlugin) getFilter(object *protogen.Message) string {
+	if !p.localQuery {
+		return ""
+	}
+	return p.getStringFieldOfName(object, "filter")
+}
+
+// getOrderBy returns the name of the AIP-132 order_by string field of a list
+// request, parsed into a query.Sorting.
+func (p *OrmPlugin) getOrderBy(object *protogen.Message) string {
+	if !p.localQuery {
+		return ""
+	}
+	return p.getStringFieldOfName(object, "order_by")
+}
+
+func (p *OrmPlugin) getStringFieldOfName(object *protogen.Message, name protoreflect.Name) string {
+	for _, field := range object.Fields {
+		if field.Desc.Name() == name && field.Desc.Kind() == protoreflect.StringKind && !field.Desc.IsList() {
+			return field.GoName
+		}
+	}
+	return ""
+}
+
+// generateListQueryParsing outputs the parsing of the filter and order_by
+// strings of the request of a list method, and returns the filtering and
+// sorting arguments of its handler.
+func (p *OrmPlugin) generateListQueryParsing(service autogenService, method autogenMethod) (string, string) {
+	var f, s string
+	if name := p.getFiltering(method.inType); name != "" {
+		f = "in." + name
+	} else if name := p.getFilter(method.inType); name != "" {
+		p.P(`f, err := `, identParseFilterFn, `(in.Get`, name, `())`)
+		p.P(`if err != nil {`)
+		p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
+		p.P(`}`)
+		f = "f"
+	}
+	if name := p.getSorting(method.inType); name != "" {
+		s = "in." + name
+	} else if name := p.getOrderBy(method.inType); name != "" {
+		p.P(`s, err := `, identParseOrderByFn, `(in.Get`, name, `())`)
+		p.P(`if err != nil {`)
+		p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
+		p.P(`}`)
+		s = "s"
+	}
+	return f, s
+}
+
+// useLocalQuery points the collection operator idents at the query runtime
+// package.
+func (t *targetIdents) useLocalQuery() {
+	t.identQueryFieldSelection = queryImportPath.Ident("FieldSelection")
+	t.identQueryPagination = queryImportPath.Ident("Pagination")
+	t.identQuerySorting = queryImportPath.Ident("Sorting")
+	t.identQueryFiltering = queryImportPath.Ident("Filtering")
+	t.identQueryPageInfo = queryImportPath.Ident("PageInfo")
+
+	t.identApplyFieldSelectionFn = queryImportPath.Ident("ApplyFieldSelection")
+	t.identMergeWithMaskFn = queryImportPath.Ident("MergeWithMask")
+	t.identApplyCollectionOperatorsFn = queryImportPath.Ident("ApplyCollectionOperators")
+}
+
+var (
+	identParseFilterFn  = queryImportPath.Ident("ParseFilter")
+	identParseOrderByFn = queryImportPath.Ident("ParseOrderBy")
+)
+
+// generateApplyCollectionOperators outputs the application of the collection
+// operators f, s, pg and fs to db, returning its error after ret.
+func (p *OrmPlugin) generateApplyCollectionOperators(ormable *OrmableType, f, s, pg, fs, ret string) {
+	if p.localQuery {
+		p.P(`if err = `, p.identFnCall(p.identApplyCollectionOperatorsFn, "ctx", "&db", "&"+ormable.Name+"{}", f, s, pg, fs), `; err != nil {`)
+	} else {
+		typeName := ormable.OriginName
+		p.P(`db, err = `, p.identFnCall(p.identApplyCollectionOperatorsFn, "ctx", "db", "&"+ormable.Name+"{}", "&"+typeName+"{}", f, s, pg, fs))
+		p.P(`if err != nil {`)
+	}
+	p.P(ret, `err`)
+	p.P(`}`)
+}
+
+// generateApplyFieldSelection outputs the application of the field selection
+// fs to db, returning its error after ret.
+func (p *OrmPlugin) generateApplyFieldSelection(ormable *OrmableType, fs, ret string) {
+	if p.localQuery {
+		p.P(`if err = `, p.identApplyFieldSelectionFn, `(ctx, &db, `, fs, `, &`, ormable.Name, `{}); err != nil {`)
+	} else {
+		p.P(`if db, err = `, p.identApplyFieldSelectionFn, `(ctx, db, `, fs, `, &`, ormable.Name, `{}); err != nil {`)
+	}
+	p.P(ret, `err`)
+	p.P(`}`)
+}
@@ -0,0 +1,166 @@
+package plugin
+
+import (
+	"strings"
+)
+
+// queryBuilderOperators are the comparisons of the query builder methods, by
+// the suffix of their name.
+var queryBuilderOperators = []struct {
+	suffix   string
+	operator string
+	ordered  bool
+}{
+	{"Eq", "=", false},
+	{"Ne", "<>", false},
+	{"Gt", ">", true},
+	{"Ge", ">=", true},
+	{"Lt", "<", true},
+	{"Le", "<=", true},
+}
+
+// isQueryColumn reports whether field is stored in a column.
+func isQueryColumn(field *Field) bool {
+	tag := field.GetTag()
+	return !isAssociation(field) && !tag.GetIgnore() && !tag.GetEmbedded()
+}
+
+// queryComparison returns whether the values of field can be compared by the
+// query builder, and whether they are ordered.
+func queryComparison(field *Field) (ok, ordered bool) {
+	goType := fieldGoType(field)
+	switch {
+	case goType == "bool" || goType == string(identUUID.GoImportPath)+"."+identUUID.GoName:
+		return true, false
+	case goType == "string" || goType == "time.Time" || isIntegerType(goType) || goType == "float32" || goType == "float64":
+		return true, true
+	}
+	return false, false
+}
+
+// queryArgType returns the Go type of the values of field taken by the query
+// builder methods, and whether field is nullable.
+func (p *OrmPlugin) queryArgType(field *Field) (string, bool) {
+	if field.F == nil {
+		return strings.TrimPrefix(field.Type, "*"), strings.HasPrefix(field.Type, "*")
+	}
+	ident := field.F.GoIdent
+	ident.GoName = strings.TrimPrefix(ident.GoName, "*")
+	return p.qualifiedGoIdent(ident), strings.HasPrefix(field.F.GoIdent.GoName, "*")
+}
+
+// generateQueryBuilder outputs the constants of the columns of ormable and
+// its typed query builder, so the conditions written in the hooks are checked
+// by the compiler rather than naming the columns in strings.
+func (p *OrmPlugin) generateQueryBuilder(ormable *OrmableType) {
+	builder := ormable.Name + "QueryBuilder"
+	db := p.qualifiedGoIdentPtr(p.identGormDB)
+	var columns []string
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		if isQueryColumn(ormable.Fields[fieldName]) {
+			columns = append(columns, fieldName)
+		}
+	}
+	if len(columns) > 0 {
+		p.P(`// The columns of `, ormable.Name)
+		p.P(`const (`)
+		for _, fieldName := range columns {
+			p.P(ormable.Name, `Column`, fieldName, ` = "`, columnName(fieldName, ormable.Fields[fieldName]), `"`)
+		}
+		p.P(`)`)
+		p.P()
+	}
+	p.P(`// `, builder, ` builds a query of `, ormable.Name, ` from typed conditions, orders and`)
+	p.P(`// preloads, applied to a *gorm.DB with Apply. The builder is immutable.`)
+	p.P(`type `, builder, ` struct {`)
+	p.P(`scopes []func(`, db, `) `, db)
+	p.P(`}`)
+	p.P()
+	p.P(`// `, ormable.Name, `Query returns an empty query builder of `, ormable.Name)
+	p.P(`func `, ormable.Name, `Query() *`, builder, ` {`)
+	p.P(`return &`, builder, `{}`)
+	p.P(`}`)
+	p.P()
+	p.P(`func (q *`, builder, `) scope(scope func(`, db, `) `, db, `) *`, builder, ` {`)
+	p.P(`return &`, builder, `{scopes: append(q.scopes[:len(q.scopes):len(q.scopes)], scope)}`)
+	p.P(`}`)
+	p.P()
+	p.P(`func (q *`, builder, `) where(query string, args ...interface{}) *`, builder, ` {`)
+	p.P(`return q.scope(func(db `, db, `) `, db, ` {`)
+	p.P(`return db.Where(query, args...)`)
+	p.P(`})`)
+	p.P(`}`)
+	p.P()
+	p.P(`// Apply returns db with the conditions, orders and preloads of q`)
+	p.P(`func (q *`, builder, `) Apply(db `, db, `) `, db, ` {`)
+	p.P(`for _, scope := range q.scopes {`)
+	p.P(`db = scope(db)`)
+	p.P(`}`)
+	p.P(`return db`)
+	p.P(`}`)
+	p.P()
+	for _, fieldName := range columns {
+		field := ormable.Fields[fieldName]
+		column := ormable.Name + `Column` + fieldName
+		argType, nullable := p.queryArgType(field)
+		if ok, ordered := queryComparison(field); ok {
+			for _, op := range queryBuilderOperators {
+				if op.ordered && !ordered {
+					continue
+				}
+				p.P(`// `, fieldName, op.suffix, ` adds the condition `, columnName(fieldName, field), ` `, op.operator, ` v`)
+				p.P(`func (q *`, builder, `) `, fieldName, op.suffix, `(v `, argType, `) *`, builder, ` {`)
+				p.P(`return q.where(`, column, `+" `, op.operator, ` ?", v)`)
+				p.P(`}`)
+				p.P()
+			}
+			p.P(`// `, fieldName, `In adds the condition `, columnName(fieldName, field), ` IN (vs)`)
+			p.P(`func (q *`, builder, `) `, fieldName, `In(vs ...`, argType, `) *`, builder, ` {`)
+			p.P(`return q.where(`, column, `+" IN (?)", vs)`)
+			p.P(`}`)
+			p.P()
+			if argType == "string" {
+				p.P(`// `, fieldName, `Like adds the condition `, columnName(fieldName, field), ` LIKE pattern`)
+				p.P(`func (q *`, builder, `) `, fieldName, `Like(pattern string) *`, builder, ` {`)
+				p.P(`return q.where(`, column, `+" LIKE ?", pattern)`)
+				p.P(`}`)
+				p.P()
+			}
+		}
+		if nullable {
+			p.P(`// `, fieldName, `IsNull adds the condition `, columnName(fieldName, field), ` IS NULL`)
+			p.P(`func (q *`, builder, `) `, fieldName, `IsNull() *`, builder, ` {`)
+			p.P(`return q.where(`, column, ` + " IS NULL")`)
+			p.P(`}`)
+			p.P()
+			p.P(`// `, fieldName, `IsNotNull adds the condition `, columnName(fieldName, field), ` IS NOT NULL`)
+			p.P(`func (q *`, builder, `) `, fieldName, `IsNotNull() *`, builder, ` {`)
+			p.P(`return q.where(`, column, ` + " IS NOT NULL")`)
+			p.P(`}`)
+			p.P()
+		}
+		p.P(`// OrderBy`, fieldName, ` adds the order by `, columnName(fieldName, field), `, descending when desc`)
+		p.P(`func (q *`, builder, `) OrderBy`, fieldName, `(desc bool) *`, builder, ` {`)
+		p.P(`order := `, column)
+		p.P(`if desc {`)
+		p.P(`order += " DESC"`)
+		p.P(`}`)
+		p.P(`return q.scope(func(db `, db, `) `, db, ` {`)
+		p.P(`return db.Order(order)`)
+		p.P(`})`)
+		p.P(`}`)
+		p.P()
+	}
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		if field := ormable.Fields[fieldName]; !isAssociation(field) || field.GetTag().GetIgnore() {
+			continue
+		}
+		p.P(`// Preload`, fieldName, ` adds the preload of the `, fieldName, ` association`)
+		p.P(`func (q *`, builder, `) Preload`, fieldName, `() *`, builder, ` {`)
+		p.P(`return q.scope(func(db `, db, `) `, db, ` {`)
+		p.P(`return db.Preload("`, fieldName, `")`)
+		p.P(`})`)
+		p.P(`}`)
+		p.P()
+	}
+}
@@ -0,0 +1,84 @@
+package plugin
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// repeatedEnumIdent returns the lib/pq array type repeated enum fields are
+// stored in, along with its sql type.
+func (p *OrmPlugin) repeatedEnumIdent() (protogen.GoIdent, string) {
+	if p.StringEnums() {
+		return identpqStringArray, "text[]"
+	}
+	return identpqInt32Array, "integer[]"
+}
+
+// isRepeatedJsonb reports whether field is a repeated message that is not
+// ormable, and is hence stored in a jsonb column.
+func (p *OrmPlugin) isRepeatedJsonb(field *protogen.Field) bool {
+	return field.Desc.IsList() && field.Desc.Message() != nil && !p.isOrmable(p.fieldType(field))
+}
+
+// generateRepeatedEnumConversion outputs the conversion of a repeated enum
+// field to and from its lib/pq array.
+func (p *OrmPlugin) generateRepeatedEnumConversion(field *protogen.Field, toORM bool, src, dst string) {
+	enumIdent := p.qualifiedGoIdent(field.Enum.GoIdent)
+	var elemType, value string
+	if toORM {
+		ident, _ := p.repeatedEnumIdent()
+		elemType = p.qualifiedGoIdent(ident)
+		value = `int32(v)`
+		if p.StringEnums() {
+			value = enumIdent + `_name[int32(v)]`
+		}
+	} else {
+		elemType = `[]` + enumIdent
+		value = enumIdent + `(v)`
+		if p.StringEnums() {
+			value = enumIdent + `(` + enumIdent + `_value[v])`
+		}
+	}
+	p.P(`if `, src, ` != nil {`)
+	p.P(dst, ` = make(`, elemType, `, 0, len(`, src, `))`)
+	p.P(`for _, v := range `, src, ` {`)
+	p.P(dst, ` = append(`, dst, `, `, value, `)`)
+	p.P(`}`)
+	p.P(`}`)
+}
+
+// generateRepeatedJsonbConversion outputs the conversion of a repeated
+// message field to and from its jsonb column, which holds a JSON array of the
+// protojson encoded messages.
+func (p *OrmPlugin) generateRepeatedJsonbConversion(field *protogen.Field, toORM bool, src, dst string) {
+	p.P(`if `, src, ` != nil {`)
+	if toORM {
+		p.P(`raw := make([]`, identJsonRawMessage, `, 0, len(`, src, `))`)
+		p.P(`for _, v := range `, src, ` {`)
+		p.P(`data, err := `, identProtojsonMarshal, `(v)`)
+		p.P(`if err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`raw = append(raw, data)`)
+		p.P(`}`)
+		p.P(`data, err := `, identJsonMarshal, `(raw)`)
+		p.P(`if err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.generateJsonbAssignment(dst)
+	} else {
+		ident := p.qualifiedGoIdent(field.Message.GoIdent)
+		p.P(`var raw []`, identJsonRawMessage)
+		p.P(`if err = `, identJsonUnmarshal, `(`, p.jsonbData(src), `, &raw); err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(dst, ` = make([]*`, ident, `, 0, len(raw))`)
+		p.P(`for _, v := range raw {`)
+		p.P(`x := &`, ident, `{}`)
+		p.P(`if err = `, identProtojsonUnmarshal, `(v, x); err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(dst, ` = append(`, dst, `, x)`)
+		p.P(`}`)
+	}
+	p.P(`}`)
+}
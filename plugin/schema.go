@@ -0,0 +1,311 @@
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	jgorm "github.com/jinzhu/gorm"
+	"github.com/jinzhu/inflection"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// sqlTable describes the table an ormable type, a map field stored in a child
+// table or a many-to-many association is stored in.
+type sqlTable struct {
+	name string
+	// source is the full name of the message the table is stored from, it
+	// is empty for join tables
+	source      string
+	columns     []*sqlColumn
+	primaryKey  []string
+	indexes     []*sqlIndex
+	foreignKeys []*sqlForeignKey
+}
+
+type sqlColumn struct {
+	name          string
+	typ           string
+	notNull       bool
+	unique        bool
+	defaultValue  string
+	autoIncrement bool
+}
+
+type sqlIndex struct {
+	name    string
+	unique  bool
+	columns []string
+}
+
+type sqlForeignKey struct {
+	name       string
+	columns    []string
+	refTable   string
+	refColumns []string
+}
+
+// tableName returns the name of the table ormable is stored in.
+func (p *OrmPlugin) tableName(ormable *OrmableType) string {
+	if ormable.mapTable != nil {
+		return ormable.mapTable.table
+	}
+	if ormable.historyOf != nil {
+		return p.tableName(ormable.historyOf) + "_history"
+	}
+	if opts := getMessageOptions(ormable.Message); opts != nil && opts.Table != nil {
+		return opts.GetTable()
+	}
+	return inflection.Plural(jgorm.ToDBName(p.messageType(ormable.Message)))
+}
+
+// columnName returns the name of the column the field fieldName is stored in.
+func columnName(fieldName string, field *Field) string {
+	if column := field.GetTag().GetColumn(); column != "" {
+		return column
+	}
+	return jgorm.ToDBName(fieldName)
+}
+
+// deletedAtColumn returns the column holding the deletion time of the rows
+// of the soft deleted ormable.
+func deletedAtColumn(ormable *OrmableType) string {
+	return columnName("DeletedAt", ormable.Fields["DeletedAt"])
+}
+
+// isAssociation reports whether field links to another ormable type rather
+// than being stored in a column.
+func isAssociation(field *Field) bool {
+	return field.GetHasOne() != nil || field.GetHasMany() != nil ||
+		field.GetBelongsTo() != nil || field.GetManyToMany() != nil
+}
+
+// fieldGoType returns the Go type of field without pointer, qualified with
+// its import path for non builtin types, e.g. time.Time.
+func fieldGoType(field *Field) string {
+	if field.F == nil {
+		return strings.TrimPrefix(field.Type, "*")
+	}
+	name := strings.TrimPrefix(field.F.GoIdent.GoName, "*")
+	if _, ok := builtinTypes[name]; ok || field.F.GoIdent.GoImportPath == "" {
+		return name
+	}
+	return string(field.F.GoIdent.GoImportPath) + "." + name
+}
+
+// primaryKeyNames returns the names of the primary key fields of ormable: the
+// fields tagged as primary key, or else the one named id.
+func (p *OrmPlugin) primaryKeyNames(ormable *OrmableType) []string {
+	var names []string
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		if ormable.Fields[fieldName].GetTag().GetPrimaryKey() {
+			names = append(names, fieldName)
+		}
+	}
+	if len(names) == 0 && p.hasPrimaryKey(ormable) {
+		fieldName, _ := p.findPrimaryKey(ormable)
+		names = append(names, fieldName)
+	}
+	return names
+}
+
+// buildSchema returns the tables the ormable types of file are stored in, in
+// the order of their declaration. Map child tables, many-to-many join tables
+// and history tables follow the table of the type they belong to.
+func (p *OrmPlugin) buildSchema(file *protogen.File, dialect *sqlDialect) []*sqlTable {
+	foreignKeys, references := p.buildForeignKeys()
+	var tables []*sqlTable
+	seen := make(map[string]bool)
+	add := func(table *sqlTable) {
+		if seen[table.name] {
+			return
+		}
+		seen[table.name] = true
+		table.foreignKeys = append(table.foreignKeys, foreignKeys[table.name]...)
+		tables = append(tables, table)
+	}
+	for _, msg := range file.Messages {
+		if !p.isOrmableMessage(msg) {
+			continue
+		}
+		ormable := p.getOrmableMessage(msg)
+		add(p.buildTable(ormable, references, dialect))
+		for _, field := range msg.Fields {
+			if field.Desc.IsMap() && !getFieldOptions(field).GetDrop() && getFieldOptions(field).GetMap().GetChildTable() {
+				add(p.buildTable(p.getOrmable(mapTableTypeName(ormable, field)), references, dialect))
+			}
+		}
+		for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+			if ormable.Fields[fieldName].GetManyToMany() != nil {
+				add(p.buildJoinTable(ormable, ormable.Fields[fieldName], dialect))
+			}
+		}
+		if hasHistory(ormable) {
+			add(p.buildTable(p.getOrmable(historyTypeName(ormable)), references, dialect))
+		}
+	}
+	return tables
+}
+
+// buildTable returns the table ormable is stored in, without its foreign keys.
+// A foreign key column with no type set takes the type of the column it
+// references, found in references.
+func (p *OrmPlugin) buildTable(ormable *OrmableType, references map[*Field]*Field, dialect *sqlDialect) *sqlTable {
+	table := &sqlTable{name: p.tableName(ormable), source: string(ormable.Message.Desc.FullName())}
+	if ormable.historyOf != nil {
+		table.source += "#history"
+	}
+	primaryKey := p.primaryKeyNames(ormable)
+	isPrimaryKey := make(map[string]bool)
+	for _, fieldName := range primaryKey {
+		isPrimaryKey[fieldName] = true
+		table.primaryKey = append(table.primaryKey, columnName(fieldName, ormable.Fields[fieldName]))
+	}
+	indexes := make(map[string]*sqlIndex)
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		field := ormable.Fields[fieldName]
+		tag := field.GetTag()
+		if isAssociation(field) || tag.GetIgnore() {
+			continue
+		}
+		if tag.GetEmbedded() {
+			p.warning("field %s of %s is embedded, its columns are left out of the DDL", fieldName, ormable.Name)
+			continue
+		}
+		typed := field
+		if ref, ok := references[field]; ok && tag.GetType() == "" {
+			typed = ref
+		}
+		column := &sqlColumn{
+			name:         columnName(fieldName, field),
+			typ:          dialect.columnType(typed),
+			notNull:      tag.GetNotNull() || isPrimaryKey[fieldName],
+			unique:       tag.GetUnique(),
+			defaultValue: tag.GetDefault(),
+		}
+		if column.typ == "" {
+			p.warning("field %s of %s has no known column type, set (gorm.field).tag.type to add it to the DDL", fieldName, ormable.Name)
+			continue
+		}
+		// gorm makes a single integer primary key auto increment unless its
+		// type is set explicitly
+		column.autoIncrement = tag.GetAutoIncrement() ||
+			len(primaryKey) == 1 && isPrimaryKey[fieldName] && tag.GetType() == "" && isIntegerType(fieldGoType(field))
+		table.columns = append(table.columns, column)
+		if tag != nil && tag.Index != nil {
+			p.addIndexColumn(table, indexes, tag.GetIndex(), false, column.name)
+		}
+		if tag != nil && tag.UniqueIndex != nil {
+			p.addIndexColumn(table, indexes, tag.GetUniqueIndex(), true, column.name)
+		}
+	}
+	return table
+}
+
+// addIndexColumn adds column to the named index, the indexes with the same
+// name make up a composite index. An unnamed index is named after gorm's
+// conventions.
+func (p *OrmPlugin) addIndexColumn(table *sqlTable, indexes map[string]*sqlIndex, name string, unique bool, column string) {
+	if name == "" {
+		prefix := "idx"
+		if unique && !p.isGormV2() {
+			prefix = "uix"
+		}
+		name = fmt.Sprintf("%s_%s_%s", prefix, table.name, column)
+	}
+	index, ok := indexes[name]
+	if !ok {
+		index = &sqlIndex{name: name, unique: unique}
+		indexes[name] = index
+		table.indexes = append(table.indexes, index)
+	}
+	index.columns = append(index.columns, column)
+}
+
+// buildJoinTable returns the join table of the many-to-many association held
+// by field of ormable.
+func (p *OrmPlugin) buildJoinTable(ormable *OrmableType, field *Field, dialect *sqlDialect) *sqlTable {
+	mtm := field.GetManyToMany()
+	assoc := p.ormableTypes.GetOrmableByType(field.Type)
+	table := &sqlTable{name: mtm.GetJointable()}
+	for _, side := range []struct {
+		ormable *OrmableType
+		key     string
+		joinKey string
+	}{
+		{ormable, mtm.GetForeignkey(), mtm.GetJointableForeignkey()},
+		{assoc, mtm.GetAssociationForeignkey(), mtm.GetAssociationJointableForeignkey()},
+	} {
+		key := side.ormable.Fields[side.key]
+		column := &sqlColumn{name: jgorm.ToDBName(side.joinKey), typ: dialect.columnType(key), notNull: true}
+		table.columns = append(table.columns, column)
+		table.primaryKey = append(table.primaryKey, column.name)
+		table.foreignKeys = append(table.foreignKeys, &sqlForeignKey{
+			name:       fmt.Sprintf("fk_%s_%s", table.name, column.name),
+			columns:    []string{column.name},
+			refTable:   p.tableName(side.ormable),
+			refColumns: []string{columnName(side.key, key)},
+		})
+	}
+	return table
+}
+
+// buildForeignKeys returns the foreign keys of the has-one, has-many and
+// belongs-to associations of all the ormable types, by the table holding
+// them, along with the fields they reference by foreign key field.
+func (p *OrmPlugin) buildForeignKeys() (map[string][]*sqlForeignKey, map[*Field]*Field) {
+	foreignKeys := make(map[string][]*sqlForeignKey)
+	references := make(map[*Field]*Field)
+	seen := make(map[string]bool)
+	add := func(owner *OrmableType, foreignKey string, target *OrmableType, targetKey string) {
+		// the keys of several columns are listed comma separated
+		targetKeys := splitKeyNames(targetKey, false)
+		fk := &sqlForeignKey{refTable: p.tableName(target)}
+		for i, fieldName := range splitKeyNames(foreignKey, false) {
+			ownerField, ok := owner.Fields[fieldName]
+			if !ok || i >= len(targetKeys) {
+				return
+			}
+			references[ownerField] = target.Fields[targetKeys[i]]
+			fk.columns = append(fk.columns, columnName(fieldName, ownerField))
+			fk.refColumns = append(fk.refColumns, columnName(targetKeys[i], target.Fields[targetKeys[i]]))
+		}
+		table := p.tableName(owner)
+		fk.name = fmt.Sprintf("fk_%s_%s", table, strings.Join(fk.columns, "_"))
+		if len(fk.columns) == 0 || seen[fk.name] {
+			return
+		}
+		seen[fk.name] = true
+		foreignKeys[table] = append(foreignKeys[table], fk)
+	}
+	var typeNames []string
+	for typeName := range p.ormableTypes {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+	for _, typeName := range typeNames {
+		ormable := p.ormableTypes[typeName]
+		for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+			field := ormable.Fields[fieldName]
+			assoc := p.ormableTypes.GetOrmableByType(field.Type)
+			switch {
+			case assoc == nil:
+			case field.GetHasOne() != nil:
+				add(assoc, field.GetHasOne().GetForeignkey(), ormable, field.GetHasOne().GetAssociationForeignkey())
+			case field.GetHasMany() != nil:
+				add(assoc, field.GetHasMany().GetForeignkey(), ormable, field.GetHasMany().GetAssociationForeignkey())
+			case field.GetBelongsTo() != nil:
+				add(ormable, field.GetBelongsTo().GetForeignkey(), assoc, field.GetBelongsTo().GetAssociationForeignkey())
+			}
+		}
+	}
+	return foreignKeys, references
+}
+
+func isIntegerType(goType string) bool {
+	switch goType {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return true
+	}
+	return false
+}
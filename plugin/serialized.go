@@ -0,0 +1,67 @@
+package plugin
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+
+	gorm "github.com/edhaight/protoc-gen-gorm/options"
+)
+
+// isSerialized reports whether field is stored serialized in a single column.
+func isSerialized(field *protogen.Field) bool {
+	return getFieldOptions(field).GetSerializer() != gorm.Serializer_SERIALIZER_UNSPECIFIED
+}
+
+// parseSerializedField sets the ORM type and column type of a message field
+// stored serialized, it returns false if the field has no serializer set.
+func (p *OrmPlugin) parseSerializedField(field *protogen.Field, opts *gorm.GormFieldOptions) bool {
+	switch opts.GetSerializer() {
+	case gorm.Serializer_SERIALIZER_JSON:
+		field.GoIdent = ptrIdent(p.jsonbIdent())
+		opts.Tag = tagWithType(opts.GetTag(), "jsonb")
+	case gorm.Serializer_SERIALIZER_PROTO:
+		field.GoIdent = protogen.GoIdent{GoName: "[]byte"}
+		opts.Tag = tagWithType(opts.GetTag(), "bytea")
+	default:
+		return false
+	}
+	return true
+}
+
+// generateSerializedFieldConversion outputs the conversion of a message field
+// to and from its protojson encoded jsonb column or its binary encoded bytea
+// column.
+func (p *OrmPlugin) generateSerializedFieldConversion(field *protogen.Field, toORM bool, src, dst string) {
+	if getFieldOptions(field).GetSerializer() != gorm.Serializer_SERIALIZER_PROTO {
+		p.generateProtojsonConversion(field, toORM, src, dst)
+		return
+	}
+	p.P(`if `, src, ` != nil {`)
+	if toORM {
+		p.P(`if `, dst, `, err = `, identProtoMarshal, `(`, src, `); err != nil {`)
+	} else {
+		p.P(dst, ` = &`, p.qualifiedGoIdent(field.Message.GoIdent), `{}`)
+		p.P(`if err = `, identProtoUnmarshal, `(`, src, `, `, dst, `); err != nil {`)
+	}
+	p.P(`return to, err`)
+	p.P(`}`)
+	p.P(`}`)
+}
+
+// generateProtojsonConversion outputs the conversion of a message field to
+// and from its protojson encoded jsonb column.
+func (p *OrmPlugin) generateProtojsonConversion(field *protogen.Field, toORM bool, src, dst string) {
+	p.P(`if `, src, ` != nil {`)
+	if toORM {
+		p.P(`data, err := `, identProtojsonMarshal, `(`, src, `)`)
+		p.P(`if err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.generateJsonbAssignment(dst)
+	} else {
+		p.P(dst, ` = &`, p.qualifiedGoIdent(field.Message.GoIdent), `{}`)
+		p.P(`if err = `, identProtojsonUnmarshal, `(`, p.jsonbData(src), `, `, dst, `); err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+	}
+	p.P(`}`)
+}
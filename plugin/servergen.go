@@ -6,17 +6,24 @@ import (
 	"fmt"
 
 	"github.com/golang/protobuf/protoc-gen-go/generator"
+	"github.com/jinzhu/inflection"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 const (
-	createService    = "Create"
-	readService      = "Read"
-	updateService    = "Update"
-	updateSetService = "UpdateSet"
-	deleteService    = "Delete"
-	deleteSetService = "DeleteSet"
-	listService      = "List"
+	createService      = "Create"
+	createSetService   = "CreateSet"
+	readService        = "Read"
+	updateService      = "Update"
+	updateSetService   = "UpdateSet"
+	upsertService      = "Upsert"
+	deleteService      = "Delete"
+	deleteSetService   = "DeleteSet"
+	listService        = "List"
+	restoreService     = "Restore"
+	hardDeleteService  = "HardDelete"
+	listDeletedService = "ListDeleted"
 )
 
 type autogenService struct {
@@ -40,7 +47,7 @@ type autogenMethod struct {
 }
 
 func (p *OrmPlugin) parseServices(file *protogen.File) {
-	defaultSuppressWarn := p.SuppressWarnings
+	defaultSuppressWarn := p.Quiet
 	for _, service := range file.Services {
 		genSvc := autogenService{
 			Service: service,
@@ -52,13 +59,16 @@ func (p *OrmPlugin) parseServices(file *protogen.File) {
 			genSvc.usesTxnMiddleware = opts.GetTxnMiddleware()
 		}
 		if !genSvc.autogen {
-			p.SuppressWarnings = true
+			p.Quiet = true
 		}
 		for _, method := range service.Methods {
 			inType, outType, methodName := p.getMethodProps(method)
 			var verb, fmName, baseType string
 			var follows bool
-			if strings.HasPrefix(methodName, createService) {
+			if strings.HasPrefix(methodName, createSetService) {
+				verb = createSetService
+				follows, baseType = p.followsCreateSetConventions(inType, outType, createSetService)
+			} else if strings.HasPrefix(methodName, createService) {
 				verb = createService
 				follows, baseType = p.followsCreateConventions(inType, outType, createService)
 			} else if strings.HasPrefix(methodName, readService) {
@@ -70,12 +80,28 @@ func (p *OrmPlugin) parseServices(file *protogen.File) {
 			} else if strings.HasPrefix(methodName, updateService) {
 				verb = updateService
 				follows, baseType, fmName = p.followsUpdateConventions(inType, outType, updateService)
+			} else if strings.HasPrefix(methodName, upsertService) {
+				verb = upsertService
+				follows, baseType = p.followsCreateConventions(inType, outType, upsertService)
+				follows, baseType = p.followsUpsertConventions(follows, baseType, methodName)
 			} else if strings.HasPrefix(methodName, deleteSetService) {
 				verb = deleteSetService
 				follows, baseType = p.followsDeleteSetConventions(inType, outType, method)
 			} else if strings.HasPrefix(methodName, deleteService) {
 				verb = deleteService
 				follows, baseType = p.followsDeleteConventions(inType, outType, method)
+			} else if strings.HasPrefix(methodName, restoreService) {
+				verb = restoreService
+				follows, baseType = p.followsReadConventions(inType, outType, restoreService)
+				follows, baseType = p.followsSoftDeleteConventions(follows, baseType, methodName)
+			} else if strings.HasPrefix(methodName, hardDeleteService) {
+				verb = hardDeleteService
+				follows, baseType = p.followsDeleteConventions(inType, outType, method)
+				follows, baseType = p.followsSoftDeleteConventions(follows, baseType, methodName)
+			} else if strings.HasPrefix(methodName, listDeletedService) {
+				verb = listDeletedService
+				follows, baseType = p.followsListConventions(inType, outType, listDeletedService)
+				follows, baseType = p.followsSoftDeleteConventions(follows, baseType, methodName)
 			} else if strings.HasPrefix(methodName, listService) {
 				verb = listService
 				follows, baseType = p.followsListConventions(inType, outType, listService)
@@ -97,7 +123,7 @@ func (p *OrmPlugin) parseServices(file *protogen.File) {
 			}
 		}
 		p.ormableServices = append(p.ormableServices, genSvc)
-		p.SuppressWarnings = defaultSuppressWarn
+		p.Quiet = defaultSuppressWarn
 	}
 }
 
@@ -108,9 +134,18 @@ func (p *OrmPlugin) generateDefaultServer(file *protogen.File) {
 		}
 		p.P(`type `, service.ccName, `DefaultServer struct {`)
 		if !service.usesTxnMiddleware {
-			p.P(`DB *`, identGormDB)
+			p.P(`DB *`, p.identGormDB)
+		}
+		p.P(`// ErrorTranslator converts the errors returned by the methods, errors.StatusTranslator`)
+		p.P(`// is used when nil`)
+		p.P(`ErrorTranslator `, identErrorsTranslator)
+		if service.hasVerb(createSetService) {
+			p.P(`// BatchSize is the number of objects inserted at once by the CreateSet methods,`)
+			p.P(`// all of them when 0`)
+			p.P(`BatchSize int`)
 		}
 		p.P(`}`)
+		p.generateTranslateErrorMethod(service)
 		withSpan := getServiceOptions(service.Service).WithTracing
 		if withSpan != nil && *withSpan {
 			p.generateSpanInstantiationMethod(service)
@@ -123,18 +158,24 @@ func (p *OrmPlugin) generateDefaultServer(file *protogen.File) {
 			switch method.verb {
 			case createService:
 				p.generateCreateServerMethod(service, method)
+			case createSetService:
+				p.generateCreateSetServerMethod(service, method)
 			case readService:
 				p.generateReadServerMethod(service, method)
 			case updateService:
 				p.generateUpdateServerMethod(service, method)
 			case updateSetService:
 				p.generateUpdateSetServerMethod(service, method)
-			case deleteService:
+			case upsertService:
+				p.generateUpsertServerMethod(service, method)
+			case deleteService, hardDeleteService:
 				p.generateDeleteServerMethod(service, method)
 			case deleteSetService:
 				p.generateDeleteSetServerMethod(service, method)
-			case listService:
+			case listService, listDeletedService:
 				p.generateListServerMethod(service, method)
+			case restoreService:
+				p.generateRestoreServerMethod(service, method)
 			default:
 				p.generateMethodStub(service, method)
 			}
@@ -142,6 +183,18 @@ func (p *OrmPlugin) generateDefaultServer(file *protogen.File) {
 	}
 }
 
+// generateTranslateErrorMethod outputs the method converting the errors
+// returned by the methods of the default server of service.
+func (p *OrmPlugin) generateTranslateErrorMethod(service autogenService) {
+	p.P(`// translateError converts err with the ErrorTranslator of the server`)
+	p.P(`func (m *`, service.ccName, `DefaultServer) translateError(err error) error {`)
+	p.P(`if m.ErrorTranslator == nil {`)
+	p.P(`return `, identStatusTranslator, `.Translate(err)`)
+	p.P(`}`)
+	p.P(`return m.ErrorTranslator.Translate(err)`)
+	p.P(`}`)
+}
+
 func (p *OrmPlugin) generateSpanInstantiationMethod(service autogenService) {
 	// p.UsingGoImports(stdFmtImport)
 	p.P(`// spanInit ...`)
@@ -194,11 +247,11 @@ func (p *OrmPlugin) generateCreateServerMethod(service autogenService, method au
 		p.generatePreserviceCall(service, method.baseType, method.ccName)
 		p.P(`res, err := DefaultCreate`, method.baseType, `(ctx, in.GetPayload(), db)`)
 		p.P(`if err != nil {`)
-		p.P(`return nil, `, p.wrapSpanError(service, "err"))
+		p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
 		p.P(`}`)
 		p.P(`out := &`, method.outType.GoIdent.GoName, `{Result: res}`)
 		if p.Gateway {
-			p.P(`err = `, identGatewaySetCreatedFn, `(ctx, "")`)
+			p.P(`err = `, p.identGatewaySetCreatedFn, `(ctx, "")`)
 			p.P(`if err != nil {`)
 			p.P(`return nil, `, p.wrapSpanError(service, "err"))
 			p.P(`}`)
@@ -215,6 +268,70 @@ func (p *OrmPlugin) generateCreateServerMethod(service autogenService, method au
 	}
 }
 
+// generateCreateSetServerMethod outputs the CreateSet method creating the
+// payloads with DefaultCreate{Type}Set.
+func (p *OrmPlugin) generateCreateSetServerMethod(service autogenService, method autogenMethod) {
+	p.generateMethodSignature(service, method)
+	if method.followsConvention {
+		p.generateDBSetup(service)
+		p.generatePreserviceCall(service, method.baseType, method.ccName)
+		p.P(`res, err := DefaultCreate`, method.baseType, `Set(ctx, in.GetPayloads(), m.BatchSize, db)`)
+		p.P(`if err != nil {`)
+		p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
+		p.P(`}`)
+		p.P(`out := &`, method.outType.GoIdent.GoName, `{Results: res}`)
+		if p.Gateway {
+			p.P(`err = `, p.identGatewaySetCreatedFn, `(ctx, "")`)
+			p.P(`if err != nil {`)
+			p.P(`return nil, `, p.wrapSpanError(service, "err"))
+			p.P(`}`)
+		}
+		p.generatePostserviceCall(service, method.baseType, method.ccName)
+		p.spanResultHandling(service)
+		p.P(`return out, nil`)
+		p.P(`}`)
+		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName)
+		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName)
+	} else {
+		p.generateEmptyBody(service, method.outType)
+	}
+}
+
+// followsCreateSetConventions reports whether the request has a repeated
+// ormable payloads field and the response a results field of the same type.
+func (p *OrmPlugin) followsCreateSetConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string) {
+	vin := conventionFieldValidation{
+		fieldName: "payloads",
+		validate:  func(f *protogen.Field) bool { return f.Desc.IsList() && p.isOrmable(p.fieldType(f)) },
+		cause:     "cannot find repeated ormable payloads field",
+	}
+	vout := conventionFieldValidation{
+		fieldName: "results",
+		validate:  func(f *protogen.Field) bool { return f.Desc.IsList() },
+		cause:     "cannot find repeated results field",
+	}
+	valid, in, out := p.followsConventionsHelper(inType, outType, methodName, vin, vout)
+	if !valid {
+		return valid, ""
+	}
+	if p.fieldType(in) != p.fieldType(out) {
+		p.warning(`stub will be generated for %s since "payloads" field type of %s incoming message doesn't match "results" field type of %s outcoming message`, methodName, inType.GoIdent.GoName, outType.GoIdent.GoName)
+		return false, ""
+	}
+	return valid, p.fieldType(in)
+}
+
+// hasVerb reports whether service has a method of verb following its
+// conventions.
+func (s autogenService) hasVerb(verb string) bool {
+	for _, method := range s.methods {
+		if method.verb == verb && method.followsConvention {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *OrmPlugin) generateReadServerMethod(service autogenService, method autogenMethod) {
 	p.generateMethodSignature(service, method)
 	if method.followsConvention {
@@ -222,12 +339,34 @@ func (p *OrmPlugin) generateReadServerMethod(service autogenService, method auto
 		p.generatePreserviceCall(service, method.baseType, method.ccName)
 		typeName := method.baseType
 		if fields := p.getFieldSelection(method.inType); fields != "" {
-			p.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db, in.`, fields, `)`)
+			p.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{`, p.primaryKeyLiteral(p.getOrmable(typeName).Message), `}, db, in.`, fields, `)`)
 		} else {
-			p.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db)`)
+			p.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{`, p.primaryKeyLiteral(p.getOrmable(typeName).Message), `}, db)`)
 		}
 		p.P(`if err != nil {`)
-		p.P(`return nil, `, p.wrapSpanError(service, "err"))
+		p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
+		p.P(`}`)
+		p.P(`out := &`, method.outType.GoIdent.GoName, `{Result: res}`)
+		p.generatePostserviceCall(service, method.baseType, method.ccName)
+		p.spanResultHandling(service)
+		p.P(`return out, nil`)
+		p.P(`}`)
+		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName)
+		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName)
+	} else {
+		p.generateEmptyBody(service, method.outType)
+	}
+}
+
+func (p *OrmPlugin) generateRestoreServerMethod(service autogenService, method autogenMethod) {
+	p.generateMethodSignature(service, method)
+	if method.followsConvention {
+		p.generateDBSetup(service)
+		p.generatePreserviceCall(service, method.baseType, method.ccName)
+		typeName := method.baseType
+		p.P(`res, err := DefaultRestore`, typeName, `(ctx, &`, typeName, `{`, p.primaryKeyLiteral(p.getOrmable(typeName).Message), `}, db)`)
+		p.P(`if err != nil {`)
+		p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
 		p.P(`}`)
 		p.P(`out := &`, method.outType.GoIdent.GoName, `{Result: res}`)
 		p.generatePostserviceCall(service, method.baseType, method.ccName)
@@ -298,28 +437,106 @@ func (p *OrmPlugin) followsCreateConventions(inType *protogen.Message, outType *
 }
 
 func (p *OrmPlugin) followsReadConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string) {
-	vin := conventionFieldValidation{
-		fieldName: "id",
-		validate:  func(f *protogen.Field) bool { return true },
-		cause:     "cannot find id field",
-	}
-	vout := conventionFieldValidation{
-		fieldName: "result",
-		validate:  func(f *protogen.Field) bool { return p.isOrmable(p.fieldType(f)) },
-		cause:     "cannot find ormable result field",
+	var out *protogen.Field
+	for _, field := range outType.Fields {
+		if field.Desc.Name() == "result" && p.isOrmable(p.fieldType(field)) {
+			out = field
+		}
 	}
-	valid, _, out := p.followsConventionsHelper(inType, outType, methodName, vin, vout)
-	if !valid {
-		return valid, ""
+	if out == nil {
+		p.warning(`stub will be generated for %s: output message %s validation failure: %s`, methodName, outType.GoIdent.GoName, "cannot find ormable result field")
+		return false, ""
 	}
 	outFieldType := p.fieldType(out)
-	if !p.hasPrimaryKey(p.getOrmable(outFieldType)) {
-		p.warning(`stub will be generated for %s since %s ormable type doesn't have a primary key`, methodName, out)
+	if !p.followsPrimaryKeyConventions(inType, outFieldType, methodName) {
 		return false, ""
 	}
 	return true, outFieldType
 }
 
+// followsPrimaryKeyConventions checks that the request inType of a method
+// finding a single row of typeName has the fields of its primary key, with
+// the same names and types as in the message of typeName.
+func (p *OrmPlugin) followsPrimaryKeyConventions(inType *protogen.Message, typeName string, methodName string) bool {
+	ormable := p.getOrmable(typeName)
+	if !p.hasPrimaryKey(ormable) {
+		p.warning(`stub will be generated for %s since %s ormable type doesn't have a primary key`, methodName, typeName)
+		return false
+	}
+	if !p.hasMessagePrimaryKey(ormable.Message) {
+		p.warning(`stub will be generated for %s since the primary key of %s isn't a field of its message`, methodName, typeName)
+		return false
+	}
+	if name := p.missingPrimaryKeyField(inType, ormable); name != "" {
+		p.warning(`stub will be generated for %s since %s incoming message doesn't have %q field`, methodName, inType.GoIdent.GoName, name)
+		return false
+	}
+	return true
+}
+
+// missingPrimaryKeyField returns the name of the first field of the primary
+// key of ormable the request inType lacks a field of the same name and type
+// of, or "" when it has them all.
+func (p *OrmPlugin) missingPrimaryKeyField(inType *protogen.Message, ormable *OrmableType) string {
+	for _, pkName := range p.primaryKeyNames(ormable) {
+		pkField := messageField(ormable.Message, pkName)
+		inField := messageFieldOfName(inType, pkField.Desc.Name())
+		if inField == nil || !sameFieldType(inField, pkField, false) {
+			return string(pkField.Desc.Name())
+		}
+	}
+	return ""
+}
+
+// getPrimaryKeysField returns the repeated field of the request inType
+// listing the primary keys of the single field primary key of ormable, named
+// ids or the plural of the name of the primary key field, e.g. skus.
+func (p *OrmPlugin) getPrimaryKeysField(inType *protogen.Message, ormable *OrmableType) *protogen.Field {
+	pkField := messageField(ormable.Message, p.primaryKeyNames(ormable)[0])
+	names := map[protoreflect.Name]bool{"ids": true, protoreflect.Name(inflection.Plural(string(pkField.Desc.Name()))): true}
+	for _, field := range inType.Fields {
+		if names[field.Desc.Name()] && sameFieldType(field, pkField, true) {
+			return field
+		}
+	}
+	return nil
+}
+
+// messageField returns the field of message named goName, or nil.
+func messageField(message *protogen.Message, goName string) *protogen.Field {
+	for _, field := range message.Fields {
+		if fieldName(field) == goName {
+			return field
+		}
+	}
+	return nil
+}
+
+// messageFieldOfName returns the field of message of the proto name, or nil.
+func messageFieldOfName(message *protogen.Message, name protoreflect.Name) *protogen.Field {
+	for _, field := range message.Fields {
+		if field.Desc.Name() == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// sameFieldType reports whether the field holds the values of the singular
+// field of, repeated when list.
+func sameFieldType(field, of *protogen.Field, list bool) bool {
+	if field.Desc.IsList() != list || field.Desc.IsMap() || field.Desc.Kind() != of.Desc.Kind() {
+		return false
+	}
+	switch {
+	case field.Message != nil:
+		return of.Message != nil && field.Message.Desc.FullName() == of.Message.Desc.FullName()
+	case field.Enum != nil:
+		return of.Enum != nil && field.Enum.Desc.FullName() == of.Enum.Desc.FullName()
+	}
+	return true
+}
+
 func (p *OrmPlugin) followsUpdateConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string, string) {
 	var inTypeName string
 	var typeOrmable bool
@@ -382,7 +599,30 @@ func (p *OrmPlugin) generateUpdateServerMethod(service autogenService, method au
 			p.P(`res, err = DefaultStrictUpdate`, typeName, `(ctx, in.GetPayload(), db)`)
 		}
 		p.P(`if err != nil {`)
-		p.P(`return nil, `, p.wrapSpanError(service, "err"))
+		p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
+		p.P(`}`)
+		p.P(`out := &`, method.outType.GoIdent.GoName, `{Result: res}`)
+		p.generatePostserviceCall(service, method.baseType, method.ccName)
+		p.spanResultHandling(service)
+		p.P(`return out, nil`)
+		p.P(`}`)
+		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName)
+		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName)
+	} else {
+		p.generateEmptyBody(service, method.outType)
+	}
+}
+
+// generateUpsertServerMethod outputs the Upsert method inserting or updating
+// the payload with DefaultUpsert{Type}.
+func (p *OrmPlugin) generateUpsertServerMethod(service autogenService, method autogenMethod) {
+	p.generateMethodSignature(service, method)
+	if method.followsConvention {
+		p.generateDBSetup(service)
+		p.generatePreserviceCall(service, method.baseType, method.ccName)
+		p.P(`res, err := DefaultUpsert`, method.baseType, `(ctx, in.GetPayload(), db)`)
+		p.P(`if err != nil {`)
+		p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
 		p.P(`}`)
 		p.P(`out := &`, method.outType.GoIdent.GoName, `{Result: res}`)
 		p.generatePostserviceCall(service, method.baseType, method.ccName)
@@ -396,13 +636,26 @@ func (p *OrmPlugin) generateUpdateServerMethod(service autogenService, method au
 	}
 }
 
+// followsUpsertConventions checks that the ormable type of a method following
+// the Upsert conventions has an upsert handler.
+func (p *OrmPlugin) followsUpsertConventions(follows bool, typeName string, methodName string) (bool, string) {
+	if !follows {
+		return false, ""
+	}
+	if !p.hasUpsert(p.getOrmable(typeName)) {
+		p.warning(`stub will be generated for %s since %s ormable type has no primary key or is multi account`, methodName, typeName)
+		return false, ""
+	}
+	return true, typeName
+}
+
 func (p *OrmPlugin) generateUpdateSetServerMethod(service autogenService, method autogenMethod) {
 	p.generateMethodSignature(service, method)
 	if method.followsConvention {
 		typeName := method.baseType
 		typeName = strings.TrimPrefix(typeName, "[]*")
 		p.P(`if in == nil {`)
-		p.P(`return nil,`, identNilArgumentError)
+		p.P(`return nil, m.translateError(`, identNilArgumentError, `)`)
 		p.P(`}`)
 		p.P(``)
 		p.generateDBSetup(service)
@@ -412,7 +665,7 @@ func (p *OrmPlugin) generateUpdateSetServerMethod(service autogenService, method
 		p.P(``)
 		p.P(`res, err := DefaultPatchSet`, typeName, `(ctx, in.GetObjects(), in.Get`, method.fieldMaskName, `(), db)`)
 		p.P(`if err != nil {`)
-		p.P(`return nil, `, p.wrapSpanError(service, "err"))
+		p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
 		p.P(`}`)
 		p.P(``)
 		p.P(`out := &`, method.outType.GoIdent.GoName, `{Results: res}`)
@@ -500,9 +753,9 @@ func (p *OrmPlugin) generateDeleteServerMethod(service autogenService, method au
 		typeName := method.baseType
 		p.generateDBSetup(service)
 		p.generatePreserviceCall(service, method.baseType, method.ccName)
-		p.P(`err := DefaultDelete`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db)`)
+		p.P(`err := Default`, method.verb, typeName, `(ctx, &`, typeName, `{`, p.primaryKeyLiteral(p.getOrmable(typeName).Message), `}, db)`)
 		p.P(`if err != nil {`)
-		p.P(`return nil, `, p.wrapSpanError(service, "err"))
+		p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
 		p.P(`}`)
 		p.P(`out := &`, method.outType.GoIdent.GoName, `{}`)
 		p.generatePostserviceCall(service, method.baseType, method.ccName)
@@ -518,16 +771,6 @@ func (p *OrmPlugin) generateDeleteServerMethod(service autogenService, method au
 
 func (p *OrmPlugin) followsDeleteConventions(inType *protogen.Message, outType *protogen.Message, method *protogen.Method) (bool, string) {
 	methodName := method.GoName
-	var hasID bool
-	for _, field := range inType.Fields {
-		if field.Desc.Name() == "id" {
-			hasID = true
-		}
-	}
-	if !hasID {
-		p.warning(`stub will be generated for %s since %s incoming message doesn't have "id" field`, methodName, inType.GoIdent.GoName)
-		return false, ""
-	}
 	typeName := generator.CamelCase(getMethodOptions(method).GetObjectType())
 	if typeName == "" {
 		p.warning(`stub will be generated for %s since (gorm.method).object_type option is not specified`, methodName)
@@ -537,8 +780,20 @@ func (p *OrmPlugin) followsDeleteConventions(inType *protogen.Message, outType *
 		p.warning(`stub will be generated for %s since %s is not an ormable type`, methodName, typeName)
 		return false, ""
 	}
-	if !p.hasPrimaryKey(p.getOrmable(typeName)) {
-		p.warning(`stub will be generated for %s since %s ormable type doesn't have a primary key`, methodName, typeName)
+	if !p.followsPrimaryKeyConventions(inType, typeName, methodName) {
+		return false, ""
+	}
+	return true, typeName
+}
+
+// followsSoftDeleteConventions checks that the ormable type of a method
+// following the conventions of a soft delete verb has soft delete enabled.
+func (p *OrmPlugin) followsSoftDeleteConventions(follows bool, typeName string, methodName string) (bool, string) {
+	if !follows {
+		return false, ""
+	}
+	if !getMessageOptions(p.getOrmable(typeName).Message).GetSoftDelete() {
+		p.warning(`stub will be generated for %s since %s ormable type doesn't have soft delete enabled`, methodName, typeName)
 		return false, ""
 	}
 	return true, typeName
@@ -549,14 +804,15 @@ func (p *OrmPlugin) generateDeleteSetServerMethod(service autogenService, method
 	if method.followsConvention {
 		typeName := method.baseType
 		p.generateDBSetup(service)
+		ormable := p.getOrmable(typeName)
 		p.P(`objs := []*`, typeName, `{}`)
-		p.P(`for _, id := range in.Ids {`)
-		p.P(`objs = append(objs, &`, typeName, `{Id: id})`)
+		p.P(`for _, id := range in.`, p.getPrimaryKeysField(method.inType, ormable).GoName, ` {`)
+		p.P(`objs = append(objs, &`, typeName, `{`, p.primaryKeyNames(ormable)[0], `: id})`)
 		p.P(`}`)
 		p.generatePreserviceCall(service, method.baseType, method.ccName)
 		p.P(`err := DefaultDelete`, typeName, `Set(ctx, objs, db)`)
 		p.P(`if err != nil {`)
-		p.P(`return nil, `, p.wrapSpanError(service, "err"))
+		p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
 		p.P(`}`)
 		p.P(`out := &`, method.outType.GoIdent.GoName, `{}`)
 		p.generatePostserviceCall(service, method.baseType, method.ccName)
@@ -572,16 +828,6 @@ func (p *OrmPlugin) generateDeleteSetServerMethod(service autogenService, method
 
 func (p *OrmPlugin) followsDeleteSetConventions(inType *protogen.Message, outType *protogen.Message, method *protogen.Method) (bool, string) {
 	methodName := method.GoName
-	var hasIDs bool
-	for _, field := range inType.Fields {
-		if field.Desc.Name() == "ids" && field.Desc.IsList() {
-			hasIDs = true
-		}
-	}
-	if !hasIDs {
-		p.warning(`stub will be generated for %s since %s incoming message doesn't have "ids" field`, methodName, inType.GoIdent.GoName)
-		return false, ""
-	}
 	typeName := generator.CamelCase(getMethodOptions(method).GetObjectType())
 	if typeName == "" {
 		p.warning(`stub will be generated for %s since (gorm.method).object_type option is not specified`, methodName)
@@ -591,10 +837,25 @@ func (p *OrmPlugin) followsDeleteSetConventions(inType *protogen.Message, outTyp
 		p.warning(`stub will be generated for %s since %s is not an ormable type`, methodName, typeName)
 		return false, ""
 	}
-	if !p.hasPrimaryKey(p.getOrmable(typeName)) {
+	ormable := p.getOrmable(typeName)
+	if !p.hasPrimaryKey(ormable) {
 		p.warning(`stub will be generated for %s since %s ormable type doesn't have a primary key`, methodName, typeName)
 		return false, ""
 	}
+	if p.hasCompositeKey(ormable) {
+		p.warning(`stub will be generated for %s since the composite primary key of %s can't be listed in a repeated field`, methodName, typeName)
+		return false, ""
+	}
+	if !p.hasMessagePrimaryKey(ormable.Message) {
+		p.warning(`stub will be generated for %s since the primary key of %s isn't a field of its message`, methodName, typeName)
+		return false, ""
+	}
+	if p.getPrimaryKeysField(inType, ormable) == nil {
+		pkField := messageField(ormable.Message, p.primaryKeyNames(ormable)[0])
+		p.warning(`stub will be generated for %s since %s incoming message doesn't have "ids" or %q field`, methodName,
+			inType.GoIdent.GoName, inflection.Plural(string(pkField.Desc.Name())))
+		return false, ""
+	}
 	return true, typeName
 }
 
@@ -603,17 +864,30 @@ func (p *OrmPlugin) generateListServerMethod(service autogenService, method auto
 	if method.followsConvention {
 		p.generateDBSetup(service)
 		p.generatePreserviceCall(service, method.baseType, method.ccName)
-		pg := p.getPagination(method.inType)
-		pi := p.getPageInfo(method.outType)
+		ormable := p.getOrmable(method.baseType)
+		keyset := p.listHasKeyset(ormable, method.verb)
+		var pg, pi, npt string
+		if keyset {
+			npt = p.getNextPageToken(method.outType)
+		} else {
+			pg = p.getPagination(method.inType)
+			pi = p.getPageInfo(method.outType)
+		}
 		if pg != "" && pi != "" {
 			p.generatePagedRequestSetup(pg)
 		}
-		handlerCall := fmt.Sprint(`res, err := DefaultList`, method.baseType, `(ctx, db`)
-		if f := p.getFiltering(method.inType); f != "" {
-			handlerCall += fmt.Sprint(",in.", f)
+		f, s := p.generateListQueryParsing(service, method)
+		handlerCall := fmt.Sprint(`res, err := Default`, method.verb, method.baseType, `(ctx, db`)
+		if keyset && npt != "" {
+			handlerCall = fmt.Sprint(`res, nextPageToken, err := Default`, method.verb, method.baseType, `(ctx, db`)
+		} else if keyset {
+			handlerCall = fmt.Sprint(`res, _, err := Default`, method.verb, method.baseType, `(ctx, db`)
+		}
+		if f != "" {
+			handlerCall += fmt.Sprint(",", f)
 		}
-		if s := p.getSorting(method.inType); s != "" {
-			handlerCall += fmt.Sprint(",in.", s)
+		if s != "" {
+			handlerCall += fmt.Sprint(",", s)
 		}
 		if pg != "" {
 			handlerCall += fmt.Sprint(",in.", pg)
@@ -621,17 +895,39 @@ func (p *OrmPlugin) generateListServerMethod(service autogenService, method auto
 		if fs := p.getFieldSelection(method.inType); fs != "" {
 			handlerCall += fmt.Sprint(",in.", fs)
 		}
+		if keyset {
+			handlerCall += fmt.Sprint(",in.Get", p.getPageToken(method.inType), "()")
+			if ps := p.getPageSize(method.inType); ps != "" {
+				handlerCall += fmt.Sprint(",int32(in.Get", ps, "())")
+			} else {
+				handlerCall += ",0"
+			}
+		}
 		handlerCall += ")"
 		p.P(handlerCall)
 		p.P(`if err != nil {`)
-		p.P(`return nil, `, p.wrapSpanError(service, "err"))
+		p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
 		p.P(`}`)
-		var pageInfoIfExist string
+		var outFields string
 		if pg != "" && pi != "" {
 			p.generatePagedRequestHandling(pg)
-			pageInfoIfExist = ", " + pi + ": resPaging"
+			outFields = ", " + pi + ": resPaging"
+		}
+		if npt != "" {
+			outFields = ", " + npt + ": nextPageToken"
+		}
+		if p.listHasCount(ormable, method.verb) {
+			if total, totalType := p.getTotalSize(method.outType); total != "" {
+				p.generateCountServerCall(service, method, f)
+				outFields += ", " + total + ": " + totalType + "(count)"
+			} else {
+				p.P(`if resPaging != nil {`)
+				p.generateCountServerCall(service, method, f)
+				p.P(`resPaging.Size = int32(count)`)
+				p.P(`}`)
+			}
 		}
-		p.P(`out := &`, method.outType.GoIdent.GoName, `{Results: res`, pageInfoIfExist, ` }`)
+		p.P(`out := &`, method.outType.GoIdent.GoName, `{Results: res`, outFields, ` }`)
 		p.generatePostserviceCall(service, method.baseType, method.ccName)
 		p.spanResultHandling(service)
 		p.P(`return out, nil`)
@@ -684,7 +980,7 @@ func (p *OrmPlugin) generateMethodSignature(service autogenService, method autog
 
 func (p *OrmPlugin) generateDBSetup(service autogenService) error {
 	if service.usesTxnMiddleware {
-		p.P(`txn, ok := `, p.identFnCall(identTkFromContextFn, "ctx"))
+		p.P(`txn, ok := `, p.identFnCall(p.identTkFromContextFn, "ctx"))
 		p.P(`if !ok {`)
 		p.P(`return nil, `, identNoTransactionError)
 		p.P(`}`)
@@ -726,7 +1022,7 @@ func (p *OrmPlugin) generatePreserviceCall(service autogenService, typeName, mth
 	p.P(`if custom, ok := interface{}(in).(`, service.ccName, typeName, `WithBefore`, mthd, `); ok {`)
 	p.P(`var err error`)
 	p.P(`if db, err = custom.Before`, mthd, `(ctx, db); err != nil {`)
-	p.P(`return nil, `, p.wrapSpanError(service, "err"))
+	p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
 	p.P(`}`)
 	p.P(`}`)
 }
@@ -740,7 +1036,7 @@ func (p *OrmPlugin) generatePagedRequestSetup(pg string) {
 }
 
 func (p *OrmPlugin) generatePagedRequestHandling(pg string) {
-	p.P(`var resPaging `, p.qualifiedGoIdentPtr(identQueryPageInfo))
+	p.P(`var resPaging `, p.qualifiedGoIdentPtr(p.identQueryPageInfo))
 	p.P(`if pagedRequest {`)
 	p.P(`var offset int32`)
 	p.P(`var size int32 = int32(len(res))`)
@@ -749,14 +1045,14 @@ func (p *OrmPlugin) generatePagedRequestHandling(pg string) {
 	p.P(`res=res[:size]`)
 	p.P(fmt.Sprintf(`offset=in.Get%s().GetOffset()+size`, pg))
 	p.P(`}`)
-	p.P(`resPaging = &`, identQueryPageInfo, `{Offset: offset}`)
+	p.P(`resPaging = &`, p.identQueryPageInfo, `{Offset: offset}`)
 	p.P(`}`)
 }
 
 func (p *OrmPlugin) generatePreserviceHook(svc, typeName, mthd string) {
 	p.P(`// `, svc, typeName, `WithBefore`, mthd, ` called before Default`, mthd, typeName, ` in the default `, mthd, ` handler`)
 	p.P(`type `, svc, typeName, `WithBefore`, mthd, ` interface {`)
-	p.P(`Before`, mthd, `(`, identCtx, `, *`, identGormDB, `) (*`, identGormDB, `, error)`)
+	p.P(`Before`, mthd, `(`, identCtx, `, *`, p.identGormDB, `) (*`, p.identGormDB, `, error)`)
 	p.P(`}`)
 }
 
@@ -764,7 +1060,7 @@ func (p *OrmPlugin) generatePostserviceCall(service autogenService, typeName, mt
 	p.P(`if custom, ok := interface{}(in).(`, service.ccName, typeName, `WithAfter`, mthd, `); ok {`)
 	p.P(`var err error`)
 	p.P(`if err = custom.After`, mthd, `(ctx, out, db); err != nil {`)
-	p.P(`return nil, `, p.wrapSpanError(service, "err"))
+	p.P(`return nil, `, p.wrapSpanError(service, "m.translateError(err)"))
 	p.P(`}`)
 	p.P(`}`)
 }
@@ -772,7 +1068,7 @@ func (p *OrmPlugin) generatePostserviceCall(service autogenService, typeName, mt
 func (p *OrmPlugin) generatePostserviceHook(svc, typeName, outTypeName, mthd string) {
 	p.P(`// `, svc, typeName, `WithAfter`, mthd, ` called before Default`, mthd, typeName, ` in the default `, mthd, ` handler`)
 	p.P(`type `, svc, typeName, `WithAfter`, mthd, ` interface {`)
-	p.P(`After`, mthd, `(`, identCtx, `, *`, outTypeName, `, *`, identGormDB, `) error`)
+	p.P(`After`, mthd, `(`, identCtx, `, *`, outTypeName, `, *`, p.identGormDB, `) error`)
 	p.P(`}`)
 }
 
@@ -43,8 +43,55 @@ func (t *tagString) format(key string) string {
 	return fmt.Sprintf("%s:\"%s\"", key, strings.TrimRight(string(*t), ";"))
 }
 
+// gormTagKeys holds the struct tag keys that are spelled differently by the
+// supported ORM targets.
+type gormTagKeys struct {
+	primaryKey                     string
+	autoIncrement                  string
+	uniqueIndex                    string
+	embeddedPrefix                 string
+	foreignKey                     string
+	associationForeignKey          string
+	joinTableForeignKey            string
+	associationJoinTableForeignKey string
+	// associationSettings is false when the target has no tag equivalent
+	// for the association_* and preload settings.
+	associationSettings bool
+}
+
+var jinzhuTagKeys = gormTagKeys{
+	primaryKey:                     "primary_key",
+	autoIncrement:                  "auto_increment",
+	uniqueIndex:                    "unique_index",
+	embeddedPrefix:                 "embedded_prefix",
+	foreignKey:                     "foreignkey",
+	associationForeignKey:          "association_foreignkey",
+	joinTableForeignKey:            "jointable_foreignkey",
+	associationJoinTableForeignKey: "association_jointable_foreignkey",
+	associationSettings:            true,
+}
+
+var gormV2TagKeys = gormTagKeys{
+	primaryKey:                     "primaryKey",
+	autoIncrement:                  "autoIncrement",
+	uniqueIndex:                    "uniqueIndex",
+	embeddedPrefix:                 "embeddedPrefix",
+	foreignKey:                     "foreignKey",
+	associationForeignKey:          "references",
+	joinTableForeignKey:            "joinForeignKey",
+	associationJoinTableForeignKey: "joinReferences",
+}
+
+func (p *OrmPlugin) tagKeys() gormTagKeys {
+	if p.isGormV2() {
+		return gormV2TagKeys
+	}
+	return jinzhuTagKeys
+}
+
 func (p *OrmPlugin) renderGormTag(field *Field) string {
 	var gormRes, atlasRes tagString
+	keys := p.tagKeys()
 	tag := field.GetTag()
 	if tag == nil {
 		tag = &gorm.GormTag{}
@@ -56,18 +103,18 @@ func (p *OrmPlugin) renderGormTag(field *Field) string {
 	gormRes.checkAndSetInt32(tag.Size, "size")
 	gormRes.checkAndSetInt32(tag.Precision, "precision")
 
-	gormRes.checkAndSetBool(tag.PrimaryKey, "primary_key", false)
+	gormRes.checkAndSetBool(tag.PrimaryKey, keys.primaryKey, false)
 	gormRes.checkAndSetBool(tag.Unique, "unique", false)
 
 	gormRes.checkAndSetString(tag.Default, "default", false)
 	gormRes.checkAndSetBool(tag.NotNull, "not null", false)
-	gormRes.checkAndSetBool(tag.AutoIncrement, "auto_increment", false)
+	gormRes.checkAndSetBool(tag.AutoIncrement, keys.autoIncrement, false)
 
 	gormRes.checkAndSetString(tag.Index, "index", true)
-	gormRes.checkAndSetString(tag.UniqueIndex, "unique_index", true)
+	gormRes.checkAndSetString(tag.UniqueIndex, keys.uniqueIndex, true)
 
 	gormRes.checkAndSetBool(tag.Embedded, "embedded", false)
-	gormRes.checkAndSetString(tag.EmbeddedPrefix, "embedded_prefix", false)
+	gormRes.checkAndSetString(tag.EmbeddedPrefix, keys.embeddedPrefix, false)
 	gormRes.checkAndSetBool(tag.Ignore, "-", false)
 
 	var foreignKey, associationForeignKey, joinTable, joinTableForeignKey, associationJoinTableForeignKey *string
@@ -125,18 +172,20 @@ func (p *OrmPlugin) renderGormTag(field *Field) string {
 		preload = tag.Preload
 	}
 
-	gormRes.checkAndSetString(foreignKey, "foreignkey", false)
-	gormRes.checkAndSetString(associationForeignKey, "association_foreignkey", false)
+	gormRes.checkAndSetString(foreignKey, keys.foreignKey, false)
+	gormRes.checkAndSetString(associationForeignKey, keys.associationForeignKey, false)
 	gormRes.checkAndSetString(joinTable, "many2many", false)
-	gormRes.checkAndSetString(joinTableForeignKey, "jointable_foreignkey", false)
-	gormRes.checkAndSetString(associationJoinTableForeignKey, "association_jointable_foreignkey", false)
-	gormRes.checkAndSetBool(associationAutoupdate, "association_autoupdate", true)
-	gormRes.checkAndSetBool(associationAutocreate, "association_autocreate", true)
-	gormRes.checkAndSetBool(associationSaveReference, "association_save_reference", true)
-	gormRes.checkAndSetBool(preload, "preload", true)
-	gormRes.checkAndSetBool(clear, "clear", true)
-	gormRes.checkAndSetBool(replace, "replace", true)
-	gormRes.checkAndSetBool(append, "append", true)
+	gormRes.checkAndSetString(joinTableForeignKey, keys.joinTableForeignKey, false)
+	gormRes.checkAndSetString(associationJoinTableForeignKey, keys.associationJoinTableForeignKey, false)
+	if keys.associationSettings {
+		gormRes.checkAndSetBool(associationAutoupdate, "association_autoupdate", true)
+		gormRes.checkAndSetBool(associationAutocreate, "association_autocreate", true)
+		gormRes.checkAndSetBool(associationSaveReference, "association_save_reference", true)
+		gormRes.checkAndSetBool(preload, "preload", true)
+		gormRes.checkAndSetBool(clear, "clear", true)
+		gormRes.checkAndSetBool(replace, "replace", true)
+		gormRes.checkAndSetBool(append, "append", true)
+	}
 
 	finalTag := strings.TrimSpace(strings.Join([]string{gormRes.format("gorm"), atlasRes.format("atlas")}, " "))
 	if finalTag == "" {
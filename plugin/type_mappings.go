@@ -0,0 +1,94 @@
+package plugin
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	gorm "github.com/edhaight/protoc-gen-gorm/options"
+)
+
+// parseTypeMappings collects the file level type mappings of all the files
+// of the protoc invocation, by message full name.
+func (p *OrmPlugin) parseTypeMappings(files []*protogen.File) map[string]*gorm.TypeMapping {
+	mappings := make(map[string]*gorm.TypeMapping)
+	for _, file := range files {
+		for _, mapping := range getFileOptions(file).GetTypeMapping() {
+			name := mapping.GetMessage()
+			if name == "" {
+				p.Fail("Type mapping to", mapping.GetGoType(), "in", file.Desc.Path(), "has no message set.")
+			}
+			p.validateTypeMapping(name, mapping)
+			if prev, ok := mappings[name]; ok && !proto.Equal(prev, mapping) {
+				p.Fail("Conflicting type mappings for", name, "in", file.Desc.Path())
+			}
+			mappings[name] = mapping
+		}
+	}
+	return mappings
+}
+
+func (p *OrmPlugin) validateTypeMapping(name string, mapping *gorm.TypeMapping) {
+	if mapping.GetGoType() == "" || mapping.GetToOrm() == "" || mapping.GetToPb() == "" {
+		p.Fail("Type mapping of", name, "needs go_type, to_orm and to_pb to be set.")
+	}
+}
+
+// getTypeMapping returns the type mapping field is stored with, if any.
+func (p *OrmPlugin) getTypeMapping(field *protogen.Field) *gorm.TypeMapping {
+	if field.Desc.Message() == nil || field.Desc.IsList() || field.Desc.IsMap() {
+		return nil
+	}
+	name := string(field.Desc.Message().FullName())
+	if mapping := getFieldOptions(field).GetTypeMapping(); mapping != nil {
+		if mapping.GetMessage() != "" && mapping.GetMessage() != name {
+			p.Fail("Type mapping of field", field.GoName, "of", field.Parent.GoIdent.GoName, "is declared for", mapping.GetMessage(), "not", name)
+		}
+		p.validateTypeMapping(name, mapping)
+		return mapping
+	}
+	return p.typeMappings[name]
+}
+
+// typeMappingIdent returns the ident of the Go type of a type mapping, or of
+// one of its conversion functions.
+func typeMappingIdent(mapping *gorm.TypeMapping, name string) protogen.GoIdent {
+	if _, ok := builtinTypes[strings.TrimLeft(name, "*[]")]; ok {
+		return protogen.GoIdent{GoName: name}
+	}
+	return protogen.GoIdent{GoName: name, GoImportPath: protogen.GoImportPath(mapping.GetPackage())}
+}
+
+// parseMappedField sets the ORM type and column type of a field stored with
+// a type mapping.
+func (p *OrmPlugin) parseMappedField(field *protogen.Field, mapping *gorm.TypeMapping, opts *gorm.GormFieldOptions) {
+	field.GoIdent = typeMappingIdent(mapping, mapping.GetGoType())
+	if mapping.SqlType != nil && opts.GetTag().GetType() == "" {
+		opts.Tag = tagWithType(opts.GetTag(), mapping.GetSqlType())
+	}
+}
+
+// generateMappedFieldConversion outputs the conversion of a field stored with
+// a type mapping, calling its conversion functions.
+func (p *OrmPlugin) generateMappedFieldConversion(mapping *gorm.TypeMapping, toORM bool, src, dst string) {
+	if toORM {
+		p.P(`if `, src, ` != nil {`)
+		p.P(`if `, dst, `, err = `, p.qualifiedGoIdent(typeMappingIdent(mapping, mapping.GetToOrm())), `(`, src, `); err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`}`)
+		return
+	}
+	nillable := strings.HasPrefix(mapping.GetGoType(), "*") || strings.HasPrefix(mapping.GetGoType(), "[]") ||
+		strings.HasPrefix(mapping.GetGoType(), "map[")
+	if nillable {
+		p.P(`if `, src, ` != nil {`)
+	}
+	p.P(`if `, dst, `, err = `, p.qualifiedGoIdent(typeMappingIdent(mapping, mapping.GetToPb())), `(`, src, `); err != nil {`)
+	p.P(`return to, err`)
+	p.P(`}`)
+	if nillable {
+		p.P(`}`)
+	}
+}
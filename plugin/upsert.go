@@ -0,0 +1,274 @@
+package plugin
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// hasUpsert reports whether the upsert handler of ormable is generated. The
+// multi account types have none, as the upsert would overwrite the
+// conflicting rows of the other accounts, nor have the types without an
+// upsert_index whose primary key isn't in the message, as it is never set.
+func (p *OrmPlugin) hasUpsert(ormable *OrmableType) bool {
+	if getMessageOptions(ormable.Message).GetUpsertIndex() == "" && !p.hasMessagePrimaryKey(ormable.Message) {
+		return false
+	}
+	return p.hasPrimaryKey(ormable) && !getMessageOptions(ormable.Message).GetMultiAccount()
+}
+
+// upsertConflictFields returns the names of the fields of the conflict target
+// of the upserts of ormable: the ones of the unique index named by the
+// upsert_index option, or else the primary key.
+func (p *OrmPlugin) upsertConflictFields(ormable *OrmableType) []string {
+	index := getMessageOptions(ormable.Message).GetUpsertIndex()
+	if index == "" {
+		return p.primaryKeyNames(ormable)
+	}
+	var fieldNames []string
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		if tag := ormable.Fields[fieldName].GetTag(); tag != nil && tag.UniqueIndex != nil && tag.GetUniqueIndex() == index {
+			fieldNames = append(fieldNames, fieldName)
+		}
+	}
+	if len(fieldNames) == 0 {
+		p.Fail("Upsert index", index, "of", ormable.OriginName, "is not the unique_index of any of its fields.")
+	}
+	return fieldNames
+}
+
+// upsertUpdatedColumns returns the columns of ormable overwritten when the
+// upsert updates the conflicting row. The primary key, the conflict target,
+// the creation audit columns, the version and the deletion time are kept.
+func (p *OrmPlugin) upsertUpdatedColumns(ormable *OrmableType, conflict []string) []string {
+	kept := map[string]bool{ormable.version: true, "DeletedAt": true}
+	for _, pkName := range p.primaryKeyNames(ormable) {
+		kept[pkName] = true
+	}
+	for _, fieldName := range conflict {
+		kept[fieldName] = true
+	}
+	if hasAudit(ormable) {
+		kept["CreatedAt"], kept["CreatedBy"] = true, true
+	}
+	var columns []string
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		if field := ormable.Fields[fieldName]; !kept[fieldName] && isQueryColumn(field) {
+			columns = append(columns, columnName(fieldName, field))
+		}
+	}
+	return columns
+}
+
+// generateUpsertHandler outputs the handler inserting an object or updating
+// the row it conflicts with in a single statement, and its hooks.
+func (p *OrmPlugin) generateUpsertHandler(message *protogen.Message) {
+	typeName := p.messageType(message)
+	ormable := p.getOrmable(typeName)
+	if !p.hasUpsert(ormable) {
+		return
+	}
+	conflict := p.upsertConflictFields(ormable)
+	var conflictColumns, conditions, args []string
+	for _, fieldName := range conflict {
+		column := columnName(fieldName, ormable.Fields[fieldName])
+		conflictColumns = append(conflictColumns, column)
+		conditions = append(conditions, column+" = ?")
+		args = append(args, "ormObj."+fieldName)
+	}
+	where := `"` + strings.Join(conditions, " AND ") + `", ` + strings.Join(args, ", ")
+	updated := p.upsertUpdatedColumns(ormable, conflict)
+	if len(updated) == 0 && ormable.version == "" {
+		// an update of nothing is still needed for the row to be returned
+		updated = conflictColumns[:1]
+	}
+	var version, table string
+	if ormable.version != "" {
+		version, table = columnName(ormable.version, ormable.Fields[ormable.version]), p.tableName(ormable)
+		if p.Engine == EngineSQLServer {
+			p.Fail("Upsert of", ormable.OriginName, "is not supported with engine=sqlserver, as the MERGE statement can't compare its version.")
+		}
+	}
+	p.P(`// DefaultUpsert`, typeName, ` inserts in, or updates the row with the same `, strings.Join(conflictColumns, ", "))
+	p.P(`// in a single statement, and returns the stored row. The associations aren't saved`)
+	p.P(`func DefaultUpsert`, typeName, `(ctx `, identCtx, `, in *`, typeName, `, db *`, p.identGormDB, `) (*`, typeName, `, error) {`)
+	p.P(`if in == nil {`)
+	p.P(`return nil, `, identNilArgumentError)
+	p.P(`}`)
+	p.generateHistoryTransaction(ormable, `DefaultUpsert`+typeName+`(ctx, in, tx)`, `*`+typeName)
+	p.P(`ormObj, err := in.ToORM(ctx)`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.generateCreateAudit(ormable)
+	if getMessageOptions(message).GetSoftDelete() {
+		// a soft deleted row is left as is rather than restored
+		p.P(`var deleted int64`)
+		p.P(`if err = db.Unscoped().Model(&`, ormable.Name, `{}).Where(`, where, `).Where("`, deletedAtColumn(ormable), ` IS NOT NULL").Count(&deleted).Error; err != nil {`)
+		p.generateDBErrorReturn(`return nil, `)
+		p.P(`}`)
+		p.P(`if deleted > 0 {`)
+		p.P(`return nil, `, identNotFoundFn, `(`, p.identGormErrRecordNotFound, `)`)
+		p.P(`}`)
+	}
+	if hasHistory(ormable) || p.Gateway {
+		p.P(`lockedRow := &`, ormable.Name, `{}`)
+		var count, rowsAffected string
+		if p.Gateway {
+			count, rowsAffected = `count := `, `.RowsAffected`
+		}
+		if p.isGormV2() {
+			p.P(count, `db.Clauses(`, identGormClauseLocking, `{Strength: "UPDATE"}).Where(`, where, `).Limit(1).Find(lockedRow)`, rowsAffected)
+		} else {
+			p.P(count, `db.Set("gorm:query_option", "FOR UPDATE").Where(`, where, `).Limit(1).Find(lockedRow)`, rowsAffected)
+		}
+		p.generateHistoryLockedRow(ormable)
+	}
+	p.generateBeforeHookCall(ormable, "Upsert")
+	if p.isGormV2() {
+		p.generateUpsertOnConflict(ormable, conflictColumns, updated, version, table)
+		if version != "" {
+			p.P(`res := db.Omit(`, identGormClauseAssociations, `).Clauses(onConflict).Create(&ormObj)`)
+		} else {
+			p.P(`if err = db.Omit(`, identGormClauseAssociations, `).Clauses(onConflict).Create(&ormObj).Error; err != nil {`)
+		}
+	} else {
+		p.generateUpsertOption(ormable, conflictColumns, updated, version, table)
+		if version != "" {
+			p.P(`res := db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj)`)
+		} else {
+			p.P(`if err = db.Set("gorm:insert_option", option).Set("gorm:save_associations", false).Create(&ormObj).Error; err != nil {`)
+		}
+	}
+	if version != "" {
+		p.P(`// the conflicting row of another version is neither updated nor returned by PostgreSQL`)
+		if p.isGormV2() {
+			p.P(`if err = res.Error; err == nil && res.RowsAffected == 0 {`)
+		} else {
+			p.P(`if err = res.Error; err == `, identSqlErrNoRows, ` || err == nil && res.RowsAffected == 0 {`)
+		}
+		p.P(`return nil, `, identVersionConflictError)
+		p.P(`}`)
+		p.P(`if err != nil {`)
+	}
+	p.generateDBErrorReturn(`return nil, `)
+	p.P(`}`)
+	p.P(`// the row is read back as the inserted values are partly ignored by the update,`)
+	p.P(`// and some dialects don't return the primary key of the updated row`)
+	p.P(`stored := `, ormable.Name, `{}`)
+	p.P(`if err = db.Where(`, where, `).First(&stored).Error; err != nil {`)
+	p.generateDBErrorReturn(`return nil, `)
+	p.P(`}`)
+	p.P(`ormObj = stored`)
+	p.generateHistoryWrite(ormable, `operation`, `before`, `&ormObj`, `return nil, `)
+	p.generateAfterHookCall(ormable, "Upsert")
+	p.P(`pbResponse, err := ormObj.ToPB(ctx)`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	if p.Gateway {
+		p.P(`if count == 0 {`)
+		p.P(`err = `, p.identGatewaySetCreatedFn, `(ctx, "")`)
+		p.P(`}`)
+	}
+	p.P(`return &pbResponse, err`)
+	p.P(`}`)
+	p.generateBeforeHookDef(ormable, "Upsert")
+	p.generateAfterHookDef(ormable, "Upsert")
+}
+
+// generateUpsertOnConflict outputs the onConflict clause of the gorm v2
+// upserts. The version of the conflicting row is compared with the one of
+// ormObj and incremented, unless an unexposed version is left unset by the
+// ToORM hooks, in which case it is only incremented. MySQL has no WHERE in
+// its ON DUPLICATE KEY UPDATE, so its columns keep their values instead.
+func (p *OrmPlugin) generateUpsertOnConflict(ormable *OrmableType, conflictColumns, updated []string, version, table string) {
+	p.P(`onConflict := `, identGormClauseOnConflict, `{`)
+	p.P(`Columns: []`, identGormClauseColumn, `{`)
+	for _, column := range conflictColumns {
+		p.P(`{Name: "`, column, `"},`)
+	}
+	p.P(`},`)
+	p.P(`DoUpdates: `, identGormClauseAssignmentColumns, `([]string{`, quoteJoin(updated), `}),`)
+	p.P(`}`)
+	if version == "" {
+		return
+	}
+	increment := func() {
+		p.P(`onConflict.DoUpdates = append(onConflict.DoUpdates, `, identGormClauseAssignment, `{Column: `, identGormClauseColumn,
+			`{Name: "`, version, `"}, Value: `, p.identGormExpr, `("`, table, `.`, version, ` + 1")})`)
+	}
+	p.P(`switch {`)
+	if !isVersionExposed(ormable) {
+		p.P(`case ormObj.`, ormable.version, ` == 0:`)
+		increment()
+	}
+	p.P(`case db.Dialector.Name() == "mysql":`)
+	p.P(`onConflict.DoUpdates = []`, identGormClauseAssignment, `{`)
+	for _, column := range updated {
+		p.P(`{Column: `, identGormClauseColumn, `{Name: "`, column, `"}, Value: `, p.identGormExpr,
+			`("IF(`, version, ` = ?, VALUES(`, column, `), `, column, `)", ormObj.`, ormable.version, `)},`)
+	}
+	p.P(`{Column: `, identGormClauseColumn, `{Name: "`, version, `"}, Value: `, p.identGormExpr,
+		`("IF(`, version, ` = ?, `, version, ` + 1, `, version, `)", ormObj.`, ormable.version, `)},`)
+	p.P(`}`)
+	p.P(`default:`)
+	increment()
+	p.P(`onConflict.Where = `, identGormClauseWhere, `{Exprs: []`, identGormClauseExpression, `{`,
+		p.identGormExpr, `("`, table, `.`, version, ` = ?", ormObj.`, ormable.version, `)}}`)
+	p.P(`}`)
+}
+
+// generateUpsertOption outputs the insert option of the jinzhu upserts, which
+// compares the version like generateUpsertOnConflict.
+func (p *OrmPlugin) generateUpsertOption(ormable *OrmableType, conflictColumns, updated []string, version, table string) {
+	var onConflict, onDuplicate []string
+	for _, column := range updated {
+		onConflict = append(onConflict, column+" = excluded."+column)
+		onDuplicate = append(onDuplicate, column+" = VALUES("+column+")")
+	}
+	if version == "" || !isVersionExposed(ormable) {
+		if version != "" {
+			increment := version + " = " + table + "." + version + " + 1"
+			onConflict, onDuplicate = append(onConflict, increment), append(onDuplicate, increment)
+		}
+		p.P(`// PostgreSQL and SQLite share the ON CONFLICT clause`)
+		p.P(`option := "ON CONFLICT (`, strings.Join(conflictColumns, ", "), `) DO UPDATE SET `, strings.Join(onConflict, ", "), `"`)
+		p.P(`if db.Dialect().GetName() == "mysql" {`)
+		p.P(`option = "ON DUPLICATE KEY UPDATE `, strings.Join(onDuplicate, ", "), `"`)
+		p.P(`}`)
+		if version == "" {
+			return
+		}
+		p.P(`if ormObj.`, ormable.version, ` != 0 {`)
+	} else {
+		p.P(`var option string`)
+	}
+	onConflict, onDuplicate = onConflict[:0], onDuplicate[:0]
+	for _, column := range updated {
+		onConflict = append(onConflict, column+" = excluded."+column)
+		onDuplicate = append(onDuplicate, column+" = IF("+version+" = %[1]d, VALUES("+column+"), "+column+")")
+	}
+	onConflict = append(onConflict, version+" = "+table+"."+version+" + 1")
+	onDuplicate = append(onDuplicate, version+" = IF("+version+" = %[1]d, "+version+" + 1, "+version+")")
+	p.P(`// the conflicting row is only updated when its version matches, MySQL has no`)
+	p.P(`// WHERE in its ON DUPLICATE KEY UPDATE so its columns keep their values instead`)
+	p.P(`option = `, identFmtSprintf, `("ON CONFLICT (`, strings.Join(conflictColumns, ", "), `) DO UPDATE SET `, strings.Join(onConflict, ", "),
+		` WHERE `, table, `.`, version, ` = %d", ormObj.`, ormable.version, `)`)
+	p.P(`if db.Dialect().GetName() == "mysql" {`)
+	p.P(`option = `, identFmtSprintf, `("ON DUPLICATE KEY UPDATE `, strings.Join(onDuplicate, ", "), `", ormObj.`, ormable.version, `)`)
+	p.P(`}`)
+	if !isVersionExposed(ormable) {
+		p.P(`}`)
+	}
+}
+
+// quoteJoin returns the Go string literals of values separated by commas.
+func quoteJoin(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, strconv.Quote(value))
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -45,6 +45,43 @@ func (p *OrmPlugin) fieldToPQArrayIdent(field *protogen.Field) (i protogen.GoIde
 	}
 }
 
+// jsonbIdent is the ORM type of jsonb columns for the targeted ORM library.
+func (p *OrmPlugin) jsonbIdent() protogen.GoIdent {
+	if p.isGormV2() {
+		return identDatatypesJSON
+	}
+	return identpqJsonb
+}
+
+// deletedAtIdent returns the ident of the DeletedAt field of the soft deleted
+// types, gorm only soft deletes the rows of a model holding such a field.
+func (p *OrmPlugin) deletedAtIdent() protogen.GoIdent {
+	if p.isGormV2() {
+		return identGormDeletedAt
+	}
+	return ptrIdent(identTime)
+}
+
+// generateJsonbAssignment outputs the assignment of the JSON held in the data
+// variable to the jsonb column dst.
+func (p *OrmPlugin) generateJsonbAssignment(dst string) {
+	if p.isGormV2() {
+		p.P(`v := `, identDatatypesJSON, `(data)`)
+		p.P(dst, ` = &v`)
+	} else {
+		p.P(dst, ` = &`, identpqJsonb, `{RawMessage: data}`)
+	}
+}
+
+// jsonbData returns the expression reading the JSON held in the jsonb column
+// src.
+func (p *OrmPlugin) jsonbData(src string) string {
+	if p.isGormV2() {
+		return "[]byte(*" + src + ")"
+	}
+	return src + ".RawMessage"
+}
+
 func (p *OrmPlugin) qualifiedGoIdent(ident protogen.GoIdent) string {
 	isPointer := strings.Contains(ident.GoName, "*")
 	isList := strings.Contains(ident.GoName, "[]")
@@ -79,6 +116,18 @@ func (p *OrmPlugin) fnCall(funcName string, args ...string) string {
 	return fmt.Sprint(funcName, `(`+strings.Join(args, ",")+`)`)
 }
 
+func getFileOptions(file *protogen.File) *gorm.GormFileOptions {
+	if file.Desc.Options() == nil {
+		return nil
+	}
+	v := proto.GetExtension(file.Desc.Options(), gorm.E_FileOpts)
+	opts, ok := v.(*gorm.GormFileOptions)
+	if !ok {
+		return nil
+	}
+	return opts
+}
+
 // retrieves the GormMessageOptions from a message
 func getMessageOptions(message *protogen.Message) *gorm.GormMessageOptions {
 	if message.Desc.Options() == nil {
@@ -104,6 +153,18 @@ func getFieldOptions(field *protogen.Field) *gorm.GormFieldOptions {
 	return opts
 }
 
+func getOneofOptions(oneof *protogen.Oneof) *gorm.GormOneofOptions {
+	if oneof.Desc.Options() == nil {
+		return nil
+	}
+	v := proto.GetExtension(oneof.Desc.Options(), gorm.E_Oneof)
+	opts, ok := v.(*gorm.GormOneofOptions)
+	if !ok {
+		return nil
+	}
+	return opts
+}
+
 func getServiceOptions(service *protogen.Service) *gorm.AutoServerOptions {
 	if service.Desc.Options() == nil {
 		return nil
@@ -135,6 +196,14 @@ func (p *OrmPlugin) isSpecialType(field *protogen.Field) bool {
 	} else {
 		ident = field.GoIdent
 	}
+	if p.getTypeMapping(field) != nil {
+		// converted by user provided functions, patched as a whole
+		return true
+	}
+	if hasWellKnownStorage(field) && !isWellKnownType(field, protoTypeBytesValue) {
+		// patched as a whole
+		return true
+	}
 	if p.currentPackage == ident.GoImportPath {
 		return false
 	}
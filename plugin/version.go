@@ -0,0 +1,70 @@
+package plugin
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	gorm "github.com/edhaight/protoc-gen-gorm/options"
+)
+
+// parseVersionField sets the version field of ormable: the field with the
+// version option, or else the Version column included with the version
+// message option.
+func (p *OrmPlugin) parseVersionField(msg *protogen.Message, ormable *OrmableType) {
+	for _, field := range msg.Fields {
+		if !getFieldOptions(field).GetVersion() {
+			continue
+		}
+		if ormable.version != "" {
+			p.Fail("Message", ormable.OriginName, "has more than one version field.")
+		}
+		if f, ok := ormable.Fields[field.GoName]; !ok || !isIntegerType(f.Type) {
+			p.Fail("Version field", field.GoName, "of", ormable.OriginName, "must be a non optional integer.")
+		}
+		ormable.version = field.GoName
+	}
+	if ormable.version != "" || !getMessageOptions(msg).GetVersion() {
+		return
+	}
+	if _, ok := ormable.Fields["Version"]; ok {
+		p.Fail("Cannot include Version field into", ormable.Name, "as it already exists there.")
+	}
+	ormable.Fields["Version"] = &Field{
+		F:                &protogen.Field{GoIdent: protogen.GoIdent{GoName: "int64"}},
+		Type:             "int64",
+		GormFieldOptions: &gorm.GormFieldOptions{Tag: &gorm.GormTag{NotNull: proto.Bool(true), Default: proto.String("0")}},
+	}
+	ormable.version = "Version"
+}
+
+// isVersionExposed reports whether the version of ormable is a field of its
+// message, rather than an included column set by the ToORM hooks.
+func isVersionExposed(ormable *OrmableType) bool {
+	return ormable.Fields[ormable.version].F.Desc != nil
+}
+
+// generateVersionCheck outputs the compare-and-increment of the version of the
+// row of the ormObj variable, which fails with the VersionConflictError when
+// the stored version doesn't match, or the row is missing. An unexposed version
+// left unset by the ToORM hooks is taken from the lockedRow variable, so the
+// version is only incremented.
+func (p *OrmPlugin) generateVersionCheck(ormable *OrmableType) {
+	column := columnName(ormable.version, ormable.Fields[ormable.version])
+	if !isVersionExposed(ormable) {
+		p.P(`if ormObj.`, ormable.version, ` == 0 {`)
+		p.P(`ormObj.`, ormable.version, ` = lockedRow.`, ormable.version)
+		p.P(`}`)
+	}
+	condition, args := p.primaryKeyCondition(ormable, "ormObj")
+	p.P(`res := db.Model(&`, ormable.Name, `{}).Where("`, condition, ` AND `, column, ` = ?", `, strings.Join(args, ", "), `, ormObj.`, ormable.version,
+		`).UpdateColumn("`, column, `", `, p.identGormExpr, `("`, column, ` + 1"))`)
+	p.P(`if err = res.Error; err != nil {`)
+	p.generateDBErrorReturn(`return nil, `)
+	p.P(`}`)
+	p.P(`if res.RowsAffected == 0 {`)
+	p.P(`return nil, `, identVersionConflictError)
+	p.P(`}`)
+	p.P(`ormObj.`, ormable.version, `++`)
+}
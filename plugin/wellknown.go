@@ -0,0 +1,152 @@
+package plugin
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	gorm "github.com/edhaight/protoc-gen-gorm/options"
+)
+
+// Well known types with a dedicated storage, by full name.
+const (
+	protoTypeDuration   = "google.protobuf.Duration"
+	protoTypeStruct     = "google.protobuf.Struct"
+	protoTypeValue      = "google.protobuf.Value"
+	protoTypeListValue  = "google.protobuf.ListValue"
+	protoTypeAny        = "google.protobuf.Any"
+	protoTypeBytesValue = "google.protobuf.BytesValue"
+)
+
+// isWellKnownType reports whether field holds a message of the given well
+// known type.
+func isWellKnownType(field *protogen.Field, fullName protoreflect.FullName) bool {
+	return field.Desc.Message() != nil && field.Desc.Message().FullName() == fullName
+}
+
+// hasWellKnownStorage reports whether field holds a well known type with a
+// dedicated storage.
+func hasWellKnownStorage(field *protogen.Field) bool {
+	if field.Desc.Message() == nil {
+		return false
+	}
+	switch field.Desc.Message().FullName() {
+	case protoTypeDuration, protoTypeStruct, protoTypeValue, protoTypeListValue, protoTypeAny, protoTypeBytesValue:
+		return true
+	}
+	return false
+}
+
+// isInterval reports whether a Duration field is stored in an interval column
+// rather than as nanoseconds in a bigint column.
+func isInterval(field *protogen.Field) bool {
+	return strings.ToLower(getFieldOptions(field).GetTag().GetType()) == "interval"
+}
+
+// parseWellKnownField sets the ORM type of a field holding a well known type
+// with a dedicated storage, it returns false for any other field.
+func (p *OrmPlugin) parseWellKnownField(field *protogen.Field, opts *gorm.GormFieldOptions) bool {
+	switch {
+	case isWellKnownType(field, protoTypeDuration):
+		if isInterval(field) {
+			field.GoIdent = ptrIdent(identTypesInterval)
+		} else {
+			field.GoIdent = ptrIdent(identTimeDuration)
+		}
+	case isWellKnownType(field, protoTypeStruct), isWellKnownType(field, protoTypeValue), isWellKnownType(field, protoTypeListValue):
+		field.GoIdent = ptrIdent(p.jsonbIdent())
+		opts.Tag = tagWithType(opts.GetTag(), "jsonb")
+	case isWellKnownType(field, protoTypeBytesValue):
+		field.GoIdent = protogen.GoIdent{GoName: "[]byte"}
+	default:
+		return false
+	}
+	return true
+}
+
+// parseAnyField adds the columns an Any field is stored in: the type url and
+// the binary encoded value. The tag of the field applies to the value column.
+func (p *OrmPlugin) parseAnyField(ormable *OrmableType, field *protogen.Field, opts *gorm.GormFieldOptions) {
+	if isOneofMember(field) {
+		p.Fail("Oneof member", field.GoName, "of", ormable.Name, "is an Any,",
+			"set (gorm.oneof).discriminator to store the oneof in a jsonb column instead.")
+	}
+	typeURLName, valueName := field.GoName+"TypeUrl", field.GoName+"Value"
+	for _, name := range []string{typeURLName, valueName} {
+		if _, ok := ormable.Fields[name]; ok {
+			p.Fail("Cannot include", name, "field into", ormable.Name, "as it already exists there.")
+		}
+	}
+	ormable.Fields[typeURLName] = &Field{
+		Type:             "*string",
+		F:                &protogen.Field{GoIdent: protogen.GoIdent{GoName: "*string"}},
+		GormFieldOptions: &gorm.GormFieldOptions{},
+	}
+	ormable.Fields[valueName] = &Field{
+		Type:             "[]byte",
+		F:                &protogen.Field{GoIdent: protogen.GoIdent{GoName: "[]byte"}},
+		GormFieldOptions: &gorm.GormFieldOptions{Tag: opts.GetTag()},
+	}
+}
+
+// generateWellKnownFieldConversion outputs the conversion of a field holding
+// a well known type with a dedicated storage to and from its columns.
+func (p *OrmPlugin) generateWellKnownFieldConversion(field *protogen.Field, toORM bool, src, dst string) {
+	switch {
+	case isWellKnownType(field, protoTypeDuration):
+		if toORM {
+			p.P(`if `, src, ` != nil {`)
+			p.P(`d, err := `, identDuration, `(`, src, `)`)
+			p.P(`if err != nil {`)
+			p.P(`return to, err`)
+			p.P(`}`)
+			if isInterval(field) {
+				p.P(`v := `, identTypesInterval, `(d)`)
+				p.P(dst, ` = &v`)
+			} else {
+				p.P(dst, ` = &d`)
+			}
+			p.P(`}`)
+		} else {
+			p.P(`if `, src, ` != nil {`)
+			if isInterval(field) {
+				p.P(dst, ` = `, identDurationProto, `(`, identTimeDuration, `(*`, src, `))`)
+			} else {
+				p.P(dst, ` = `, identDurationProto, `(*`, src, `)`)
+			}
+			p.P(`}`)
+		}
+	case isWellKnownType(field, protoTypeAny):
+		if toORM {
+			p.P(`if `, src, ` != nil {`)
+			p.P(`typeURL := `, src, `.TypeUrl`)
+			p.P(dst, `TypeUrl = &typeURL`)
+			p.P(dst, `Value = `, src, `.Value`)
+			p.P(`if `, dst, `Value == nil {`)
+			p.P(dst, `Value = []byte{}`)
+			p.P(`}`)
+			p.P(`}`)
+		} else {
+			p.P(`if `, src, `TypeUrl != nil {`)
+			p.P(dst, ` = &`, p.qualifiedGoIdent(field.Message.GoIdent), `{TypeUrl: *`, src, `TypeUrl, Value: `, src, `Value}`)
+			p.P(`}`)
+		}
+	case isWellKnownType(field, protoTypeBytesValue):
+		if toORM {
+			// an empty value is kept apart from a NULL one
+			p.P(`if `, src, ` != nil {`)
+			p.P(dst, ` = `, src, `.Value`)
+			p.P(`if `, dst, ` == nil {`)
+			p.P(dst, ` = []byte{}`)
+			p.P(`}`)
+			p.P(`}`)
+		} else {
+			p.P(`if `, src, ` != nil {`)
+			p.P(dst, ` = &`, p.qualifiedGoIdent(field.Message.GoIdent), `{Value: `, src, `}`)
+			p.P(`}`)
+		}
+	default: // Struct, Value and ListValue
+		p.generateProtojsonConversion(field, toORM, src, dst)
+	}
+}
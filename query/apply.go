@@ -0,0 +1,301 @@
+// Package query implements the collection operators of the List handlers,
+// filtering, sorting, pagination and field selection, without depending on
+// atlas-app-toolkit. The requests of the generated code take them when their
+// fields are of the types of query.proto.
+package query
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edhaight/protoc-gen-gorm/errors"
+)
+
+// Columns is implemented by the ORM types of the generated code. QueryColumn
+// returns the column of the field path and a pointer to its field, or a nil
+// pointer when path isn't a column.
+type Columns interface {
+	QueryColumn(path string) (string, interface{})
+}
+
+// LikeEscape is the escape character of the LIKE patterns. Where passes it as
+// the argument of their ESCAPE clause, since MySQL and Postgres don't quote a
+// backslash the same way in a string literal.
+const LikeEscape = `\`
+
+var conditionOperators = map[Condition_Type]string{
+	Condition_EQ:   "=",
+	Condition_NE:   "<>",
+	Condition_GT:   ">",
+	Condition_GE:   ">=",
+	Condition_LT:   "<",
+	Condition_LE:   "<=",
+	Condition_LIKE: "LIKE",
+}
+
+// Where returns the condition, and its arguments, of f on the columns of obj.
+// It returns an InvalidArgumentError for the paths which aren't columns, and
+// the values which can't be converted to the type of their field.
+func Where(f *Filtering, obj Columns) (string, []interface{}, error) {
+	switch expression := f.GetExpression().(type) {
+	case *Filtering_Condition:
+		return conditionWhere(expression.Condition, obj)
+	case *Filtering_Operator:
+		return operatorWhere(expression.Operator, obj)
+	}
+	return "", nil, nil
+}
+
+func conditionWhere(c *Condition, obj Columns) (string, []interface{}, error) {
+	column, field := obj.QueryColumn(c.GetField())
+	if field == nil {
+		return "", nil, errors.InvalidArgument(fmt.Errorf("unknown filtering field %q", c.GetField()))
+	}
+	values := make([]interface{}, len(c.GetValues()))
+	for i, value := range c.GetValues() {
+		converted, err := convert(value, reflect.TypeOf(field).Elem())
+		if err != nil {
+			return "", nil, errors.InvalidArgument(fmt.Errorf("invalid value %q of filtering field %q: %w", value, c.GetField(), err))
+		}
+		values[i] = converted
+	}
+	switch c.GetType() {
+	case Condition_IS_NULL:
+		if len(values) != 0 {
+			break
+		}
+		return column + " IS NULL", nil, nil
+	case Condition_IN:
+		if len(values) == 0 {
+			break
+		}
+		return column + " IN (?)", []interface{}{values}, nil
+	case Condition_LIKE:
+		if len(values) != 1 {
+			break
+		}
+		return column + " LIKE ? ESCAPE ?", append(values, LikeEscape), nil
+	default:
+		if len(values) != 1 {
+			break
+		}
+		return fmt.Sprintf("%s %s ?", column, conditionOperators[c.GetType()]), values, nil
+	}
+	return "", nil, errors.InvalidArgument(fmt.Errorf("%d values for %s condition of filtering field %q", len(values), c.GetType(), c.GetField()))
+}
+
+func operatorWhere(o *LogicalOperator, obj Columns) (string, []interface{}, error) {
+	var (
+		terms []string
+		args  []interface{}
+	)
+	for _, operand := range o.GetOperands() {
+		where, operandArgs, err := Where(operand, obj)
+		if err != nil {
+			return "", nil, err
+		}
+		if where != "" {
+			terms = append(terms, "("+where+")")
+			args = append(args, operandArgs...)
+		}
+	}
+	switch {
+	case o.GetType() == LogicalOperator_NOT && len(terms) == 1:
+		return "NOT " + terms[0], args, nil
+	case o.GetType() == LogicalOperator_NOT:
+		return "", nil, errors.InvalidArgument(fmt.Errorf("%d operands for NOT operator", len(terms)))
+	}
+	return strings.Join(terms, " "+o.GetType().String()+" "), args, nil
+}
+
+// convert returns value converted to typ, the type of a field.
+func convert(value string, typ reflect.Type) (interface{}, error) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == reflect.TypeOf(time.Time{}) {
+		return time.Parse(time.RFC3339Nano, value)
+	}
+	if scanner, ok := reflect.New(typ).Interface().(sql.Scanner); ok {
+		if err := scanner.Scan(value); err != nil {
+			return nil, err
+		}
+		return reflect.ValueOf(scanner).Elem().Interface(), nil
+	}
+	converted := reflect.New(typ).Elem()
+	switch typ.Kind() {
+	case reflect.String:
+		converted.SetString(value)
+	case reflect.Bool:
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, err
+		}
+		converted.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(value, 10, typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		converted.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(value, 10, typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		converted.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(value, typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		converted.SetFloat(v)
+	default:
+		return value, nil
+	}
+	return converted.Interface(), nil
+}
+
+// Order returns the ORDER BY clause of s on the columns of obj. It returns an
+// InvalidArgumentError for the paths which aren't columns.
+func Order(s *Sorting, obj Columns) (string, error) {
+	var criterias []string
+	for _, c := range s.GetCriterias() {
+		column, field := obj.QueryColumn(c.GetTag())
+		if field == nil {
+			return "", errors.InvalidArgument(fmt.Errorf("unknown sorting field %q", c.GetTag()))
+		}
+		if c.IsDesc() {
+			column += " DESC"
+		}
+		criterias = append(criterias, column)
+	}
+	return strings.Join(criterias, ", "), nil
+}
+
+// Preloads returns the associations of obj, a pointer to an ORM struct, to
+// preload for the field paths of fs. It returns an InvalidArgumentError for
+// the paths which are neither columns nor associations.
+func Preloads(fs *FieldSelection, obj interface{}) ([]string, error) {
+	var preloads []string
+	seen := make(map[string]bool)
+	for _, path := range fs.GetFields() {
+		name := strings.SplitN(path, ".", 2)[0]
+		if columns, ok := obj.(Columns); ok {
+			if _, field := columns.QueryColumn(name); field != nil {
+				continue
+			}
+		}
+		association := camelCase(name)
+		field, ok := reflect.TypeOf(obj).Elem().FieldByName(association)
+		if !ok || !isAssociation(field.Type) {
+			return nil, errors.InvalidArgument(fmt.Errorf("unknown field selection field %q", path))
+		}
+		if !seen[association] {
+			seen[association] = true
+			preloads = append(preloads, association)
+		}
+	}
+	return preloads, nil
+}
+
+func isAssociation(typ reflect.Type) bool {
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Struct && typ != reflect.TypeOf(time.Time{})
+}
+
+// camelCase returns the Go name of the field path name, e.g. FirstName for
+// first_name.
+func camelCase(name string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
+
+// ApplyCollectionOperators applies f, s, p and fs to db, a pointer to a
+// *gorm.DB of either github.com/jinzhu/gorm or gorm.io/gorm, with the columns
+// of obj, a pointer to an ORM struct of the generated code.
+func ApplyCollectionOperators(ctx context.Context, db interface{}, obj interface{}, f *Filtering, s *Sorting, p *Pagination, fs *FieldSelection) error {
+	columns, ok := obj.(Columns)
+	if !ok && (f.GetExpression() != nil || len(s.GetCriterias()) > 0) {
+		return fmt.Errorf("%T doesn't implement query.Columns", obj)
+	}
+	if f.GetExpression() != nil {
+		where, args, err := Where(f, columns)
+		if err != nil {
+			return err
+		}
+		if err := call(db, "Where", append([]interface{}{where}, args...)...); err != nil {
+			return err
+		}
+	}
+	if len(s.GetCriterias()) > 0 {
+		order, err := Order(s, columns)
+		if err != nil {
+			return err
+		}
+		if err := call(db, "Order", order); err != nil {
+			return err
+		}
+	}
+	if p.GetOffset() > 0 {
+		if err := call(db, "Offset", int(p.GetOffset())); err != nil {
+			return err
+		}
+	}
+	if p.GetLimit() > 0 {
+		if err := call(db, "Limit", int(p.GetLimit())); err != nil {
+			return err
+		}
+	}
+	return ApplyFieldSelection(ctx, db, fs, obj)
+}
+
+// ApplyFieldSelection preloads the associations of obj selected by fs, db is
+// a pointer to a *gorm.DB of either github.com/jinzhu/gorm or gorm.io/gorm.
+func ApplyFieldSelection(ctx context.Context, db interface{}, fs *FieldSelection, obj interface{}) error {
+	preloads, err := Preloads(fs, obj)
+	if err != nil {
+		return err
+	}
+	for _, association := range preloads {
+		if err := call(db, "Preload", association); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// call calls the method of the *gorm.DB pointed by db with args, and stores
+// the *gorm.DB it returns in db.
+func call(db interface{}, method string, args ...interface{}) error {
+	v := reflect.ValueOf(db)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Ptr {
+		return fmt.Errorf("%T is not a pointer to a *gorm.DB", db)
+	}
+	m := v.Elem().MethodByName(method)
+	if !m.IsValid() || m.Type().NumOut() != 1 || m.Type().Out(0) != v.Elem().Type() {
+		return fmt.Errorf("%T has no %s method", v.Elem().Interface(), method)
+	}
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		if arg == nil {
+			in[i] = reflect.Zero(reflect.TypeOf((*interface{})(nil)).Elem())
+		} else {
+			in[i] = reflect.ValueOf(arg)
+		}
+	}
+	v.Elem().Set(m.Call(in)[0])
+	return nil
+}
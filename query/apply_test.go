@@ -0,0 +1,167 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	gerrors "github.com/edhaight/protoc-gen-gorm/errors"
+)
+
+type profile struct {
+	Bio string
+}
+
+type userORM struct {
+	Id        uint64
+	Name      string
+	Age       int32
+	CreatedAt *time.Time
+	Profile   *profile
+	Friends   []*userORM
+}
+
+func (m *userORM) QueryColumn(path string) (string, interface{}) {
+	switch path {
+	case "id":
+		return "id", &m.Id
+	case "name":
+		return "user_name", &m.Name
+	case "age":
+		return "age", &m.Age
+	case "created_at":
+		return "created_at", &m.CreatedAt
+	}
+	return "", nil
+}
+
+// db records the calls of the methods of a *gorm.DB.
+type db struct {
+	calls []string
+}
+
+func (d *db) record(method string, args ...interface{}) *db {
+	return &db{calls: append(d.calls, fmt.Sprint(append([]interface{}{method}, args...)...))}
+}
+
+func (d *db) Where(query interface{}, args ...interface{}) *db {
+	return d.record("Where ", query, args)
+}
+func (d *db) Order(value interface{}, reorder ...bool) *db { return d.record("Order ", value) }
+func (d *db) Offset(offset interface{}) *db                { return d.record("Offset ", offset) }
+func (d *db) Limit(limit int) *db                          { return d.record("Limit ", limit) }
+func (d *db) Preload(column string, conditions ...interface{}) *db {
+	return d.record("Preload ", column)
+}
+
+func condition(field string, typ Condition_Type, values ...string) *Filtering {
+	return &Filtering{Expression: &Filtering_Condition{Condition: &Condition{Field: field, Type: typ, Values: values}}}
+}
+
+func operator(typ LogicalOperator_Type, operands ...*Filtering) *Filtering {
+	return &Filtering{Expression: &Filtering_Operator{Operator: &LogicalOperator{Type: typ, Operands: operands}}}
+}
+
+func TestWhere(t *testing.T) {
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	cases := []struct {
+		name        string
+		f           *Filtering
+		where       string
+		args        []interface{}
+		expectError bool
+	}{
+		{"nil", nil, "", nil, false},
+		{"eq", condition("name", Condition_EQ, "bob"), "user_name = ?", []interface{}{"bob"}, false},
+		{"gt", condition("age", Condition_GT, "18"), "age > ?", []interface{}{int32(18)}, false},
+		{"time", condition("created_at", Condition_LT, created.Format(time.RFC3339)), "created_at < ?", []interface{}{created}, false},
+		{"in", condition("id", Condition_IN, "1", "2"), "id IN (?)", []interface{}{[]interface{}{uint64(1), uint64(2)}}, false},
+		{"is null", condition("created_at", Condition_IS_NULL), "created_at IS NULL", nil, false},
+		{"and", operator(LogicalOperator_AND, condition("name", Condition_LIKE, "b%"), condition("age", Condition_LE, "30")),
+			"(user_name LIKE ? ESCAPE ?) AND (age <= ?)", []interface{}{"b%", `\`, int32(30)}, false},
+		{"not or", operator(LogicalOperator_NOT, operator(LogicalOperator_OR, condition("age", Condition_EQ, "1"), condition("age", Condition_NE, "2"))),
+			"NOT ((age = ?) OR (age <> ?))", []interface{}{int32(1), int32(2)}, false},
+		{"unknown field", condition("password", Condition_EQ, "x"), "", nil, true},
+		{"association", condition("profile", Condition_EQ, "x"), "", nil, true},
+		{"invalid value", condition("age", Condition_EQ, "old"), "", nil, true},
+		{"missing value", condition("age", Condition_EQ), "", nil, true},
+		{"not with two operands", operator(LogicalOperator_NOT, condition("age", Condition_EQ, "1"), condition("age", Condition_EQ, "2")), "", nil, true},
+	}
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			where, args, err := Where(v.f, &userORM{})
+			if v.expectError {
+				var target *gerrors.InvalidArgumentError
+				if !errors.As(err, &target) {
+					t.Errorf("Expected an InvalidArgumentError, got %#v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if where != v.where {
+				t.Errorf("Expected %q, got %q", v.where, where)
+			}
+			if !reflect.DeepEqual(args, v.args) {
+				t.Errorf("Expected %#v, got %#v", v.args, args)
+			}
+		})
+	}
+}
+
+func TestOrder(t *testing.T) {
+	s := &Sorting{Criterias: []*SortCriteria{{Tag: "name"}, {Tag: "id", Order: SortCriteria_DESC}}}
+	order, err := Order(s, &userORM{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "user_name, id DESC"; order != expected {
+		t.Errorf("Expected %q, got %q", expected, order)
+	}
+	if _, err := Order(&Sorting{Criterias: []*SortCriteria{{Tag: "profile"}}}, &userORM{}); err == nil {
+		t.Error("Expected an error sorting by an association")
+	}
+}
+
+func TestPreloads(t *testing.T) {
+	preloads, err := Preloads(&FieldSelection{Fields: []string{"name", "profile.bio", "friends", "profile"}}, &userORM{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"Profile", "Friends"}; !reflect.DeepEqual(preloads, expected) {
+		t.Errorf("Expected %v, got %v", expected, preloads)
+	}
+	if _, err := Preloads(&FieldSelection{Fields: []string{"unknown"}}, &userORM{}); err == nil {
+		t.Error("Expected an error selecting an unknown field")
+	}
+}
+
+func TestApplyCollectionOperators(t *testing.T) {
+	d := &db{}
+	err := ApplyCollectionOperators(context.Background(), &d, &userORM{},
+		condition("age", Condition_GE, "18"),
+		&Sorting{Criterias: []*SortCriteria{{Tag: "name", Order: SortCriteria_DESC}}},
+		&Pagination{Offset: 20, Limit: 10},
+		&FieldSelection{Fields: []string{"profile"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"Where age >= ?[18]", "Order user_name DESC", "Offset 20", "Limit 10", "Preload Profile"}
+	if !reflect.DeepEqual(d.calls, expected) {
+		t.Errorf("Expected %q, got %q", expected, d.calls)
+	}
+
+	d = &db{}
+	if err := ApplyCollectionOperators(context.Background(), &d, &userORM{}, nil, nil, nil, nil); err != nil || len(d.calls) != 0 {
+		t.Errorf("Expected no calls, got %q and %v", d.calls, err)
+	}
+	if err := ApplyCollectionOperators(context.Background(), d, &userORM{}, nil, nil, &Pagination{Limit: 1}, nil); err == nil ||
+		!strings.Contains(err.Error(), "not a pointer") {
+		t.Errorf("Expected an error applying to a *db, got %v", err)
+	}
+}
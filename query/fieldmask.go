@@ -0,0 +1,65 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+// MergeWithMask writes the fields of source, pointers to structs of the same
+// type, in the paths of mask to the ones of dest. The nil pointers along the
+// paths of dest are allocated.
+func MergeWithMask(source, dest interface{}, mask *field_mask.FieldMask) error {
+	if len(mask.GetPaths()) == 0 {
+		return nil
+	}
+	if source == nil {
+		return errors.New("source object is nil")
+	}
+	if dest == nil {
+		return errors.New("destination object is nil")
+	}
+	if reflect.TypeOf(source) != reflect.TypeOf(dest) {
+		return errors.New("types of source and destination objects do not match")
+	}
+paths:
+	for _, path := range mask.GetPaths() {
+		src := reflect.ValueOf(source).Elem()
+		dst := reflect.ValueOf(dest).Elem()
+		for _, name := range strings.Split(path, ".") {
+			src, dst = derefMerge(src, dst)
+			// skip the paths going through a field which isn't a struct
+			if dst.Kind() != reflect.Struct {
+				continue paths
+			}
+			src = src.FieldByName(name)
+			dst = dst.FieldByName(name)
+			if !src.IsValid() || !dst.IsValid() {
+				return fmt.Errorf("field path %q doesn't exist in type %s", path, reflect.TypeOf(source))
+			}
+		}
+		src, dst = derefMerge(src, dst)
+		dst.Set(src)
+	}
+	return nil
+}
+
+// derefMerge dereferences the pointers dst, allocating the nil ones, and src
+// along with it.
+func derefMerge(src, dst reflect.Value) (reflect.Value, reflect.Value) {
+	for dst.Kind() == reflect.Ptr {
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		if src.IsNil() {
+			src = reflect.Zero(src.Type().Elem())
+		} else {
+			src = src.Elem()
+		}
+		dst = dst.Elem()
+	}
+	return src, dst
+}
@@ -0,0 +1,37 @@
+package query
+
+import (
+	"testing"
+
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+type address struct {
+	City   string
+	Street string
+}
+
+type contact struct {
+	Name    string
+	Address *address
+}
+
+func TestMergeWithMask(t *testing.T) {
+	source := &contact{Name: "new", Address: &address{City: "Paris", Street: "new"}}
+	dest := &contact{Name: "old"}
+	if err := MergeWithMask(source, dest, &field_mask.FieldMask{Paths: []string{"Address.City"}}); err != nil {
+		t.Fatal(err)
+	}
+	if dest.Name != "old" || dest.Address == nil || dest.Address.City != "Paris" || dest.Address.Street != "" {
+		t.Errorf("Unexpected merge %+v %+v", dest, dest.Address)
+	}
+	if err := MergeWithMask(source, dest, &field_mask.FieldMask{Paths: []string{"Phone"}}); err == nil {
+		t.Error("Expected an error merging an unknown field")
+	}
+	if err := MergeWithMask(source, &address{}, &field_mask.FieldMask{Paths: []string{"City"}}); err == nil {
+		t.Error("Expected an error merging objects of different types")
+	}
+	if err := MergeWithMask(source, dest, nil); err != nil {
+		t.Errorf("Expected no error merging with a nil mask, got %v", err)
+	}
+}
@@ -0,0 +1,290 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/edhaight/protoc-gen-gorm/errors"
+)
+
+// ParseFilter parses an AIP-160 filter, e.g. `age >= 18 AND (name = "b*" OR
+// NOT verified = true)`, into a Filtering. The restrictions compare a field
+// path with a value using =, !=, <, <=, >, >= or :, the value null matches the
+// NULL columns, the * of the string values of = and : matches any characters,
+// while their % and _ only match themselves, and field:* matches the non NULL
+// columns. The restrictions are combined with AND, OR, NOT or -, OR binding
+// tighter than AND, and a sequence of restrictions separated by spaces is the
+// same as their AND. An empty filter returns nil. The syntax errors are
+// returned as InvalidArgumentErrors, the fields are checked by Where.
+func ParseFilter(filter string) (*Filtering, error) {
+	p := &filterParser{input: filter}
+	if p.skipSpaces(); p.eof() {
+		return nil, nil
+	}
+	f, err := p.expression()
+	if err == nil && !p.eof() {
+		err = p.errorf("unexpected %q", p.input[p.pos:])
+	}
+	if err != nil {
+		return nil, errors.InvalidArgument(err)
+	}
+	return f, nil
+}
+
+// ParseOrderBy parses an AIP-132 order_by, e.g. `name, age desc`, into a
+// Sorting. An empty order_by returns nil. The syntax errors are returned as
+// InvalidArgumentErrors, the fields are checked by Order.
+func ParseOrderBy(orderBy string) (*Sorting, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return nil, nil
+	}
+	s := &Sorting{}
+	for _, item := range strings.Split(orderBy, ",") {
+		words := strings.Fields(item)
+		if len(words) == 0 || len(words) > 2 || !isFieldPath(words[0]) {
+			return nil, errors.InvalidArgument(fmt.Errorf("invalid order_by %q: invalid item %q", orderBy, item))
+		}
+		c := &SortCriteria{Tag: words[0]}
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				c.Order = SortCriteria_DESC
+			default:
+				return nil, errors.InvalidArgument(fmt.Errorf("invalid order_by %q: invalid direction %q", orderBy, words[1]))
+			}
+		}
+		s.Criterias = append(s.Criterias, c)
+	}
+	return s, nil
+}
+
+func isFieldPath(path string) bool {
+	for i, r := range path {
+		if !isFieldRune(r) || i == 0 && unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return path != ""
+}
+
+func isFieldRune(r rune) bool {
+	return r == '_' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+var filterComparators = []struct {
+	token string
+	typ   Condition_Type
+}{
+	// the two characters comparators come first
+	{"<=", Condition_LE},
+	{">=", Condition_GE},
+	{"!=", Condition_NE},
+	{"<", Condition_LT},
+	{">", Condition_GT},
+	{"=", Condition_EQ},
+	{":", Condition_EQ},
+}
+
+// filterParser is a recursive descent parser of the AIP-160 grammar:
+//
+//	expression  = sequence { "AND" sequence }
+//	sequence    = factor { factor }
+//	factor      = term { "OR" term }
+//	term        = [ "NOT" | "-" ] simple
+//	simple      = "(" expression ")" | restriction
+//	restriction = field comparator value
+type filterParser struct {
+	input string
+	pos   int
+}
+
+func (p *filterParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid filter %q at %d: %s", p.input, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *filterParser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *filterParser) skipSpaces() {
+	for !p.eof() && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+// keyword consumes the keyword when it's the next word of the input.
+func (p *filterParser) keyword(keyword string) bool {
+	p.skipSpaces()
+	end := p.pos + len(keyword)
+	if end > len(p.input) || p.input[p.pos:end] != keyword {
+		return false
+	}
+	if end < len(p.input) && isFieldRune(rune(p.input[end])) {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+// next returns the next character of the input, 0 at its end.
+func (p *filterParser) next() byte {
+	if p.skipSpaces(); p.eof() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *filterParser) expression() (*Filtering, error) {
+	return p.operands(LogicalOperator_AND, p.sequence, func() bool { return p.keyword("AND") })
+}
+
+func (p *filterParser) sequence() (*Filtering, error) {
+	return p.operands(LogicalOperator_AND, p.factor, func() bool {
+		next := p.next()
+		return next != 0 && next != ')' && !p.isKeyword("AND") && !p.isKeyword("OR")
+	})
+}
+
+func (p *filterParser) factor() (*Filtering, error) {
+	return p.operands(LogicalOperator_OR, p.term, func() bool { return p.keyword("OR") })
+}
+
+// operands parses the operands of typ separated when more returns true, and
+// returns the single operand as is.
+func (p *filterParser) operands(typ LogicalOperator_Type, operand func() (*Filtering, error), more func() bool) (*Filtering, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	operands := []*Filtering{first}
+	for more() {
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, next)
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return &Filtering{Expression: &Filtering_Operator{Operator: &LogicalOperator{Type: typ, Operands: operands}}}, nil
+}
+
+func (p *filterParser) isKeyword(keyword string) bool {
+	pos := p.pos
+	defer func() { p.pos = pos }()
+	return p.keyword(keyword)
+}
+
+func (p *filterParser) term() (*Filtering, error) {
+	negated := p.keyword("NOT")
+	if !negated && p.next() == '-' {
+		p.pos++
+		negated = true
+	}
+	f, err := p.simple()
+	if err != nil || !negated {
+		return f, err
+	}
+	return not(f), nil
+}
+
+func not(f *Filtering) *Filtering {
+	return &Filtering{Expression: &Filtering_Operator{Operator: &LogicalOperator{Type: LogicalOperator_NOT, Operands: []*Filtering{f}}}}
+}
+
+func (p *filterParser) simple() (*Filtering, error) {
+	if p.next() != '(' {
+		return p.restriction()
+	}
+	p.pos++
+	f, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if p.next() != ')' {
+		return nil, p.errorf("expected )")
+	}
+	p.pos++
+	return f, nil
+}
+
+// likePattern escapes the wildcards of LIKE, and LikeEscape, in a value and
+// turns its * into %.
+var likePattern = strings.NewReplacer(LikeEscape, LikeEscape+LikeEscape, "%", LikeEscape+"%", "_", LikeEscape+"_", "*", "%")
+
+func (p *filterParser) restriction() (*Filtering, error) {
+	p.skipSpaces()
+	start := p.pos
+	for !p.eof() && isFieldRune(rune(p.input[p.pos])) {
+		p.pos++
+	}
+	field := p.input[start:p.pos]
+	if !isFieldPath(field) {
+		return nil, p.errorf("expected a field")
+	}
+	p.skipSpaces()
+	var comparator string
+	c := &Condition{Field: field}
+	for _, v := range filterComparators {
+		if strings.HasPrefix(p.input[p.pos:], v.token) {
+			comparator, c.Type = v.token, v.typ
+			p.pos += len(v.token)
+			break
+		}
+	}
+	if comparator == "" {
+		return nil, p.errorf("expected a comparator after %q", field)
+	}
+	value, quoted, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case !quoted && value == "*" && comparator == ":":
+		return not(&Filtering{Expression: &Filtering_Condition{Condition: &Condition{Field: field, Type: Condition_IS_NULL}}}), nil
+	case !quoted && value == "null" && (c.Type == Condition_EQ || c.Type == Condition_NE):
+		c.Type = Condition_IS_NULL
+	case (comparator == "=" || comparator == ":") && strings.Contains(value, "*"):
+		c.Type, c.Values = Condition_LIKE, []string{likePattern.Replace(value)}
+	default:
+		c.Values = []string{value}
+	}
+	f := &Filtering{Expression: &Filtering_Condition{Condition: c}}
+	if comparator == "!=" && c.Type == Condition_IS_NULL {
+		return not(f), nil
+	}
+	return f, nil
+}
+
+// value returns the next value of the input, either a string quoted with "
+// or ' with its escapes resolved, or a text up to a space or a parenthesis.
+func (p *filterParser) value() (string, bool, error) {
+	quote := p.next()
+	if quote != '"' && quote != '\'' {
+		start := p.pos
+		for !p.eof() && !unicode.IsSpace(rune(p.input[p.pos])) && p.input[p.pos] != '(' && p.input[p.pos] != ')' {
+			p.pos++
+		}
+		if start == p.pos {
+			return "", false, p.errorf("expected a value")
+		}
+		return p.input[start:p.pos], false, nil
+	}
+	var b strings.Builder
+	for p.pos++; !p.eof(); p.pos++ {
+		switch ch := p.input[p.pos]; {
+		case ch == quote:
+			p.pos++
+			return b.String(), true, nil
+		case ch == '\\' && p.pos+1 < len(p.input):
+			p.pos++
+			b.WriteByte(p.input[p.pos])
+		default:
+			b.WriteByte(ch)
+		}
+	}
+	return "", false, p.errorf("unterminated string")
+}
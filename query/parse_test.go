@@ -0,0 +1,103 @@
+package query
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	gerrors "github.com/edhaight/protoc-gen-gorm/errors"
+)
+
+func TestParseFilter(t *testing.T) {
+	cases := []struct {
+		filter      string
+		where       string
+		args        []interface{}
+		expectError bool
+	}{
+		{"", "", nil, false},
+		{"  ", "", nil, false},
+		{`name = "bob"`, "user_name = ?", []interface{}{"bob"}, false},
+		{"age>=18", "age >= ?", []interface{}{int32(18)}, false},
+		{`name = 'b*'`, "user_name LIKE ? ESCAPE ?", []interface{}{"b%", `\`}, false},
+		{`name = '100%*'`, "user_name LIKE ? ESCAPE ?", []interface{}{`100\%%`, `\`}, false},
+		{`name = 'a_b*'`, "user_name LIKE ? ESCAPE ?", []interface{}{`a\_b%`, `\`}, false},
+		{`name = C:\dir\*`, "user_name LIKE ? ESCAPE ?", []interface{}{`C:\\dir\\%`, `\`}, false},
+		{`name = '*50%'`, "user_name LIKE ? ESCAPE ?", []interface{}{`%50\%`, `\`}, false},
+		{`name = '50%'`, "user_name = ?", []interface{}{"50%"}, false},
+		{`name:"bob"`, "user_name = ?", []interface{}{"bob"}, false},
+		{"created_at = null", "created_at IS NULL", nil, false},
+		{"created_at != null", "NOT (created_at IS NULL)", nil, false},
+		{"created_at:*", "NOT (created_at IS NULL)", nil, false},
+		{`name = "a \"b\""`, "user_name = ?", []interface{}{`a "b"`}, false},
+		{"age > 1 age < 9", "(age > ?) AND (age < ?)", []interface{}{int32(1), int32(9)}, false},
+		{"age = 1 OR age = 2 AND name = x", "((age = ?) OR (age = ?)) AND (user_name = ?)", []interface{}{int32(1), int32(2), "x"}, false},
+		{"age = 1 OR (age = 2 AND name = x)", "(age = ?) OR ((age = ?) AND (user_name = ?))", []interface{}{int32(1), int32(2), "x"}, false},
+		{"NOT age = 1 AND -name = x", "(NOT (age = ?)) AND (NOT (user_name = ?))", []interface{}{int32(1), "x"}, false},
+		{"NOTE = 1", "", nil, true},
+		{"age", "", nil, true},
+		{"age =", "", nil, true},
+		{"(age = 1", "", nil, true},
+		{"age = 1)", "", nil, true},
+		{`name = "bob`, "", nil, true},
+		{"age = 1 AND", "", nil, true},
+		{"= 1", "", nil, true},
+	}
+	for _, v := range cases {
+		t.Run(v.filter, func(t *testing.T) {
+			f, err := ParseFilter(v.filter)
+			if err == nil {
+				var where string
+				var args []interface{}
+				if where, args, err = Where(f, &userORM{}); err == nil {
+					if where != v.where {
+						t.Errorf("Expected %q, got %q", v.where, where)
+					}
+					if !reflect.DeepEqual(args, v.args) {
+						t.Errorf("Expected %#v, got %#v", v.args, args)
+					}
+				}
+			}
+			var target *gerrors.InvalidArgumentError
+			if v.expectError && !errors.As(err, &target) {
+				t.Errorf("Expected an InvalidArgumentError, got %#v", err)
+			}
+			if !v.expectError && err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestParseOrderBy(t *testing.T) {
+	cases := []struct {
+		orderBy     string
+		order       string
+		expectError bool
+	}{
+		{"", "", false},
+		{"name", "user_name", false},
+		{" name desc,  age ASC ,id", "user_name DESC, age, id", false},
+		{"name,", "", true},
+		{"name sideways", "", true},
+		{"name desc age", "", true},
+		{"1name", "", true},
+		{"secret", "", true},
+	}
+	for _, v := range cases {
+		t.Run(v.orderBy, func(t *testing.T) {
+			s, err := ParseOrderBy(v.orderBy)
+			order := ""
+			if err == nil && s != nil {
+				order, err = Order(s, &userORM{})
+			}
+			var target *gerrors.InvalidArgumentError
+			if v.expectError && !errors.As(err, &target) {
+				t.Errorf("Expected an InvalidArgumentError, got %#v", err)
+			}
+			if !v.expectError && (err != nil || order != v.order) {
+				t.Errorf("Expected %q, got %q and %v", v.order, order, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package query
+
+// IsAsc reports whether the rows are sorted by ascending tag.
+func (c *SortCriteria) IsAsc() bool {
+	return c.GetOrder() == SortCriteria_ASC
+}
+
+// IsDesc reports whether the rows are sorted by descending tag.
+func (c *SortCriteria) IsDesc() bool {
+	return c.GetOrder() == SortCriteria_DESC
+}
@@ -0,0 +1,190 @@
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Lengths of the variable units of an interval, as used by postgres when
+// justifying intervals.
+const (
+	intervalDay   = 24 * time.Hour
+	intervalMonth = 30 * intervalDay
+	intervalYear  = 12 * intervalMonth
+)
+
+var intervalUnits = map[string]time.Duration{
+	"year": intervalYear, "years": intervalYear,
+	"mon": intervalMonth, "mons": intervalMonth,
+	"day": intervalDay, "days": intervalDay,
+	"hour": time.Hour, "hours": time.Hour,
+	"min": time.Minute, "mins": time.Minute,
+	"sec": time.Second, "secs": time.Second,
+	"millisecond": time.Millisecond, "milliseconds": time.Millisecond,
+	"microsecond": time.Microsecond, "microseconds": time.Microsecond,
+}
+
+// Interval is a special scannable type for a postgres interval, holding a
+// time.Duration. Postgres stores intervals with a microsecond precision.
+type Interval time.Duration
+
+// Value implements the Value part of the sql scannable interface
+func (i Interval) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d microseconds", time.Duration(i).Microseconds()), nil
+}
+
+// Scan implements the scan part of the sql scannable interface
+func (i *Interval) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	var strdat string
+	bytes, ok := value.([]byte)
+	if !ok {
+		if strdat, ok = value.(string); !ok {
+			return errors.New("Could not cast value in Interval.Scan as []byte or string")
+		}
+	} else {
+		strdat = string(bytes)
+	}
+	d, err := ParseInterval(strdat)
+	if err != nil {
+		return err
+	}
+	*i = Interval(d)
+	return nil
+}
+
+// ParseInterval will return the duration represented by a postgres interval
+// in the postgres, postgres_verbose or iso_8601 output style, or in the format
+// written by Value. Months and years are taken as 30 days and 12 months.
+func ParseInterval(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "P") || strings.HasPrefix(s, "-P") {
+		return parseISOInterval(s)
+	}
+	fields := strings.Fields(strings.TrimPrefix(s, "@"))
+	var d time.Duration
+	negate := false
+	for n := 0; n < len(fields); n++ {
+		field := fields[n]
+		switch {
+		case field == "ago":
+			negate = true
+		case strings.Contains(field, ":"):
+			t, err := parseIntervalTime(field)
+			if err != nil {
+				return 0, err
+			}
+			d += t
+		default:
+			if n+1 == len(fields) {
+				return 0, fmt.Errorf("invalid interval %q", s)
+			}
+			unit, ok := intervalUnits[fields[n+1]]
+			if !ok {
+				return 0, fmt.Errorf("invalid interval unit %q in %q", fields[n+1], s)
+			}
+			v, err := intervalComponent(field, unit)
+			if err != nil {
+				return 0, fmt.Errorf("invalid interval %q: %s", s, err)
+			}
+			d += v
+			n++
+		}
+	}
+	if negate {
+		d = -d
+	}
+	return d, nil
+}
+
+// parseIntervalTime parses the [-]hh:mm:ss[.ffffff] time part of an interval.
+func parseIntervalTime(s string) (time.Duration, error) {
+	sign := time.Duration(1)
+	if strings.HasPrefix(s, "-") {
+		sign = -1
+	}
+	parts := strings.Split(strings.TrimLeft(s, "+-"), ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid interval time %q", s)
+	}
+	var d time.Duration
+	for n, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		v, err := intervalComponent(parts[n], unit)
+		if err != nil {
+			return 0, fmt.Errorf("invalid interval time %q: %s", s, err)
+		}
+		d += v
+	}
+	return sign * d, nil
+}
+
+// parseISOInterval parses an interval in the iso_8601 output style, e.g.
+// P1Y2M3DT4H5M6.5S, where every component may be negative.
+func parseISOInterval(s string) (time.Duration, error) {
+	sign := time.Duration(1)
+	if strings.HasPrefix(s, "-") {
+		sign = -1
+		s = s[1:]
+	}
+	var d time.Duration
+	inTime := false
+	num := ""
+	for _, c := range s[1:] {
+		switch {
+		case c == 'T':
+			inTime = true
+		case c == '-' || c == '.' || c >= '0' && c <= '9':
+			num += string(c)
+		default:
+			var unit time.Duration
+			switch {
+			case c == 'Y':
+				unit = intervalYear
+			case c == 'M' && !inTime:
+				unit = intervalMonth
+			case c == 'W':
+				unit = 7 * intervalDay
+			case c == 'D':
+				unit = intervalDay
+			case c == 'H':
+				unit = time.Hour
+			case c == 'M':
+				unit = time.Minute
+			case c == 'S':
+				unit = time.Second
+			default:
+				return 0, fmt.Errorf("invalid interval %q", s)
+			}
+			v, err := intervalComponent(num, unit)
+			if err != nil {
+				return 0, fmt.Errorf("invalid interval %q: %s", s, err)
+			}
+			d += v
+			num = ""
+		}
+	}
+	if num != "" {
+		return 0, fmt.Errorf("invalid interval %q", s)
+	}
+	return sign * d, nil
+}
+
+// intervalComponent returns the duration of v units, fractions are rounded to
+// the microsecond.
+func intervalComponent(v string, unit time.Duration) (time.Duration, error) {
+	if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Duration(n) * unit, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(math.Round(f*float64(unit/time.Microsecond))) * time.Microsecond, nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseInterval(t *testing.T) {
+	cases := []struct {
+		str         string
+		value       time.Duration
+		expectError bool
+	}{
+		{"00:00:00", 0, false},
+		{"01:02:03.5", time.Hour + 2*time.Minute + 3500*time.Millisecond, false},
+		{"-00:00:00.000001", -time.Microsecond, false},
+		{"3 days 04:05:06", 3*24*time.Hour + 4*time.Hour + 5*time.Minute + 6*time.Second, false},
+		{"-1 days +02:00:00", -22 * time.Hour, false},
+		{"1 year 2 mons", 14 * 30 * 24 * time.Hour, false},
+		{"@ 1 day 2 hours 3 mins 4.1 secs ago", -(26*time.Hour + 3*time.Minute + 4100*time.Millisecond), false},
+		{"1500 microseconds", 1500 * time.Microsecond, false},
+		{"P1DT2H3M4.5S", 26*time.Hour + 3*time.Minute + 4500*time.Millisecond, false},
+		{"P1M", 30 * 24 * time.Hour, false},
+		{"PT-1M", -time.Minute, false},
+		{"-P1D", -24 * time.Hour, false},
+		{"3 fortnights", 0, true},
+		{"3", 0, true},
+		{"1:2", 0, true},
+		{"P1X", 0, true},
+	}
+
+	for _, v := range cases {
+		t.Run(v.str, func(t *testing.T) {
+			d, err := ParseInterval(v.str)
+			if err != nil && !v.expectError {
+				t.Errorf("Got unexpected error: %s", err)
+			}
+			if v.expectError && err == nil {
+				t.Errorf("Expected error but didn't get any")
+			}
+			if d != v.value {
+				t.Errorf("Expected value: %s, got %s", v.value, d)
+			}
+		})
+	}
+}
+
+func TestIntervalValue(t *testing.T) {
+	value, err := Interval(90*time.Minute + time.Microsecond).Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "5400000001 microseconds" {
+		t.Errorf("Did not get expected value, got %v", value)
+	}
+
+	var i Interval
+	if err := i.Scan([]byte("01:30:00.000001")); err != nil {
+		t.Fatal(err)
+	}
+	if time.Duration(i) != 90*time.Minute+time.Microsecond {
+		t.Errorf("Did not get expected value, got %s", time.Duration(i))
+	}
+}